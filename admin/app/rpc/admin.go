@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/config"
+	"Mini-Tiktok/admin/app/rpc/internal/interceptor"
+	"Mini-Tiktok/admin/app/rpc/internal/server"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/admin.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		admin.RegisterAdminRpcServer(grpcServer, server.NewAdminRpcServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	// 先检查权限，通过后再执行管理操作并写入审计日志，未通过权限检查的调用由权限检查拦截器写入审计日志
+	s.AddUnaryInterceptors(interceptor.Permission(ctx), interceptor.Audit(ctx))
+	defer s.Stop()
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
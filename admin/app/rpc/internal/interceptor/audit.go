@@ -0,0 +1,70 @@
+package interceptor
+
+import (
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/common/rbac"
+	"context"
+	"encoding/json"
+	"github.com/ncghost1/snowflake-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc"
+	"path"
+	"strconv"
+	"time"
+)
+
+// statusResp 管理服务的响应都带有状态码与状态信息
+type statusResp interface {
+	GetStatusCode() string
+	GetStatusMsg() string
+}
+
+// Audit 审计日志拦截器，需放在权限检查拦截器之后，
+// 管理操作执行完成后将操作人、操作名、请求参数与操作结果写入审计日志，写入失败不影响操作结果
+func Audit(svcCtx *svc.ServiceContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		op, _ := rbac.OperatorFromContext(ctx)
+		auditLog := newAuditLog(op, req, info)
+		if err != nil {
+			auditLog.StatusCode = model.AuditStatusError
+			auditLog.StatusMsg = err.Error()
+		} else if r, ok := resp.(statusResp); ok {
+			auditLog.StatusCode = r.GetStatusCode()
+			auditLog.StatusMsg = r.GetStatusMsg()
+		}
+
+		if e := writeAuditLog(svcCtx, auditLog); e != nil {
+			logx.WithContext(ctx).Errorf("write audit log %+v: %v", auditLog, e)
+		}
+		return resp, err
+	}
+}
+
+// newAuditLog 生成一条审计日志，记录操作人、操作名与请求参数，操作结果由调用方填写
+func newAuditLog(op rbac.Operator, req interface{}, info *grpc.UnaryServerInfo) *model.AuditLog {
+	auditLog := &model.AuditLog{
+		OperatorRole: op.Role,
+		Action:       path.Base(info.FullMethod),
+		CreateTime:   time.Now().Unix(),
+	}
+	auditLog.OperatorId, _ = strconv.ParseUint(op.UserId, 10, 64)
+	if detail, err := json.Marshal(req); err == nil {
+		auditLog.Detail = string(detail)
+	}
+	return auditLog
+}
+
+func writeAuditLog(svcCtx *svc.ServiceContext, auditLog *model.AuditLog) error {
+	sf, err := snowflake.New(svcCtx.Config.WorkerId)
+	if err != nil {
+		return err
+	}
+	auditLog.Id, err = sf.Generate()
+	if err != nil {
+		return err
+	}
+	return svcCtx.Db.Create(auditLog).Error
+}
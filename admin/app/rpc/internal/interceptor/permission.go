@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/common/rbac"
+	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
+	"context"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc"
+)
+
+// PermissionRules 各管理操作所需的最低角色，审核员只能处理内容，管理员可以处理账号与缓存
+var PermissionRules = map[string]string{
+	admin.AdminRpc_ApproveVideo_FullMethodName:         rbac.ROLE_MODERATOR,
+	admin.AdminRpc_RejectVideo_FullMethodName:          rbac.ROLE_MODERATOR,
+	admin.AdminRpc_TakeDownVideo_FullMethodName:        rbac.ROLE_MODERATOR,
+	admin.AdminRpc_ListReviewQueue_FullMethodName:      rbac.ROLE_MODERATOR,
+	admin.AdminRpc_ListReportTargets_FullMethodName:    rbac.ROLE_MODERATOR,
+	admin.AdminRpc_RestoreReportTarget_FullMethodName:  rbac.ROLE_MODERATOR,
+	admin.AdminRpc_GetDuplicateOriginal_FullMethodName: rbac.ROLE_MODERATOR,
+	admin.AdminRpc_DeleteComment_FullMethodName:        rbac.ROLE_MODERATOR,
+	admin.AdminRpc_BanUser_FullMethodName:              rbac.ROLE_ADMIN,
+	admin.AdminRpc_UnbanUser_FullMethodName:            rbac.ROLE_ADMIN,
+	admin.AdminRpc_SetRole_FullMethodName:              rbac.ROLE_ADMIN,
+	admin.AdminRpc_RebuildCache_FullMethodName:         rbac.ROLE_ADMIN,
+}
+
+// Permission 权限检查拦截器，通过鉴权服务解析调用方携带的 token 获取用户角色；
+// 被拒绝的调用不会进入审计日志拦截器，由这里写入审计日志，状态码为 AuditStatusDenied
+func Permission(svcCtx *svc.ServiceContext) grpc.UnaryServerInterceptor {
+	parse := func(ctx context.Context, token string) (string, string, error) {
+		r, err := svcCtx.JwtRpc.ParseToken(ctx, &jwtrpc.ParseTokenReq{Token: token})
+		if err != nil {
+			return "", "", err
+		}
+		return r.UserID, r.Role, nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		op, err := rbac.Authorize(ctx, parse, PermissionRules, info.FullMethod)
+		if err != nil {
+			auditLog := newAuditLog(op, req, info)
+			auditLog.StatusCode = model.AuditStatusDenied
+			auditLog.StatusMsg = err.Error()
+			if e := writeAuditLog(svcCtx, auditLog); e != nil {
+				logx.WithContext(ctx).Errorf("write audit log %+v: %v", auditLog, e)
+			}
+			return nil, err
+		}
+		return handler(rbac.NewContext(ctx, op), req)
+	}
+}
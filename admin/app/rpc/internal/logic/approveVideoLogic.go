@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveVideoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApproveVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveVideoLogic {
+	return &ApproveVideoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ApproveVideo 审核通过视频，由视频服务更新审核状态并将视频加入 Feed 流、发布列表与话题缓存
+func (l *ApproveVideoLogic) ApproveVideo(in *admin.ApproveVideoReq) (*admin.ApproveVideoResp, error) {
+	r, err := l.svcCtx.VideoRpc.ReviewVideo(l.ctx, &videorpc.ReviewVideoReq{
+		VideoId:    in.VideoId,
+		ActionType: REVIEW_APPROVE,
+		Reason:     in.Reason,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.ApproveVideoResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}, nil
+}
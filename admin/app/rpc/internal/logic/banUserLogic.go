@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/common/rbac"
+	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BanUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBanUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BanUserLogic {
+	return &BanUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BanUser 封禁用户：封禁后不能登录，并吊销该用户所有已签发的 token
+// 审核员与管理员需要先设置为普通用户才能封禁
+func (l *BanUserLogic) BanUser(in *admin.BanUserReq) (*admin.BanUserResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &admin.BanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if isOperator(l.ctx, in.UserId) {
+		return &admin.BanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_OPERATE_SELF_MSG,
+		}, nil
+	}
+
+	u, exists, err := findUser(l.svcCtx, userid)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &admin.BanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+		}, nil
+	}
+	if rbac.HasRole(u.Role, rbac.ROLE_MODERATOR) {
+		return &admin.BanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_BAN_PRIVILEGED_MSG,
+		}, nil
+	}
+
+	err = l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userid}).Update("status", model.UserStatusBanned).Error
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = l.svcCtx.JwtRpc.RevokeToken(l.ctx, &jwtrpc.RevokeTokenReq{UserID: in.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	// 从用户搜索结果中隐藏，失败只记录日志
+	err = searchUserVisibleEvent(l.ctx, l.svcCtx, userid, true)
+	if err != nil {
+		l.Errorf("write search event: %v", err)
+	}
+
+	return &admin.BanUserResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package logic
+
+const (
+	STATUS_SUCCESS              = "0"
+	STATUS_SUCCESS_MSG          = "OK"
+	STATUS_FAIL                 = "1"
+	STATUS_FAIL_PARAM_MSG       = "Request parameter error"
+	STATUS_USER_NOTEXIST_MSG    = "User not exist"
+	STATUS_COMMENT_NOTEXIST_MSG = "Comment not exist"
+	STATUS_OPERATE_SELF_MSG     = "Operating on yourself is not allowed"
+	STATUS_BAN_PRIVILEGED_MSG   = "Moderators and admins must be demoted before being banned"
+	REVIEW_APPROVE              = "1" // 与视频服务的审核操作类型保持一致
+	REVIEW_REJECT               = "2"
+	REVIEW_TAKE_DOWN            = "3"
+	COMMENT_DELETE              = "2" // 与视频服务的评论操作类型保持一致
+	CACHE_TARGET_USER           = "1"
+	CACHE_TARGET_VIDEO          = "2"
+)
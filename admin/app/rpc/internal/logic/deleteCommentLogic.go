@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCommentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCommentLogic {
+	return &DeleteCommentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteComment 删除评论，以评论作者的身份交由视频服务删除，以便同时更新评论缓存与评论数
+func (l *DeleteCommentLogic) DeleteComment(in *admin.DeleteCommentReq) (*admin.DeleteCommentResp, error) {
+	commentId, err := strconv.ParseUint(in.CommentId, 10, 64)
+	if err != nil {
+		return &admin.DeleteCommentResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var comment model.Comment
+	err = l.svcCtx.Db.Where(&model.Comment{Id: commentId}).Take(&comment).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &admin.DeleteCommentResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COMMENT_NOTEXIST_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	r, err := l.svcCtx.VideoRpc.CommentAction(l.ctx, &videorpc.CommentReq{
+		VideoId:    strconv.FormatUint(comment.VideoId, 10),
+		UserId:     strconv.FormatUint(comment.UserId, 10),
+		ActionType: COMMENT_DELETE,
+		CommentId:  in.CommentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.DeleteCommentResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDuplicateOriginalLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDuplicateOriginalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDuplicateOriginalLogic {
+	return &GetDuplicateOriginalLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDuplicateOriginal 获取被检测为重复投稿的视频所对应的原视频与相似度，供审核员比对
+func (l *GetDuplicateOriginalLogic) GetDuplicateOriginal(in *admin.DuplicateOriginalReq) (*admin.DuplicateOriginalResp, error) {
+	videoId, err := strconv.ParseUint(in.VideoId, 10, 64)
+	if err != nil {
+		return &admin.DuplicateOriginalResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetDuplicateOriginal(l.ctx, &videorpc.DuplicateOriginalReq{
+		VideoId:    videoId,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.DuplicateOriginalResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+		Original:   toAdminVideo(r.Original),
+		Score:      r.Score,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReportTargetsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReportTargetsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReportTargetsLogic {
+	return &ListReportTargetsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListReportTargets 按首次被举报时间正序分页获取指定状态（默认已自动隐藏待审核）的举报目标
+func (l *ListReportTargetsLogic) ListReportTargets(in *admin.ReportTargetListReq) (*admin.ReportTargetListResp, error) {
+	r, err := l.svcCtx.VideoRpc.ListReportTargets(l.ctx, &videorpc.ReportTargetListReq{
+		Status:     in.Status,
+		Cursor:     in.Cursor,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targetList := make([]*admin.ReportTarget, len(r.TargetList))
+	for i, t := range r.TargetList {
+		targetList[i] = &admin.ReportTarget{
+			TargetType:  t.TargetType,
+			TargetId:    t.TargetId,
+			OwnerId:     t.OwnerId,
+			ReportCount: t.ReportCount,
+			Status:      t.Status,
+			CreateTime:  t.CreateTime,
+			UpdateTime:  t.UpdateTime,
+		}
+	}
+
+	return &admin.ReportTargetListResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+		TargetList: targetList,
+		NextCursor: r.NextCursor,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReviewQueueLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReviewQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReviewQueueLogic {
+	return &ListReviewQueueLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListReviewQueue 按投稿时间正序分页获取指定审核状态（默认待审核）的视频
+func (l *ListReviewQueueLogic) ListReviewQueue(in *admin.ReviewQueueReq) (*admin.ReviewQueueResp, error) {
+	r, err := l.svcCtx.VideoRpc.ListReviewQueue(l.ctx, &videorpc.ReviewQueueReq{
+		Status:     in.Status,
+		Cursor:     in.Cursor,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	videoList := make([]*admin.Video, len(r.VideoList))
+	for i, v := range r.VideoList {
+		videoList[i] = toAdminVideo(v)
+	}
+
+	return &admin.ReviewQueueResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+		VideoList:  videoList,
+		NextCursor: r.NextCursor,
+	}, nil
+}
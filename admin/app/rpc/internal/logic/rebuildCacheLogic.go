@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RebuildCacheLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRebuildCacheLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RebuildCacheLogic {
+	return &RebuildCacheLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RebuildCache 强制重建用户或视频的缓存：删除相关缓存，由各服务下次查询时从 DB 重新加载
+func (l *RebuildCacheLogic) RebuildCache(in *admin.RebuildCacheReq) (*admin.RebuildCacheResp, error) {
+	targetId, err := strconv.ParseUint(in.TargetId, 10, 64)
+	if err != nil {
+		return &admin.RebuildCacheResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var keys []string
+	switch in.TargetType {
+	case CACHE_TARGET_USER:
+		keys = model.UserCacheKeys(targetId)
+	case CACHE_TARGET_VIDEO:
+		keys = model.VideoCacheKeys(targetId)
+	default:
+		return &admin.RebuildCacheResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	err = l.svcCtx.Redis.DelKeys(conn, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.RebuildCacheResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
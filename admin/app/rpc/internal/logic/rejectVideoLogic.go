@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectVideoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRejectVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectVideoLogic {
+	return &RejectVideoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RejectVideo 审核不通过视频（仅待审核的视频），不通过的原因作者可见
+func (l *RejectVideoLogic) RejectVideo(in *admin.RejectVideoReq) (*admin.RejectVideoResp, error) {
+	r, err := l.svcCtx.VideoRpc.ReviewVideo(l.ctx, &videorpc.ReviewVideoReq{
+		VideoId:    in.VideoId,
+		ActionType: REVIEW_REJECT,
+		Reason:     in.Reason,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.RejectVideoResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}, nil
+}
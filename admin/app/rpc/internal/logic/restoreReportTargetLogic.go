@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreReportTargetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreReportTargetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreReportTargetLogic {
+	return &RestoreReportTargetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RestoreReportTarget 恢复因举报被自动隐藏的视频、评论或账号，交由视频服务更新举报状态与缓存，
+// 恢复账号后更新用户搜索索引中的可见状态，被封禁的账号仍不可见
+func (l *RestoreReportTargetLogic) RestoreReportTarget(in *admin.RestoreReportTargetReq) (*admin.RestoreReportTargetResp, error) {
+	targetId, err := strconv.ParseUint(in.TargetId, 10, 64)
+	if err != nil {
+		return &admin.RestoreReportTargetResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.RestoreReportTarget(l.ctx, &videorpc.RestoreReportTargetReq{
+		TargetType: in.TargetType,
+		TargetId:   targetId,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.StatusCode == STATUS_SUCCESS && in.TargetType == model.ReportTargetUser {
+		u, exists, err := findUser(l.svcCtx, targetId)
+		if err != nil {
+			return nil, err
+		}
+		// 恢复用户搜索结果中的可见状态，失败只记录日志
+		err = searchUserVisibleEvent(l.ctx, l.svcCtx, targetId, !exists || u.Status == model.UserStatusBanned)
+		if err != nil {
+			l.Errorf("write search event: %v", err)
+		}
+	}
+
+	return &admin.RestoreReportTargetResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/common/rbac"
+	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetRoleLogic {
+	return &SetRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetRole 设置用户角色，角色保存在 token 中，所以设置后吊销该用户所有已签发的 token，重新登录后生效
+func (l *SetRoleLogic) SetRole(in *admin.SetRoleReq) (*admin.SetRoleResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || !rbac.IsValidRole(in.Role) {
+		return &admin.SetRoleResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if isOperator(l.ctx, in.UserId) {
+		return &admin.SetRoleResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_OPERATE_SELF_MSG,
+		}, nil
+	}
+
+	_, exists, err := findUser(l.svcCtx, userid)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &admin.SetRoleResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+		}, nil
+	}
+
+	err = l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userid}).Update("role", in.Role).Error
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = l.svcCtx.JwtRpc.RevokeToken(l.ctx, &jwtrpc.RevokeTokenReq{UserID: in.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.SetRoleResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TakeDownVideoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTakeDownVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TakeDownVideoLogic {
+	return &TakeDownVideoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// TakeDownVideo 下架视频，由视频服务更新审核状态并删除 Feed 流与发布列表中的缓存
+func (l *TakeDownVideoLogic) TakeDownVideo(in *admin.TakeDownVideoReq) (*admin.TakeDownVideoResp, error) {
+	r, err := l.svcCtx.VideoRpc.ReviewVideo(l.ctx, &videorpc.ReviewVideoReq{
+		VideoId:    in.VideoId,
+		ActionType: REVIEW_TAKE_DOWN,
+		Reason:     in.Reason,
+		OperatorId: operatorId(l.ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.TakeDownVideoResp{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnbanUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnbanUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnbanUserLogic {
+	return &UnbanUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnbanUser 解除封禁，用户需要重新登录
+func (l *UnbanUserLogic) UnbanUser(in *admin.UnbanUserReq) (*admin.UnbanUserResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &admin.UnbanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	_, exists, err := findUser(l.svcCtx, userid)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &admin.UnbanUserResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+		}, nil
+	}
+
+	err = l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userid}).Update("status", model.UserStatusNormal).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 恢复用户搜索结果中的可见状态，失败只记录日志
+	err = searchUserVisibleEvent(l.ctx, l.svcCtx, userid, false)
+	if err != nil {
+		l.Errorf("write search event: %v", err)
+	}
+
+	return &admin.UnbanUserResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/internal/svc"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/common/rbac"
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"strconv"
+)
+
+// isOperator 判断操作对象是否为调用者自己
+func isOperator(ctx context.Context, userid string) bool {
+	op, ok := rbac.OperatorFromContext(ctx)
+	return ok && op.UserId == userid
+}
+
+// operatorId 返回调用者的用户 id，随审核类请求传给视频服务，由视频服务再次检查调用者的角色
+func operatorId(ctx context.Context) string {
+	op, _ := rbac.OperatorFromContext(ctx)
+	return op.UserId
+}
+
+// searchUserVisibleEvent 封禁或解封账号后修改用户搜索索引中的可见状态，解封后因被举报而自动隐藏的账号仍不可见
+func searchUserVisibleEvent(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, banned bool) error {
+	visible := !banned
+	if visible {
+		hidden, err := model.IsReportHidden(svcCtx.Db, userid)
+		if err != nil {
+			return err
+		}
+		visible = !hidden
+	}
+	marshal, err := json.Marshal(&model.SearchEvent{
+		Type:    model.SearchEventUser,
+		Action:  model.SearchActionVisible,
+		Id:      userid,
+		Visible: visible,
+	})
+	if err != nil {
+		return err
+	}
+	return svcCtx.SearchWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(userid, 10)),
+		Value: marshal,
+	})
+}
+
+// findUser 查询用户的角色与封禁状态，用户不存在时 exists 返回 false
+func findUser(svcCtx *svc.ServiceContext, userid uint64) (u model.User, exists bool, err error) {
+	err = svcCtx.Db.Where(&model.User{Id: userid}).Take(&u).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return u, false, nil
+		}
+		return u, false, err
+	}
+	return u, true, nil
+}
@@ -0,0 +1,26 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/admin"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+)
+
+// toAdminVideo 将视频服务返回的视频转换为审核后台展示的视频，只保留审核需要的字段
+func toAdminVideo(v *videorpc.Video) *admin.Video {
+	if v == nil {
+		return nil
+	}
+	res := &admin.Video{
+		ID:           v.ID,
+		Title:        v.Title,
+		PlayURL:      v.PlayURL,
+		CoverURL:     v.CoverURL,
+		Status:       v.Status,
+		ReviewReason: v.ReviewReason,
+	}
+	if v.Author != nil {
+		res.AuthorId = v.Author.ID
+		res.AuthorName = v.Author.Name
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package svc
+
+import (
+	"Mini-Tiktok/admin/app/rpc/internal/config"
+	"Mini-Tiktok/admin/app/rpc/model"
+	"Mini-Tiktok/admin/app/rpc/model/redisCache"
+	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/zrpc"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+type ServiceContext struct {
+	Config       config.Config
+	JwtRpc       jwtrpc.JwtRpc
+	VideoRpc     videorpc.VideoRpc
+	Redis        *redisCache.RedisPool
+	Db           *gorm.DB
+	SearchWriter *kafka.Writer
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	db, err := model.InitGorm(c.DbConfig)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	pool := redisCache.NewRedisPool(c)
+	conn := pool.NewRedisConn()
+	_, err = conn.Do("PING")
+	defer conn.Close()
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	return &ServiceContext{
+		Config:   c,
+		JwtRpc:   jwtrpc.NewJwtRpc(zrpc.MustNewClient(c.JwtRpc)),
+		VideoRpc: videorpc.NewVideoRpc(zrpc.MustNewClient(c.VideoRpc)),
+		Redis:    pool,
+		Db:       db,
+		SearchWriter: &kafka.Writer{
+			Addr:         kafka.TCP(c.SearchConfig.Host),
+			Topic:        c.SearchConfig.Topic,
+			Balancer:     &kafka.Hash{}, // 按文档 id 分区，保证同一文档的事件有序
+			BatchTimeout: time.Millisecond * time.Duration(c.SearchConfig.BatchTimeout),
+			BatchSize:    c.SearchConfig.BatchSize,
+			BatchBytes:   c.SearchConfig.BatchBytes,
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+// AuditLog 表结构，管理操作的审计日志，每次调用管理服务（包括未通过权限检查的调用）都会写入一条记录
+type AuditLog struct {
+	Id           uint64 `gorm:"column:id"`
+	OperatorId   uint64 `gorm:"column:operator_id"`
+	OperatorRole string `gorm:"column:operator_role"`
+	Action       string `gorm:"column:action"`      // 管理操作名，即 rpc 方法名，如 BanUser
+	Detail       string `gorm:"column:detail"`      // 请求参数 json
+	StatusCode   string `gorm:"column:status_code"` // 操作结果，调用出错时为 -1，未通过权限检查时为 -2
+	StatusMsg    string `gorm:"column:status_msg"`
+	CreateTime   int64  `gorm:"column:create_time"`
+}
+
+const (
+	AuditStatusError  = "-1"
+	AuditStatusDenied = "-2"
+)
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
@@ -0,0 +1,47 @@
+package model
+
+import "strconv"
+
+// 各服务缓存 key 的前缀（与用户服务、视频服务保持一致）
+const (
+	UserCacheKeyPrefix              = "User:Userid:UserInfo:Hash"
+	FollowListCacheKeyPrefix        = "FollowList:Userid:FollowId:"
+	FollowerListCacheKeyPrefix      = "FollowerList:Userid:FollowId:"
+	BlockCacheKeyPrefix             = "Block:UserId:BlockId:SET:"
+	MuteCacheKeyPrefix              = "Block:UserId:MuteId:SET:"
+	NotifyUnreadCountCacheKeyPrefix = "Notify:UserId:UnreadCount:HASH:"
+	FavorCacheKeyPrefix             = "Fav:UserId:VideoId:ZSET:"
+	PublishListCacheKeyPrefix       = "Vid:UserId:VideoId:ZSET:"
+
+	VideoCacheKeyPrefix      = "Vid:VideoId:VideoInfo:"
+	ComIdCacheKeyPrefix      = "Com:VideoId:CommentId:ZSET:"
+	ComCountCacheKeyPrefix   = "Com:VideoId:CommentCount:"
+	FavorCountCacheKeyPrefix = "Fav:VideoId:FavoriteCount:"
+)
+
+// UserCacheKeys 返回用户相关的全部缓存 key：用户信息，最近关注与粉丝列表，拉黑与屏蔽集合，未读通知数，最近点赞与发布视频列表
+func UserCacheKeys(userid uint64) []string {
+	id := strconv.FormatUint(userid, 10)
+	return []string{
+		UserCacheKeyPrefix + id,
+		FollowListCacheKeyPrefix + id,
+		FollowerListCacheKeyPrefix + id,
+		BlockCacheKeyPrefix + id,
+		MuteCacheKeyPrefix + id,
+		NotifyUnreadCountCacheKeyPrefix + id,
+		FavorCacheKeyPrefix + id,
+		PublishListCacheKeyPrefix + id,
+	}
+}
+
+// VideoCacheKeys 返回视频相关的全部缓存 key：视频信息，最新评论列表，评论数，点赞数
+// （Feed 流缓存中冗余存储的视频信息不在此列，由 Feed 缓存的淘汰机制更新）
+func VideoCacheKeys(videoId uint64) []string {
+	id := strconv.FormatUint(videoId, 10)
+	return []string{
+		VideoCacheKeyPrefix + id,
+		ComIdCacheKeyPrefix + id,
+		ComCountCacheKeyPrefix + id,
+		FavorCountCacheKeyPrefix + id,
+	}
+}
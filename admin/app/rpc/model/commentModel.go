@@ -0,0 +1,12 @@
+package model
+
+// Comment 表结构（由视频服务维护，管理服务只读取评论所属的视频与作者）
+type Comment struct {
+	Id      uint64 `gorm:"column:id"`
+	UserId  uint64 `gorm:"column:user_id"`
+	VideoId uint64 `gorm:"column:video_id"`
+}
+
+func (Comment) TableName() string {
+	return "comment"
+}
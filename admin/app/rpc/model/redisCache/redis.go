@@ -0,0 +1,58 @@
+package redisCache
+
+import (
+	"Mini-Tiktok/admin/app/rpc/internal/config"
+	"fmt"
+	"github.com/gomodule/redigo/redis"
+	"time"
+)
+
+type RedisPool struct {
+	pool *redis.Pool
+}
+
+// NewRedisPool 新建一个 redis 连接池
+func NewRedisPool(config config.Config) *RedisPool {
+	return &RedisPool{&redis.Pool{
+		MaxIdle:     config.RedisConfig.MaxIdle, //最大空闲连接数
+		MaxActive:   config.RedisConfig.Active,  //最大连接数
+		IdleTimeout: time.Duration(config.RedisConfig.IdleTimeout) * time.Second,
+		Wait:        true, //超过连接数后是否等待
+		Dial: func() (redis.Conn, error) {
+			redisUri := fmt.Sprintf("%s:%d", config.RedisConfig.Host, config.RedisConfig.Port)
+			if config.RedisConfig.Auth {
+				redisConn, err := redis.Dial("tcp", redisUri,
+					redis.DialUsername(config.RedisConfig.Username),
+					redis.DialPassword(config.RedisConfig.Password))
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			} else {
+				redisConn, err := redis.Dial("tcp", redisUri)
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			}
+		},
+	}}
+}
+
+// NewRedisConn 从连接池中获取一个连接
+func (p *RedisPool) NewRedisConn() redis.Conn {
+	return p.pool.Get()
+}
+
+// DelKeys 删除缓存，由各服务下次查询时从 DB 重新加载
+func (p *RedisPool) DelKeys(conn redis.Conn, keys []string) error {
+	args := make([]interface{}, len(keys))
+	for i, k := range keys {
+		args[i] = k
+	}
+	_, err := conn.Do("DEL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package model
+
+import "gorm.io/gorm"
+
+// ReportTarget 表结构（由视频服务维护），管理服务只用于判断账号是否因被举报而自动隐藏
+type ReportTarget struct {
+	TargetType string `gorm:"column:target_type"`
+	TargetId   uint64 `gorm:"column:target_id"`
+	Status     string `gorm:"column:status"`
+}
+
+const (
+	ReportTargetUser   = "3"
+	ReportStatusHidden = "1"
+)
+
+func (ReportTarget) TableName() string {
+	return "report_target"
+}
+
+// IsReportHidden 判断账号是否因被举报而自动隐藏
+func IsReportHidden(db *gorm.DB, userid uint64) (bool, error) {
+	var count int64
+	err := db.Model(&ReportTarget{}).
+		Where("target_type = ? and target_id = ? and status = ?", ReportTargetUser, userid, ReportStatusHidden).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,16 @@
+package model
+
+// SearchEvent 写入 Kafka 搜索主题的索引事件，由搜索服务消费后更新倒排索引，以文档 id 作为消息 key 保证同一文档的事件有序
+type SearchEvent struct {
+	Type       string `json:"type"`   // 1-视频，2-用户
+	Action     string `json:"action"` // 1-新增或更新，2-删除，3-只修改是否可见
+	Id         uint64 `json:"id"`
+	Text       string `json:"text"`    // 视频标题，或用户名与昵称
+	Visible    bool   `json:"visible"` // 是否可以被搜索到，如待审核的视频不可见
+	CreateTime int64  `json:"create_time"`
+}
+
+const (
+	SearchEventUser     = "2"
+	SearchActionVisible = "3"
+)
@@ -0,0 +1,17 @@
+package model
+
+// User 表结构（由用户服务维护，管理服务只修改其中的角色与封禁状态）
+type User struct {
+	Id     uint64 `gorm:"column:id"`
+	Role   string `gorm:"column:role"`   // 0-普通用户，1-审核员，2-管理员
+	Status string `gorm:"column:status"` // 0-正常，1-已封禁
+}
+
+const (
+	UserStatusNormal = "0"
+	UserStatusBanned = "1"
+)
+
+func (User) TableName() string {
+	return "user"
+}
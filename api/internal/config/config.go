@@ -1,6 +1,8 @@
 package config
 
 import (
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/common/quota"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -10,12 +12,16 @@ type Config struct {
 	UserRpc   zrpc.RpcClientConf
 	JwtRpc    zrpc.RpcClientConf
 	VideoRpc  zrpc.RpcClientConf
+	AdminRpc  zrpc.RpcClientConf
+	SearchRpc zrpc.RpcClientConf
 	AliyunOss struct {
 		Endpoint        string
 		AccessKeyId     string
 		AccessKeySecret string
 		VideoBucket     string
 		VideoPath       string
+		ImagePath       string `json:",default=Mini-Tiktok/Image/"`
+		UrlPrefix       string `json:",optional"`
 	}
 	JwtConfig struct {
 		AccessExpire int64
@@ -27,5 +33,40 @@ type Config struct {
 		BatchSize    int
 		BatchBytes   int64
 	}
-	FeedLimit int64
+	PlayConfig struct {
+		Host         string
+		Topic        string
+		BatchTimeout int
+		BatchSize    int
+		BatchBytes   int64
+		MaxDuration  int64 `json:",default=7200000"` // 播放完成上报的观看时长上限，单位 ms
+	}
+	FeedLimit   int64
+	RedisConfig RedisConfig
+	PushConfig  PushConfig
+	Moderation  moderation.Config
+	Quota       quota.Config
+}
+
+type RedisConfig struct {
+	Host        string
+	Port        int
+	Username    string `json:",optional"`
+	Password    string `json:",optional"`
+	Auth        bool   `json:",optional"`
+	MaxIdle     int
+	Active      int
+	IdleTimeout int
+}
+
+// PushConfig WebSocket 推送网关设置
+type PushConfig struct {
+	KafkaHost      string // 推送事件所在 Kafka 地址
+	Topic          string // 推送事件主题
+	GroupId        string // 消费组，所有网关节点需使用同一个消费组
+	MinBytes       int    `json:",default=1"`          // 拉取消息的最小字节数
+	MaxBytes       int    `json:",default=1048576"`    // 拉取消息的最大字节数
+	Channel        string `json:",default=Push:Event"` // Redis 发布订阅频道
+	PingPeriod     int    `json:",default=30"`         // 向客户端发送 ping 的间隔，单位秒
+	SendBufferSize int    `json:",default=64"`         // 每个连接待发送事件的缓冲数量
 }
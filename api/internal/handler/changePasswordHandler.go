@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"Mini-Tiktok/api/internal/logic"
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func ChangePasswordHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ChangePasswordReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewChangePasswordLogic(r.Context(), svcCtx)
+		resp, err := l.ChangePassword(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
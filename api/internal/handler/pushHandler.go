@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"Mini-Tiktok/api/internal/logic"
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func PushHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PushReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewPushLogic(r.Context(), svcCtx)
+		resp, err := l.Push(&req, w, r)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else if resp != nil { // 鉴权失败，未升级为 WebSocket 连接
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
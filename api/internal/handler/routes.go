@@ -77,6 +77,236 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/douyin/relation/follower/list",
 				Handler: FollowerListHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/user/password",
+				Handler: ChangePasswordHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/user/profile/update",
+				Handler: UpdateProfileHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/relation/friend/list",
+				Handler: FriendListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/message/action",
+				Handler: MessageActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/message/chat",
+				Handler: MessageChatHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/push/ws",
+				Handler: PushHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/notification/list",
+				Handler: NotificationListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/notification/read",
+				Handler: NotificationReadHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/notification/unread",
+				Handler: NotificationUnreadHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/relation/block/action",
+				Handler: BlockActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/relation/block/list",
+				Handler: BlockListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/relation/mute/action",
+				Handler: MuteActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/relation/mute/list",
+				Handler: MuteListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/report/action",
+				Handler: ReportActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/user/ban",
+				Handler: BanUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/user/role",
+				Handler: SetRoleHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/video/approve",
+				Handler: ApproveVideoHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/video/reject",
+				Handler: RejectVideoHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/video/takedown",
+				Handler: TakeDownVideoHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/admin/video/review/list",
+				Handler: ReviewQueueHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/admin/video/duplicate",
+				Handler: DuplicateOriginalHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/admin/report/list",
+				Handler: ReportTargetListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/report/restore",
+				Handler: ReportRestoreHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/comment/delete",
+				Handler: DeleteCommentHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/admin/cache/rebuild",
+				Handler: RebuildCacheHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/search/video",
+				Handler: SearchVideoHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/search/user",
+				Handler: SearchUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/topic",
+				Handler: TopicVideoListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/feed/trending",
+				Handler: TrendingListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/video/play",
+				Handler: PlayActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/history/list",
+				Handler: WatchHistoryListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/history/delete",
+				Handler: WatchHistoryDeleteHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/history/clear",
+				Handler: WatchHistoryClearHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/history/setting",
+				Handler: WatchHistorySettingHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/creator/stats",
+				Handler: CreatorStatsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/collection/action",
+				Handler: CollectionActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/collection/sort",
+				Handler: CollectionSortHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/collection/video/action",
+				Handler: CollectionVideoActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/collection/list",
+				Handler: CollectionListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/collection/video/list",
+				Handler: CollectionVideoListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/share/action",
+				Handler: ShareActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/share/video",
+				Handler: ShareVideoHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/video/visibility",
+				Handler: VideoVisibilityHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/draft/list",
+				Handler: DraftListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/douyin/draft/action",
+				Handler: DraftActionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/douyin/publish/quota",
+				Handler: PublishQuotaHandler(serverCtx),
+			},
 		},
 	)
 }
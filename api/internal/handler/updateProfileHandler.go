@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"mime/multipart"
+	"net/http"
+
+	"Mini-Tiktok/api/internal/logic"
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func UpdateProfileHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.UpdateProfileReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		// 头像与背景图均为可选上传
+		avatar, err := optionalFormFile(r, "avatar")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		background, err := optionalFormFile(r, "background_image")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewUpdateProfileLogic(r.Context(), svcCtx)
+		resp, err := l.UpdateProfile(&req, avatar, background)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
+
+// optionalFormFile 获取可选的上传文件，未上传时返回 nil
+func optionalFormFile(r *http.Request, key string) (multipart.File, error) {
+	file, _, err := r.FormFile(key)
+	if err != nil {
+		if err == http.ErrMissingFile {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file, nil
+}
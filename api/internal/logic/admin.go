@@ -0,0 +1,19 @@
+package logic
+
+import (
+	"Mini-Tiktok/api/internal/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminDenied 将管理服务权限拦截器返回的 gRPC 错误转换为接口响应，其他错误返回 false
+func adminDenied(err error) (types.Response, bool) {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG}, true
+	case codes.PermissionDenied:
+		return types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PERMISSION_MSG}, true
+	}
+	return types.Response{}, false
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveVideoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewApproveVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveVideoLogic {
+	return &ApproveVideoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ApproveVideo 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *ApproveVideoLogic) ApproveVideo(req *types.ApproveVideoReq) (resp *types.ApproveVideoResp, err error) {
+	r, err := l.svcCtx.AdminRpc.ApproveVideo(rbac.WithToken(l.ctx, req.Token), &adminrpc.ApproveVideoReq{
+		VideoId: req.VideoId,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.ApproveVideoResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.ApproveVideoResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BanUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBanUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BanUserLogic {
+	return &BanUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// BanUser 封禁或解封用户，权限检查由管理服务完成，token 通过 metadata 传递
+func (l *BanUserLogic) BanUser(req *types.BanUserReq) (resp *types.BanUserResp, err error) {
+	ctx := rbac.WithToken(l.ctx, req.Token)
+	var r interface {
+		GetStatusCode() string
+		GetStatusMsg() string
+	}
+	switch req.ActionType {
+	case ADMIN_BAN_USER:
+		r, err = l.svcCtx.AdminRpc.BanUser(ctx, &adminrpc.BanUserReq{
+			UserId: req.UserId,
+			Reason: req.Reason,
+		})
+	case ADMIN_UNBAN_USER:
+		r, err = l.svcCtx.AdminRpc.UnbanUser(ctx, &adminrpc.UnbanUserReq{
+			UserId: req.UserId,
+			Reason: req.Reason,
+		})
+	default:
+		return &types.BanUserResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}}, nil
+	}
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.BanUserResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.BanUserResp{Response: types.Response{
+		StatusCode: r.GetStatusCode(),
+		StatusMsg:  r.GetStatusMsg(),
+	}}, nil
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBlockActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockActionLogic {
+	return &BlockActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BlockActionLogic) BlockAction(req *types.BlockActionReq) (resp *types.BlockActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.BlockActionResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.BlockAction(l.ctx, &userrpc.BlockActionReq{UserId: userid, ToUserId: req.ToUserId, ActionType: req.ActionType})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BlockActionResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBlockListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockListLogic {
+	return &BlockListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BlockListLogic) BlockList(req *types.BlockListReq) (resp *types.BlockListResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.BlockListResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.BlockList(l.ctx, &userrpc.BlockListReq{UserId: userid})
+	if err != nil {
+		return nil, err
+	}
+
+	userList := make([]types.User, len(r.UserList))
+	for i, v := range r.UserList {
+		userList[i] = userFromUserRpc(v)
+	}
+
+	return &types.BlockListResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		UserList: userList,
+	}, nil
+}
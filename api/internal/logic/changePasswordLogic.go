@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	REVOKE_RETRY_TIMES    = 3                      // 吊销 token 失败时的最大尝试次数
+	REVOKE_RETRY_INTERVAL = 100 * time.Millisecond // 吊销 token 失败后的重试间隔
+)
+
+type ChangePasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ChangePasswordLogic) ChangePassword(req *types.ChangePasswordReq) (resp *types.ChangePasswordResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.ChangePasswordResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.ChangePassword(l.ctx, &userrpc.ChangePasswordReq{
+		UserId:      userid,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.ChangePasswordResp{
+			Response: types.Response{
+				StatusCode: r.StatusCode,
+				StatusMsg:  r.StatusMsg,
+			},
+		}, nil
+	}
+
+	// 密码修改成功后吊销该用户所有已签发的 token，再为当前客户端签发新 token
+	// 密码已经修改，吊销失败时重试，吊销成功后才返回修改成功，避免旧 token 仍然可用
+	err = l.revokeToken(userid)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResp, err := l.svcCtx.JwtRpc.CreateToken(l.ctx, &Jwt.CreateTokenReq{
+		UserID:       userid,
+		AccessExpire: l.svcCtx.Config.JwtConfig.AccessExpire,
+		Role:         token.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ChangePasswordResp{
+		Response: types.Response{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+		},
+		Token: &tokenResp.Token,
+	}, nil
+}
+
+// revokeToken 吊销用户已签发的所有 token，失败时最多尝试 REVOKE_RETRY_TIMES 次
+func (l *ChangePasswordLogic) revokeToken(userid string) (err error) {
+	for i := 0; i < REVOKE_RETRY_TIMES; i++ {
+		if i > 0 {
+			time.Sleep(REVOKE_RETRY_INTERVAL)
+		}
+		_, err = l.svcCtx.JwtRpc.RevokeToken(l.ctx, &Jwt.RevokeTokenReq{UserID: userid})
+		if err == nil {
+			return nil
+		}
+		l.Errorf("revoke tokens of user %s after password change: %v", userid, err)
+	}
+	return err
+}
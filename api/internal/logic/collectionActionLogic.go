@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCollectionActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionActionLogic {
+	return &CollectionActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollectionAction 创建、修改或删除当前用户的合集
+func (l *CollectionActionLogic) CollectionAction(req *types.CollectionActionReq) (resp *types.CollectionActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.CollectionActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	var collectionId uint64
+	if req.CollectionId != "" {
+		collectionId, err = strconv.ParseUint(req.CollectionId, 10, 64)
+		if err != nil {
+			return &types.CollectionActionResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+			}, nil
+		}
+	}
+
+	r, err := l.svcCtx.VideoRpc.CollectionAction(l.ctx, &videorpc.CollectionActionReq{
+		UserId:       token.UserID,
+		ActionType:   req.ActionType,
+		CollectionId: collectionId,
+		Name:         req.Name,
+		Status:       req.IsPublic,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CollectionActionResp{
+		Response:   types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		Collection: collectionFromVideoRpc(r.Collection),
+	}, nil
+}
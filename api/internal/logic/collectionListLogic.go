@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCollectionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionListLogic {
+	return &CollectionListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollectionList 获取用户的合集列表，查看他人的合集时只返回公开合集
+func (l *CollectionListLogic) CollectionList(req *types.CollectionListReq) (resp *types.CollectionListResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.CollectionListResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetCollectionList(l.ctx, &videorpc.CollectionListReq{
+		UserId:      userid,
+		QueryUserId: req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.CollectionListResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	collectionList := make([]types.Collection, len(r.CollectionList))
+	for i, c := range r.CollectionList {
+		collectionList[i] = *collectionFromVideoRpc(c)
+	}
+
+	return &types.CollectionListResp{
+		Response:       types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		CollectionList: collectionList,
+	}, nil
+}
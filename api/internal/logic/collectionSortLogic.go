@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+	"strings"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionSortLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCollectionSortLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionSortLogic {
+	return &CollectionSortLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollectionSort 调整当前用户合集的顺序，需要提交全部合集的 id
+func (l *CollectionSortLogic) CollectionSort(req *types.CollectionSortReq) (resp *types.CollectionSortResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.CollectionSortResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	var ids []uint64
+	if req.CollectionIds != "" {
+		for _, s := range strings.Split(req.CollectionIds, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return &types.CollectionSortResp{
+					Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+				}, nil
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	r, err := l.svcCtx.VideoRpc.SortCollections(l.ctx, &videorpc.SortCollectionsReq{
+		UserId:           token.UserID,
+		CollectionIdList: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CollectionSortResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionVideoActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCollectionVideoActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionVideoActionLogic {
+	return &CollectionVideoActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollectionVideoAction 向当前用户的合集添加或移除视频
+func (l *CollectionVideoActionLogic) CollectionVideoAction(req *types.CollectionVideoActionReq) (resp *types.CollectionVideoActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.CollectionVideoActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	collectionId, err := strconv.ParseUint(req.CollectionId, 10, 64)
+	if err != nil {
+		return &types.CollectionVideoActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil {
+		return &types.CollectionVideoActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.CollectionVideoAction(l.ctx, &videorpc.CollectionVideoActionReq{
+		UserId:       token.UserID,
+		CollectionId: collectionId,
+		VideoId:      videoId,
+		ActionType:   req.ActionType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CollectionVideoActionResp{
+		Response:   types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		Collection: collectionFromVideoRpc(r.Collection),
+	}, nil
+}
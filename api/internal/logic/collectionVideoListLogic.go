@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionVideoListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCollectionVideoListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionVideoListLogic {
+	return &CollectionVideoListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollectionVideoList 分页获取合集中的视频，私密合集只有创建者可见
+func (l *CollectionVideoListLogic) CollectionVideoList(req *types.CollectionVideoListReq) (resp *types.CollectionVideoListResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.CollectionVideoListResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	collectionId, err := strconv.ParseUint(req.CollectionId, 10, 64)
+	if err != nil {
+		return &types.CollectionVideoListResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetCollectionVideoList(l.ctx, &videorpc.CollectionVideoListReq{
+		UserId:       userid,
+		CollectionId: collectionId,
+		Cursor:       req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.CollectionVideoListResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	videoList := make([]types.Video, len(r.VideoList))
+	for i, v := range r.VideoList {
+		videoList[i] = videoFromVideoRpc(v)
+	}
+
+	return &types.CollectionVideoListResp{
+		Response:   types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		Collection: collectionFromVideoRpc(r.Collection),
+		VideoList:  videoList,
+		NextCursor: r.NextCursor,
+		HasMore:    r.HasMore,
+	}, nil
+}
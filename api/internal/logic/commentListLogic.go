@@ -47,13 +47,7 @@ func (l *CommentListLogic) CommentList(req *types.CommentListReq) (resp *types.C
 			Content:    v.Content,
 			CreateDate: v.CreateDate,
 			ID:         v.ID,
-			User: types.User{
-				FollowCount:   v.User.FollowCount,
-				FollowerCount: v.User.FollowerCount,
-				ID:            v.User.ID,
-				IsFollow:      v.User.IsFollow,
-				Name:          v.User.Name,
-			},
+			User:       userFromVideoRpc(v.User),
 		}
 	}
 
@@ -62,13 +62,7 @@ func (l *CommentLogic) Comment(req *types.CommentReq) (resp *types.CommentResp,
 			Content:    r.Comment.Content,
 			CreateDate: r.Comment.CreateDate,
 			ID:         r.Comment.ID,
-			User: types.User{
-				FollowCount:   r.Comment.User.FollowCount,
-				FollowerCount: r.Comment.User.FollowerCount,
-				ID:            r.Comment.User.ID,
-				IsFollow:      r.Comment.User.IsFollow,
-				Name:          r.Comment.User.Name,
-			},
+			User:       userFromVideoRpc(r.Comment.User),
 		}
 	}
 
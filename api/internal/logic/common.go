@@ -1,15 +1,52 @@
 package logic
 
 const (
-	STATUS_SUCCESS          = "0"
-	STATUS_FAIL             = "1"
-	STATUS_FAIL_TOKEN_MSG   = "Token is invalid"
-	STATUS_FAIL_TOOLONG_MSG = "Username or Password must less than 32 characters"
-	STATUS_SUCCESS_MSG      = "OK"
-	STATUS_FAIL_PARAM_MSG   = "Request parameter error"
-	USER_NO_LOGIN           = "0" // 需保证不出现 id 为 0 的用户
-	STATUS_FAIL_FOLLOW_SELF = "Follow yourself is not allowed"
-	FILE_EMPTY_ERROR        = "upload file is empty"
-	FILE_TYPE_ERROR         = "upload file type error"
-	MP4_TYPE                = "video/mp4"
+	STATUS_SUCCESS                    = "0"
+	STATUS_FAIL                       = "1"
+	STATUS_FAIL_TOKEN_MSG             = "Token is invalid"
+	STATUS_FAIL_TOOLONG_MSG           = "Username must less than 32 characters"
+	STATUS_SUCCESS_MSG                = "OK"
+	STATUS_FAIL_PARAM_MSG             = "Request parameter error"
+	USER_NO_LOGIN                     = "0" // 需保证不出现 id 为 0 的用户
+	STATUS_FAIL_FOLLOW_SELF           = "Follow yourself is not allowed"
+	FILE_EMPTY_ERROR                  = "upload file is empty"
+	FILE_TYPE_ERROR                   = "upload file type error"
+	MP4_TYPE                          = "video/mp4"
+	NICKNAME_MAX_LENGTH               = 32
+	SIGNATURE_MAX_LENGTH              = 128
+	STATUS_FAIL_NICKNAME_TOOLONG_MSG  = "Nickname must less than 32 characters"
+	STATUS_FAIL_SIGNATURE_TOOLONG_MSG = "Signature must less than 128 characters"
+	STATUS_FAIL_TITLE_SENSITIVE_MSG   = "Title contains sensitive words"
+	OP_SEND_MESSAGE                   = "1"
+	STATUS_FAIL_PERMISSION_MSG        = "Permission denied"
+	ADMIN_BAN_USER                    = "1"
+	ADMIN_UNBAN_USER                  = "2"
+	PLAY_START                        = "1"
+	PLAY_COMPLETE                     = "2"
+	STATUS_FAIL_VIDEO_NOT_FOUND_MSG   = "Video does not exist"
+	HISTORY_PAUSE                     = "1"
+	HISTORY_RESUME                    = "2"
+	PUBLISH_NOW                       = "0"
+	PUBLISH_DRAFT                     = "1"
+	PUBLISH_SCHEDULE                  = "2"
+	STATUS_FAIL_PUBLISH_TIME_MSG      = "Publish type is invalid, or publish time is not in the next 30 days"
+	STATUS_FAIL_FILE_SIZE_MSG         = "Video file is too large"
+	STATUS_FAIL_DURATION_MSG          = "Video is too long"
+	STATUS_FAIL_UPLOAD_QUOTA_MSG      = "Daily upload limit reached"
 )
+
+// VIDEO_VISIBILITIES 视频可见范围：0-公开，1-粉丝可见，2-互关好友可见，3-仅自己可见
+var VIDEO_VISIBILITIES = map[string]bool{
+	"0": true,
+	"1": true,
+	"2": true,
+	"3": true,
+}
+
+// IMAGE_TYPES 允许上传的头像与背景图类型
+var IMAGE_TYPES = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
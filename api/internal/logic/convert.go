@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/api/internal/types"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+)
+
+// userFromUserRpc 将用户服务返回的 User 转换为接口返回的 User
+func userFromUserRpc(u *userrpc.User) types.User {
+	return types.User{
+		FollowCount:     u.FollowCount,
+		FollowerCount:   u.FollowerCount,
+		ID:              u.ID,
+		IsFollow:        u.IsFollow,
+		Name:            u.Name,
+		Nickname:        u.Nickname,
+		Avatar:          u.Avatar,
+		BackgroundImage: u.BackgroundImage,
+		Signature:       u.Signature,
+		TotalFavorited:  u.TotalFavorited,
+		WorkCount:       u.WorkCount,
+		FavoriteCount:   u.FavoriteCount,
+	}
+}
+
+// videoFromVideoRpc 将视频服务返回的 Video 转换为接口返回的 Video
+func videoFromVideoRpc(v *videorpc.Video) types.Video {
+	return types.Video{
+		Author:        userFromVideoRpc(v.Author),
+		CommentCount:  v.CommentCount,
+		CoverURL:      v.CoverURL,
+		FavoriteCount: v.FavoriteCount,
+		ViewCount:     v.ViewCount,
+		ShareCount:    v.ShareCount,
+		ID:            v.ID,
+		IsFavorite:    v.IsFavorite,
+		PlayURL:       v.PlayURL,
+		DownloadURL:   v.DownloadURL,
+		PreviewURL:    v.PreviewURL,
+		SpriteURL:     v.SpriteURL,
+		SpriteVTTURL:  v.SpriteVTTURL,
+		Title:         v.Title,
+	}
+}
+
+// userFromVideoRpc 将视频服务返回的 User 转换为接口返回的 User
+func userFromVideoRpc(u *videorpc.User) types.User {
+	return types.User{
+		FollowCount:     u.FollowCount,
+		FollowerCount:   u.FollowerCount,
+		ID:              u.ID,
+		IsFollow:        u.IsFollow,
+		Name:            u.Name,
+		Nickname:        u.Nickname,
+		Avatar:          u.Avatar,
+		BackgroundImage: u.BackgroundImage,
+		Signature:       u.Signature,
+		TotalFavorited:  u.TotalFavorited,
+		WorkCount:       u.WorkCount,
+		FavoriteCount:   u.FavoriteCount,
+	}
+}
+
+// videoFromAdminRpc 将管理服务返回的 Video 转换为接口返回的 Video，附带审核状态与原因
+func videoFromAdminRpc(v *adminrpc.Video) types.Video {
+	return types.Video{
+		Author: types.User{
+			ID:   v.AuthorId,
+			Name: v.AuthorName,
+		},
+		CoverURL:     v.CoverURL,
+		ID:           v.ID,
+		PlayURL:      v.PlayURL,
+		Title:        v.Title,
+		Status:       v.Status,
+		ReviewReason: v.ReviewReason,
+	}
+}
+
+// collectionFromVideoRpc 将视频服务返回的 Collection 转换为接口返回的 Collection
+func collectionFromVideoRpc(c *videorpc.Collection) *types.Collection {
+	if c == nil {
+		return nil
+	}
+	return &types.Collection{
+		ID:         c.Id,
+		UserId:     c.UserId,
+		Name:       c.Name,
+		IsPublic:   c.IsPublic,
+		VideoCount: c.VideoCount,
+		CreateTime: c.CreateTime,
+		UpdateTime: c.UpdateTime,
+	}
+}
@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatorStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreatorStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatorStatsLogic {
+	return &CreatorStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CreatorStats 获取当前用户的账号或其视频在日期范围内的每日统计
+func (l *CreatorStatsLogic) CreatorStats(req *types.CreatorStatsReq) (resp *types.CreatorStatsResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.CreatorStatsResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	var videoId uint64
+	if req.VideoId != "" {
+		videoId, err = strconv.ParseUint(req.VideoId, 10, 64)
+		if err != nil {
+			return &types.CreatorStatsResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+			}, nil
+		}
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetCreatorStats(l.ctx, &videorpc.CreatorStatsReq{
+		UserId:    token.UserID,
+		VideoId:   videoId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.CreatorStatsResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	statList := make([]types.DailyStat, len(r.StatList))
+	for i, s := range r.StatList {
+		statList[i] = types.DailyStat{
+			Date:          s.Date,
+			Likes:         s.Likes,
+			Comments:      s.Comments,
+			Views:         s.Views,
+			Shares:        s.Shares,
+			NewFollowers:  s.NewFollowers,
+			Unfollows:     s.Unfollows,
+			FollowerTotal: s.FollowerTotal,
+			LikeTotal:     s.LikeTotal,
+		}
+	}
+
+	return &types.CreatorStatsResp{
+		Response: types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		StatList: statList,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCommentLogic {
+	return &DeleteCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DeleteComment 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *DeleteCommentLogic) DeleteComment(req *types.DeleteCommentReq) (resp *types.DeleteCommentResp, err error) {
+	r, err := l.svcCtx.AdminRpc.DeleteComment(rbac.WithToken(l.ctx, req.Token), &adminrpc.DeleteCommentReq{
+		CommentId: req.CommentId,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.DeleteCommentResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.DeleteCommentResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DraftActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDraftActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DraftActionLogic {
+	return &DraftActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DraftAction 发布、定时发布、取消定时发布或删除草稿
+func (l *DraftActionLogic) DraftAction(req *types.DraftActionReq) (resp *types.DraftActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.DraftActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil {
+		return &types.DraftActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+	// 定时发布时间的范围由视频服务检查
+	var publishTime int64
+	if req.PublishTime != "" {
+		publishTime, err = strconv.ParseInt(req.PublishTime, 10, 64)
+		if err != nil {
+			return &types.DraftActionResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+			}, nil
+		}
+	}
+
+	r, err := l.svcCtx.VideoRpc.DraftAction(l.ctx, &videorpc.DraftActionReq{
+		UserId:      token.UserID,
+		VideoId:     videoId,
+		ActionType:  req.ActionType,
+		PublishTime: publishTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DraftActionResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DraftListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDraftListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DraftListLogic {
+	return &DraftListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DraftList 获取当前用户的草稿与定时发布的视频
+func (l *DraftListLogic) DraftList(req *types.DraftListReq) (resp *types.DraftListResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.DraftListResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetDraftList(l.ctx, &videorpc.DraftListReq{UserId: token.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	draftList := make([]types.Draft, len(r.DraftList))
+	for i, d := range r.DraftList {
+		draftList[i] = types.Draft{
+			ID:           d.ID,
+			Title:        d.Title,
+			PlayURL:      d.PlayURL,
+			CoverURL:     d.CoverURL,
+			Status:       d.Status,
+			ReviewReason: d.ReviewReason,
+			Visibility:   d.Visibility,
+			PublishState: d.PublishState,
+			PublishTime:  d.PublishTime,
+			CreateTime:   d.CreateTime,
+		}
+	}
+
+	return &types.DraftListResp{
+		Response:  types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		DraftList: draftList,
+	}, nil
+}
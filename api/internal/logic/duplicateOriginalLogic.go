@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DuplicateOriginalLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDuplicateOriginalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DuplicateOriginalLogic {
+	return &DuplicateOriginalLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DuplicateOriginal 重复投稿的原视频，权限检查由管理服务完成，token 通过 metadata 传递
+func (l *DuplicateOriginalLogic) DuplicateOriginal(req *types.DuplicateOriginalReq) (resp *types.DuplicateOriginalResp, err error) {
+	r, err := l.svcCtx.AdminRpc.GetDuplicateOriginal(rbac.WithToken(l.ctx, req.Token), &adminrpc.DuplicateOriginalReq{
+		VideoId: req.VideoId,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.DuplicateOriginalResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	resp = &types.DuplicateOriginalResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		Score: r.Score,
+	}
+	if r.Original != nil {
+		original := videoFromAdminRpc(r.Original)
+		resp.Original = &original
+	}
+	return resp, nil
+}
@@ -69,19 +69,19 @@ func (l *FeedLogic) Feed(req *types.FeedReq) (resp *types.FeedResp, err error) {
 	videoList := make([]types.Video, len(r.VideoList))
 	for i, v := range r.VideoList {
 		videoList[i] = types.Video{
-			Author: types.User{
-				FollowCount:   v.Author.FollowCount,
-				FollowerCount: v.Author.FollowerCount,
-				ID:            v.Author.ID,
-				IsFollow:      v.Author.IsFollow,
-				Name:          v.Author.Name,
-			},
+			Author:        userFromVideoRpc(v.Author),
 			CommentCount:  v.CommentCount,
 			CoverURL:      v.CoverURL,
 			FavoriteCount: v.FavoriteCount,
+			ViewCount:     v.ViewCount,
+			ShareCount:    v.ShareCount,
 			ID:            v.ID,
 			IsFavorite:    v.IsFavorite,
 			PlayURL:       v.PlayURL,
+			DownloadURL:   v.DownloadURL,
+			PreviewURL:    v.PreviewURL,
+			SpriteURL:     v.SpriteURL,
+			SpriteVTTURL:  v.SpriteVTTURL,
 			Title:         v.Title,
 		}
 	}
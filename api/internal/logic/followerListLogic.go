@@ -45,13 +45,7 @@ func (l *FollowerListLogic) FollowerList(req *types.FollowerListReq) (resp *type
 
 	var userList []types.User
 	for _, v := range r.UserList {
-		u := types.User{
-			FollowCount:   v.FollowCount,
-			FollowerCount: v.FollowerCount,
-			ID:            v.ID,
-			IsFollow:      v.IsFollow,
-			Name:          v.Name,
-		}
+		u := userFromUserRpc(v)
 		userList = append(userList, u)
 	}
 
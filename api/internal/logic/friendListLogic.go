@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FriendListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewFriendListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FriendListLogic {
+	return &FriendListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *FriendListLogic) FriendList(req *types.FriendListReq) (resp *types.FriendListResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.FriendListResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+			UserList: nil,
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.FriendList(l.ctx, &userrpc.FriendListReq{UserId: userid, ToUserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	var userList []types.FriendUser
+	for _, v := range r.UserList {
+		u := types.FriendUser{
+			User:    userFromUserRpc(v.User),
+			Message: v.Message,
+			MsgType: v.MsgType,
+		}
+		userList = append(userList, u)
+	}
+
+	return &types.FriendListResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		UserList: userList,
+	}, nil
+}
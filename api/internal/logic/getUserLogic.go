@@ -49,13 +49,8 @@ func (l *GetUserLogic) GetUser(req *types.GetUserReq) (resp *types.GetUserResp,
 	if r.User == nil {
 		userInfo = nil
 	} else {
-		userInfo = &types.User{
-			FollowCount:   r.User.FollowCount,
-			FollowerCount: r.User.FollowerCount,
-			ID:            r.User.ID,
-			IsFollow:      r.User.IsFollow,
-			Name:          r.User.Name,
-		}
+		u := userFromUserRpc(r.User)
+		userInfo = &u
 	}
 
 	return &types.GetUserResp{
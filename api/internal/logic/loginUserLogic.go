@@ -39,6 +39,7 @@ func (l *LoginUserLogic) LoginUser(req *types.LoginReq) (resp *types.LoginResp,
 		tokenResp, err := l.svcCtx.JwtRpc.CreateToken(l.ctx, &Jwt.CreateTokenReq{
 			UserID:       strconv.FormatUint(r.UserID, 10),
 			AccessExpire: l.svcCtx.Config.JwtConfig.AccessExpire,
+			Role:         r.Role,
 		})
 		if err != nil {
 			return nil, err
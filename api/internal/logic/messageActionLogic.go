@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MessageActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewMessageActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MessageActionLogic {
+	return &MessageActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MessageActionLogic) MessageAction(req *types.MessageActionReq) (resp *types.MessageActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.MessageActionResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	if req.ActionType != OP_SEND_MESSAGE {
+		return &types.MessageActionResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_PARAM_MSG,
+			},
+		}, nil
+	}
+
+	r, err := l.svcCtx.UserRpc.SendMessage(l.ctx, &userrpc.SendMessageReq{
+		UserId:   userid,
+		ToUserId: req.ToUserId,
+		Content:  req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MessageActionResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MessageChatLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewMessageChatLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MessageChatLogic {
+	return &MessageChatLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MessageChatLogic) MessageChat(req *types.MessageChatReq) (resp *types.MessageChatResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.MessageChatResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.ListMessages(l.ctx, &userrpc.ListMessagesReq{
+		UserId:     userid,
+		ToUserId:   req.ToUserId,
+		PreMsgTime: req.PreMsgTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messageList := make([]types.Message, len(r.MessageList))
+	for i, v := range r.MessageList {
+		messageList[i] = types.Message{
+			ID:         v.ID,
+			ToUserID:   v.ToUserID,
+			FromUserID: v.FromUserID,
+			Content:    v.Content,
+			CreateTime: v.CreateTime,
+		}
+	}
+
+	return &types.MessageChatResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		MessageList: messageList,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MuteActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewMuteActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MuteActionLogic {
+	return &MuteActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MuteActionLogic) MuteAction(req *types.MuteActionReq) (resp *types.MuteActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.MuteActionResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.MuteAction(l.ctx, &userrpc.MuteActionReq{UserId: userid, ToUserId: req.ToUserId, ActionType: req.ActionType})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MuteActionResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
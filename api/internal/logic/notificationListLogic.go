@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotificationListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewNotificationListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotificationListLogic {
+	return &NotificationListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *NotificationListLogic) NotificationList(req *types.NotificationListReq) (resp *types.NotificationListResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.NotificationListResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.ListNotifications(l.ctx, &userrpc.ListNotificationsReq{
+		UserId: userid,
+		Type:   req.Type,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notificationList := make([]types.Notification, len(r.NotificationList))
+	for i, v := range r.NotificationList {
+		notificationList[i] = types.Notification{
+			ID:         v.ID,
+			Type:       v.Type,
+			TargetID:   v.TargetID,
+			ActorCount: v.ActorCount,
+			Content:    v.Content,
+			IsRead:     v.IsRead,
+			CreateTime: v.CreateTime,
+			UpdateTime: v.UpdateTime,
+		}
+		if v.Actor != nil {
+			actor := userFromUserRpc(v.Actor)
+			notificationList[i].Actor = &actor
+		}
+	}
+
+	return &types.NotificationListResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		NotificationList: notificationList,
+		NextCursor:       r.NextCursor,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+	"strconv"
+	"strings"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotificationReadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewNotificationReadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotificationReadLogic {
+	return &NotificationReadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *NotificationReadLogic) NotificationRead(req *types.NotificationReadReq) (resp *types.NotificationReadResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.NotificationReadResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	// 通知 id 以逗号分隔
+	var ids []uint64
+	if req.NotificationIds != "" {
+		for _, s := range strings.Split(req.NotificationIds, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return &types.NotificationReadResp{
+					Response: types.Response{
+						StatusCode: STATUS_FAIL,
+						StatusMsg:  STATUS_FAIL_PARAM_MSG,
+					},
+				}, nil
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	r, err := l.svcCtx.UserRpc.MarkNotificationsRead(l.ctx, &userrpc.MarkNotificationsReadReq{
+		UserId: userid,
+		Ids:    ids,
+		Type:   req.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.NotificationReadResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+	}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotificationUnreadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewNotificationUnreadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotificationUnreadLogic {
+	return &NotificationUnreadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *NotificationUnreadLogic) NotificationUnread(req *types.NotificationUnreadReq) (resp *types.NotificationUnreadResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.NotificationUnreadResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.UserRpc.UnreadNotificationCount(l.ctx, &userrpc.UnreadNotificationCountReq{
+		UserId: userid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.NotificationUnreadResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		Total:       r.Total,
+		UnreadCount: r.UnreadCount,
+	}, nil
+}
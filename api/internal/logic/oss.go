@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"Mini-Tiktok/api/internal/svc"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/hashicorp/go-uuid"
+	"io"
+)
+
+// uploadOss 将文件以随机文件名上传到 OSS 的 path 路径下
+// 返回值 string 为上传后的 object key
+func uploadOss(svcCtx *svc.ServiceContext, path string, file io.Reader) (string, error) {
+	endpoint := svcCtx.Config.AliyunOss.Endpoint
+	accessKeyId := svcCtx.Config.AliyunOss.AccessKeyId
+	accessKeySecret := svcCtx.Config.AliyunOss.AccessKeySecret
+
+	cli, err := oss.New(endpoint, accessKeyId, accessKeySecret)
+	if err != nil {
+		return "", err
+	}
+
+	bucket, err := cli.Bucket(svcCtx.Config.AliyunOss.VideoBucket)
+	if err != nil {
+		return "", err
+	}
+	fileName, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+	ossObjKey := path + fileName
+
+	err = bucket.PutObject(ossObjKey, file, oss.Checkpoint(true, ""))
+	if err != nil {
+		return "", err
+	}
+	return ossObjKey, nil
+}
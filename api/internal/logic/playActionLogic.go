@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PlayActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// PlayMsg 播放事件，由视频服务的消费者统计播放次数、完播率与独立观众数
+type PlayMsg struct {
+	VideoId    uint64 `json:"video_id"`
+	UserId     uint64 `json:"user_id"`
+	Viewer     string `json:"viewer,omitempty"`
+	ActionType string `json:"action_type"`
+	Duration   int64  `json:"duration"`
+	CreateTime int64  `json:"create_time"`
+}
+
+func NewPlayActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlayActionLogic {
+	return &PlayActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PlayAction 客户端开始播放与播放完成时上报，事件写入 kafka 后直接返回，由视频服务异步统计
+// 观众标识：登录用户使用用户 id，未登录时使用设备标识，都没有时不计入独立观众数
+// 只接受公开视频的播放事件，观看时长不能超过 PlayConfig.MaxDuration；同一观众的重复上报由视频服务去重
+func (l *PlayActionLogic) PlayAction(req *types.PlayActionReq) (resp *types.PlayActionResp, err error) {
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil || (req.ActionType != PLAY_START && req.ActionType != PLAY_COMPLETE) ||
+		req.Duration < 0 || req.Duration > l.svcCtx.Config.PlayConfig.MaxDuration {
+		return &types.PlayActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.PlayActionResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	res, err := l.svcCtx.VideoRpc.GetVideoList(l.ctx, &videorpc.VideoListReq{
+		UserId:      userid,
+		VideoIdList: []uint64{videoId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != STATUS_SUCCESS || len(res.VideoList) == 0 {
+		return &types.PlayActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_VIDEO_NOT_FOUND_MSG},
+		}, nil
+	}
+
+	m := PlayMsg{
+		VideoId:    videoId,
+		ActionType: req.ActionType,
+		CreateTime: time.Now().Unix(),
+	}
+	if req.ActionType == PLAY_COMPLETE {
+		m.Duration = req.Duration
+	}
+	if userid != USER_NO_LOGIN {
+		m.UserId, err = strconv.ParseUint(userid, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		m.Viewer = "u:" + userid
+	} else if req.DeviceId != "" {
+		m.Viewer = "d:" + req.DeviceId
+	}
+	marshal, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.PlayWriter.WriteMessages(l.ctx, kafka.Message{
+		Key:   []byte(req.VideoId),
+		Value: marshal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PlayActionResp{
+		Response: types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+	}, nil
+}
@@ -3,15 +3,18 @@ package logic
 import (
 	"Mini-Tiktok/api/internal/svc"
 	"Mini-Tiktok/api/internal/types"
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/common/quota"
+	"Mini-Tiktok/common/schedule"
 	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
 	"context"
 	"encoding/json"
-	"github.com/aliyun/aliyun-oss-go-sdk/oss"
-	"github.com/hashicorp/go-uuid"
 	"github.com/segmentio/kafka-go"
 	"github.com/zeromicro/go-zero/core/logx"
 	"mime/multipart"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 type PublishActionLogic struct {
@@ -21,8 +24,13 @@ type PublishActionLogic struct {
 }
 
 type MsgInfo struct {
-	Title        string `json:"title"`
-	OssObjectKey string `json:"ossObjectKey"`
+	Title        string      `json:"title"`
+	OssObjectKey string      `json:"ossObjectKey"`
+	ReviewWords  []string    `json:"reviewWords,omitempty"` // 标题命中的审核类敏感词，不为空时由投稿服务记录到待审核列表
+	Visibility   string      `json:"visibility,omitempty"`  // 视频可见范围，为空表示公开
+	PublishType  string      `json:"publishType,omitempty"` // 发布方式：为空或 0-立即发布，1-保存为草稿，2-定时发布
+	PublishTime  int64       `json:"publishTime,omitempty"` // 定时发布的时间
+	Quota        quota.Limit `json:"quota"`                 // 作者角色的投稿限额，投稿服务转码前按实际文件再次检查
 }
 
 func NewPublishActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishActionLogic {
@@ -41,7 +49,20 @@ func (l *PublishActionLogic) PublishAction(req *types.PublishReq, formFile multi
 		return nil, err
 	}
 
-	// 2. 检测文件是否为空
+	// 2. 检测文件是否为空，可见范围与发布方式是否合法
+	if req.Visibility != "" && !VIDEO_VISIBILITIES[req.Visibility] {
+		return &types.PublishResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}}, nil
+	}
+	publishTime, ok := checkPublishTime(req.PublishType, req.PublishTime)
+	if !ok {
+		return &types.PublishResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PUBLISH_TIME_MSG,
+		}}, nil
+	}
 	if formFile == nil {
 		return &types.PublishResp{Response: types.Response{
 			StatusCode: STATUS_FAIL,
@@ -50,7 +71,7 @@ func (l *PublishActionLogic) PublishAction(req *types.PublishReq, formFile multi
 	}
 
 	// 3. 检测文件类型是否为 mp4
-	ok, err := IsFileTypeMP4(formFile)
+	ok, err = IsFileTypeMP4(formFile)
 	if err != nil {
 		return nil, err
 	}
@@ -63,19 +84,74 @@ func (l *PublishActionLogic) PublishAction(req *types.PublishReq, formFile multi
 		}, nil
 	}
 
+	// 4. 标题敏感词检查：命中拒绝类敏感词直接返回，屏蔽类敏感词替换为 *
+	check := l.svcCtx.Moderation.Check(req.Title)
+	if check.Action == moderation.ACTION_REJECT {
+		return &types.PublishResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TITLE_SENSITIVE_MSG,
+			},
+		}, nil
+	}
+
 	userid := token.UserID
-	title := req.Title
+	title := check.Text
+
+	// 5. 按角色检查投稿限额：文件大小、时长（文件头无法解析时由投稿服务在转码前检查）与当天投稿次数
+	limit := l.svcCtx.Config.Quota.For(token.Role)
+	size, err := fileSize(formFile)
+	if err != nil {
+		return nil, err
+	}
+	if !limit.AllowBytes(size) {
+		return &types.PublishResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_FILE_SIZE_MSG,
+		}}, nil
+	}
+	if duration, ok := mp4Duration(formFile, size); ok && !limit.AllowDuration(duration) {
+		return &types.PublishResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_DURATION_MSG,
+		}}, nil
+	}
+	ok, err = reserveUpload(l.svcCtx, userid, limit)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.PublishResp{Response: types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_UPLOAD_QUOTA_MSG,
+		}}, nil
+	}
+	// 之后的步骤失败时归还占用的投稿次数，归还失败只记录日志
+	defer func() {
+		if err != nil {
+			if e := releaseUpload(l.svcCtx, userid, limit); e != nil {
+				l.Errorf("release upload quota for user %s: %v", userid, e)
+			}
+		}
+	}()
 
-	// 4. 将文件上传至 OSS
+	// 6. 将文件上传至 OSS
 	ossObjKey, err := l.UploadFormFile(formFile)
 	if err != nil {
 		return nil, err
 	}
 
-	// 5. 将视频转码请求写入 kafka，随后可以马上返回客户端了（所以返回后客户端会延迟一段时间，等待服务处理完成后才可看到新视频）
+	// 7. 将视频转码请求写入 kafka，随后可以马上返回客户端了（所以返回后客户端会延迟一段时间，等待服务处理完成后才可看到新视频）
 	m := MsgInfo{
 		Title:        title,
 		OssObjectKey: ossObjKey,
+		Visibility:   req.Visibility,
+		PublishType:  req.PublishType,
+		PublishTime:  publishTime,
+		Quota:        limit,
+	}
+	if check.Action == moderation.ACTION_REVIEW {
+		m.ReviewWords = check.Words
 	}
 	marshal, err := json.Marshal(m)
 	if err != nil {
@@ -100,30 +176,23 @@ func (l *PublishActionLogic) PublishAction(req *types.PublishReq, formFile multi
 
 // UploadFormFile 将文件上传到OSS
 func (l *PublishActionLogic) UploadFormFile(formFile multipart.File) (string, error) {
-	endpoint := l.svcCtx.Config.AliyunOss.Endpoint
-	accessKeyId := l.svcCtx.Config.AliyunOss.AccessKeyId
-	accessKeySecret := l.svcCtx.Config.AliyunOss.AccessKeySecret
-
-	cli, err := oss.New(endpoint, accessKeyId, accessKeySecret)
-	if err != nil {
-		return "", err
-	}
-
-	bucket, err := cli.Bucket(l.svcCtx.Config.AliyunOss.VideoBucket)
-	if err != nil {
-		return "", err
-	}
-	fileName, err := uuid.GenerateUUID()
-	if err != nil {
-		return "", err
-	}
-	ossObjKey := l.svcCtx.Config.AliyunOss.VideoPath + fileName
+	return uploadOss(l.svcCtx, l.svcCtx.Config.AliyunOss.VideoPath, formFile)
+}
 
-	err = bucket.PutObject(ossObjKey, formFile, oss.Checkpoint(true, ""))
-	if err != nil {
-		return "", err
-	}
-	return ossObjKey, nil
+// checkPublishTime 检查发布方式与定时发布的时间，定时发布的时间需要晚于当前时间且不超过 schedule.MAX_AHEAD 秒
+// 返回值 int64 为定时发布的时间，非定时发布时为 0
+func checkPublishTime(publishType, publishTime string) (int64, bool) {
+	switch publishType {
+	case "", PUBLISH_NOW, PUBLISH_DRAFT:
+		return 0, true
+	case PUBLISH_SCHEDULE:
+		t, err := strconv.ParseInt(publishTime, 10, 64)
+		if err != nil || !schedule.IsValidPublishTime(t, time.Now().Unix()) {
+			return 0, false
+		}
+		return t, true
+	}
+	return 0, false
 }
 
 // IsFileTypeMP4 检查上传文件的前 512 字节来判断文件类型是否为 MP4
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishQuotaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPublishQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishQuotaLogic {
+	return &PublishQuotaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PublishQuota 获取当前用户角色对应的投稿限额与今天剩余的投稿次数
+func (l *PublishQuotaLogic) PublishQuota(req *types.PublishQuotaReq) (resp *types.PublishQuotaResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.PublishQuotaResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	limit := l.svcCtx.Config.Quota.For(token.Role)
+	used, err := usedUploads(l.svcCtx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	remaining := int64(UNLIMITED_UPLOADS)
+	if limit.DailyUploads > 0 {
+		remaining = limit.DailyUploads - used
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+
+	return &types.PublishQuotaResp{
+		Response:         types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		MaxBytes:         limit.MaxBytes,
+		MaxDuration:      limit.MaxDuration,
+		DailyUploads:     limit.DailyUploads,
+		UsedUploads:      used,
+		RemainingUploads: remaining,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"context"
+	"net/http"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PushLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPushLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PushLogic {
+	return &PushLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Push 鉴权通过后将请求升级为 WebSocket 连接，之后该用户的实时事件（新私信，新评论，新粉丝，投稿完成）都会通过该连接推送
+// 鉴权失败时返回 resp，不升级连接；升级成功后返回的 resp 为 nil
+func (l *PushLogic) Push(req *types.PushReq, w http.ResponseWriter, r *http.Request) (resp *types.Response, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.Response{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+		}, nil
+	}
+
+	userid, err := strconv.ParseUint(token.UserID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// 升级失败时 Upgrader 已向客户端返回错误响应
+	if err = l.svcCtx.PushHub.Serve(w, r, userid); err != nil {
+		l.Errorf("upgrade websocket for user %d: %v", userid, err)
+	}
+	return nil, nil
+}
@@ -0,0 +1,165 @@
+package logic
+
+import (
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/common/quota"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	UPLOAD_COUNT_PREFIX = "Quota:Upload:" // 用户每天投稿次数的 key 前缀，后接用户 id 与日期
+	UPLOAD_COUNT_TTL    = 2 * 24 * 3600   // 投稿次数的过期时间，保留到第二天结束
+	MP4_MAX_BOX_DEPTH   = 2               // 查找 mvhd 时只需遍历顶层 box 与 moov 的子 box
+	UNLIMITED_UPLOADS   = -1              // 不限制投稿次数时返回的剩余次数
+)
+
+// reserveUploadScript 占用一次当天的投稿次数，超出限额时撤销并返回 -1，否则返回占用后的次数
+var reserveUploadScript = redis.NewScript(1, `
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+if n > tonumber(ARGV[1]) then
+	redis.call('DECR', KEYS[1])
+	return -1
+end
+return n
+`)
+
+// uploadCountKey 返回用户当天投稿次数的 key
+func uploadCountKey(userid string) string {
+	return UPLOAD_COUNT_PREFIX + userid + ":" + time.Now().Format("20060102")
+}
+
+// reserveUpload 占用一次当天的投稿次数，超出限额时返回 false，不限制投稿次数时不计数
+// 投稿次数在网关接受投稿时计数，之后投稿服务转码失败或拒绝投稿时不归还
+func reserveUpload(svcCtx *svc.ServiceContext, userid string, limit quota.Limit) (bool, error) {
+	if limit.DailyUploads == 0 {
+		return true, nil
+	}
+	conn := svcCtx.Redis.Get()
+	defer conn.Close()
+	n, err := redis.Int64(reserveUploadScript.Do(conn, uploadCountKey(userid), limit.DailyUploads, UPLOAD_COUNT_TTL))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// releaseUpload 投稿请求处理失败时归还占用的投稿次数
+func releaseUpload(svcCtx *svc.ServiceContext, userid string, limit quota.Limit) error {
+	if limit.DailyUploads == 0 {
+		return nil
+	}
+	conn := svcCtx.Redis.Get()
+	defer conn.Close()
+	_, err := conn.Do("DECR", uploadCountKey(userid))
+	return err
+}
+
+// usedUploads 返回用户当天已投稿的次数
+func usedUploads(svcCtx *svc.ServiceContext, userid string) (int64, error) {
+	conn := svcCtx.Redis.Get()
+	defer conn.Close()
+	n, err := redis.Int64(conn.Do("GET", uploadCountKey(userid)))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return n, err
+}
+
+// fileSize 返回上传文件的字节数，并将读取位置重置到文件开头
+func fileSize(f io.Seeker) (int64, error) {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	return size, err
+}
+
+// mp4Duration 从 mp4 文件 moov box 中的 mvhd box 读取视频时长（秒），并将读取位置重置到文件开头
+// 文件结构无法解析时返回 false，由投稿服务在转码前用 ffprobe 检查时长
+func mp4Duration(f io.ReadSeeker, size int64) (float64, bool) {
+	defer f.Seek(0, io.SeekStart)
+	return findMvhd(f, 0, size, 0)
+}
+
+// findMvhd 在 [start, end) 范围内逐个读取 box 头，找到 moov 时进入其子 box 查找 mvhd
+func findMvhd(f io.ReadSeeker, start, end int64, depth int) (float64, bool) {
+	header := make([]byte, 16)
+	for offset := start; offset+8 <= end; {
+		_, err := f.Seek(offset, io.SeekStart)
+		if err != nil {
+			return 0, false
+		}
+		_, err = io.ReadFull(f, header[:8])
+		if err != nil {
+			return 0, false
+		}
+		boxSize := int64(binary.BigEndian.Uint32(header[:4]))
+		boxType := string(header[4:8])
+		headerSize := int64(8)
+		switch boxSize {
+		case 0: // box 延伸到文件末尾
+			boxSize = end - offset
+		case 1: // 64 位的 box 大小
+			_, err = io.ReadFull(f, header[8:16])
+			if err != nil {
+				return 0, false
+			}
+			boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		}
+		if boxSize < headerSize || offset+boxSize > end {
+			return 0, false
+		}
+
+		switch {
+		case boxType == "moov" && depth < MP4_MAX_BOX_DEPTH:
+			return findMvhd(f, offset+headerSize, offset+boxSize, depth+1)
+		case boxType == "mvhd":
+			return readMvhd(f, boxSize-headerSize)
+		}
+		offset += boxSize
+	}
+	return 0, false
+}
+
+// readMvhd 读取 mvhd box 的内容，version 0 的时间字段为 32 位，version 1 为 64 位
+func readMvhd(f io.Reader, size int64) (float64, bool) {
+	if size < 20 {
+		return 0, false
+	}
+	body := make([]byte, 32)
+	if size < int64(len(body)) {
+		body = body[:size]
+	}
+	_, err := io.ReadFull(f, body)
+	if err != nil {
+		return 0, false
+	}
+
+	var timescale, duration uint64
+	switch body[0] {
+	case 0:
+		timescale = uint64(binary.BigEndian.Uint32(body[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(body[16:20]))
+	case 1:
+		if len(body) < 32 {
+			return 0, false
+		}
+		timescale = uint64(binary.BigEndian.Uint32(body[20:24]))
+		duration = binary.BigEndian.Uint64(body[24:32])
+	default:
+		return 0, false
+	}
+	if timescale == 0 {
+		return 0, false
+	}
+	return float64(duration) / float64(timescale), true
+}
@@ -0,0 +1,118 @@
+package logic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+// box 生成 32 位大小的 mp4 box
+func box(boxType string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	b := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(b[:4], uint32(8+len(body)))
+	copy(b[4:8], boxType)
+	return append(b, body...)
+}
+
+// largeBox 生成 64 位大小（size 字段为 1）的 mp4 box
+func largeBox(boxType string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	b := make([]byte, 16, 16+len(body))
+	binary.BigEndian.PutUint32(b[:4], 1)
+	copy(b[4:8], boxType)
+	binary.BigEndian.PutUint64(b[8:16], uint64(16+len(body)))
+	return append(b, body...)
+}
+
+// toEndBox 生成 size 字段为 0（延伸到文件末尾）的 mp4 box
+func toEndBox(boxType string, payload ...[]byte) []byte {
+	b := box(boxType, payload...)
+	binary.BigEndian.PutUint32(b[:4], 0)
+	return b
+}
+
+// mvhdV0 生成 version 0 的 mvhd 内容：version/flags，创建与修改时间，timescale，duration 均为 32 位
+func mvhdV0(timescale, duration uint32) []byte {
+	b := make([]byte, 100)
+	binary.BigEndian.PutUint32(b[12:16], timescale)
+	binary.BigEndian.PutUint32(b[16:20], duration)
+	return b
+}
+
+// mvhdV1 生成 version 1 的 mvhd 内容：创建与修改时间、duration 为 64 位
+func mvhdV1(timescale uint32, duration uint64) []byte {
+	b := make([]byte, 112)
+	b[0] = 1
+	binary.BigEndian.PutUint32(b[20:24], timescale)
+	binary.BigEndian.PutUint64(b[24:32], duration)
+	return b
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func TestMp4Duration(t *testing.T) {
+	ftyp := box("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	mdat := box("mdat", make([]byte, 64))
+
+	cases := []struct {
+		name     string
+		file     []byte
+		duration float64
+		ok       bool
+	}{
+		{"mvhd version 0", concat(ftyp, box("moov", box("mvhd", mvhdV0(1000, 12500)))), 12.5, true},
+		{"mvhd version 1", concat(ftyp, box("moov", box("mvhd", mvhdV1(90000, 90000*3600)))), 3600, true},
+		{"moov after mdat", concat(ftyp, mdat, box("moov", box("mvhd", mvhdV0(600, 1800)))), 3, true},
+		{"mvhd after other moov children", concat(ftyp, box("moov", box("udta", make([]byte, 10)), box("mvhd", mvhdV0(1, 7)))), 7, true},
+		{"64-bit mdat size", concat(ftyp, largeBox("mdat", make([]byte, 32)), box("moov", box("mvhd", mvhdV0(1, 9)))), 9, true},
+		{"64-bit moov size", concat(ftyp, largeBox("moov", box("mvhd", mvhdV1(2, 10)))), 5, true},
+		{"size 0 moov extends to end of file", concat(ftyp, toEndBox("moov", box("mvhd", mvhdV0(4, 10)))), 2.5, true},
+		{"size 0 mdat hides later boxes", concat(ftyp, toEndBox("mdat", make([]byte, 16)), box("moov", box("mvhd", mvhdV0(1, 1)))), 0, false},
+		{"no moov", concat(ftyp, mdat), 0, false},
+		{"top-level mvhd", concat(ftyp, box("mvhd", mvhdV0(1, 4))), 4, true},
+		{"empty file", nil, 0, false},
+		{"truncated box header", concat(ftyp, []byte{0, 0, 0}), 0, false},
+		{"box larger than file", concat(ftyp, box("moov", box("mvhd", mvhdV0(1, 1)))[:40]), 0, false},
+		{"box size smaller than header", concat(ftyp, []byte{0, 0, 0, 4, 'm', 'o', 'o', 'v'}), 0, false},
+		{"64-bit size smaller than header", concat(ftyp, func() []byte {
+			b := largeBox("moov")
+			binary.BigEndian.PutUint64(b[8:16], 8)
+			return b
+		}()), 0, false},
+		{"truncated 64-bit size", concat(ftyp, []byte{0, 0, 0, 1, 'm', 'o', 'o', 'v', 0, 0}), 0, false},
+		{"child box larger than moov", concat(ftyp, func() []byte {
+			b := box("moov", box("mvhd", mvhdV0(1, 1)))
+			binary.BigEndian.PutUint32(b[8:12], 200)
+			return b
+		}()), 0, false},
+		{"mvhd too short", concat(ftyp, box("moov", box("mvhd", make([]byte, 19)))), 0, false},
+		{"mvhd version 1 too short", concat(ftyp, box("moov", box("mvhd", mvhdV1(1, 1)[:28]))), 0, false},
+		{"unknown mvhd version", concat(ftyp, box("moov", box("mvhd", append([]byte{2}, make([]byte, 99)...)))), 0, false},
+		{"zero timescale", concat(ftyp, box("moov", box("mvhd", mvhdV0(0, 100)))), 0, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := bytes.NewReader(c.file)
+			duration, ok := mp4Duration(r, int64(len(c.file)))
+			if ok != c.ok || duration != c.duration {
+				t.Errorf("mp4Duration = (%v, %v), want (%v, %v)", duration, ok, c.duration, c.ok)
+			}
+			// 读取位置需要重置到文件开头，之后上传 OSS 时读取完整的文件
+			if pos, _ := r.Seek(0, io.SeekCurrent); pos != 0 {
+				t.Errorf("read position = %d, want 0", pos)
+			}
+		})
+	}
+}
+
+func TestMp4DurationNestedMoov(t *testing.T) {
+	// 只遍历顶层 box 与 moov 的子 box，更深层的 moov 不再进入
+	file := box("moov", box("moov", box("moov", box("mvhd", mvhdV0(1, 5)))))
+	if _, ok := mp4Duration(bytes.NewReader(file), int64(len(file))); ok {
+		t.Errorf("mvhd nested deeper than MP4_MAX_BOX_DEPTH should not be found")
+	}
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RebuildCacheLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRebuildCacheLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RebuildCacheLogic {
+	return &RebuildCacheLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RebuildCache 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *RebuildCacheLogic) RebuildCache(req *types.RebuildCacheReq) (resp *types.RebuildCacheResp, err error) {
+	r, err := l.svcCtx.AdminRpc.RebuildCache(rbac.WithToken(l.ctx, req.Token), &adminrpc.RebuildCacheReq{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.RebuildCacheResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.RebuildCacheResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
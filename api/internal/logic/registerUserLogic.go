@@ -3,6 +3,7 @@ package logic
 import (
 	"Mini-Tiktok/api/internal/svc"
 	"Mini-Tiktok/api/internal/types"
+	"Mini-Tiktok/common/rbac"
 	"Mini-Tiktok/jwt/app/rpc/Jwt"
 	"Mini-Tiktok/user/app/rpc/user"
 	"context"
@@ -27,8 +28,9 @@ func NewRegisterUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Regi
 
 func (l *RegisterUserLogic) RegisterUser(req *types.RegisterReq) (resp *types.RegisterResp, err error) {
 	
-	// 用户名和密码长度不能超过 32 字符（不知道为什么客户端没做这种校验）
-	if len(req.Username) > 32 || len(req.Password) > 32 {
+	// 用户名长度不能超过 32 字符（不知道为什么客户端没做这种校验）
+	// 密码由用户服务按照配置的密码策略进行校验
+	if len(req.Username) > 32 {
 		return &types.RegisterResp{
 			Response: types.Response{
 				StatusCode: STATUS_FAIL,
@@ -54,6 +56,7 @@ func (l *RegisterUserLogic) RegisterUser(req *types.RegisterReq) (resp *types.Re
 		tokenResp, err := l.svcCtx.JwtRpc.CreateToken(l.ctx, &Jwt.CreateTokenReq{
 			UserID:       strconv.FormatUint(r.UserID, 10),
 			AccessExpire: l.svcCtx.Config.JwtConfig.AccessExpire,
+			Role:         rbac.ROLE_USER,
 		})
 		if err != nil {
 			return nil, err
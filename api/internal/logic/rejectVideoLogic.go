@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectVideoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRejectVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectVideoLogic {
+	return &RejectVideoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RejectVideo 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *RejectVideoLogic) RejectVideo(req *types.RejectVideoReq) (resp *types.RejectVideoResp, err error) {
+	r, err := l.svcCtx.AdminRpc.RejectVideo(rbac.WithToken(l.ctx, req.Token), &adminrpc.RejectVideoReq{
+		VideoId: req.VideoId,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.RejectVideoResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.RejectVideoResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReportActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReportActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReportActionLogic {
+	return &ReportActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReportActionLogic) ReportAction(req *types.ReportActionReq) (resp *types.ReportActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.ReportActionResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+	userid := token.UserID
+
+	r, err := l.svcCtx.VideoRpc.ReportAction(l.ctx, &videorpc.ReportReq{
+		UserId:     userid,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Reason:     req.Reason,
+		Content:    req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ReportActionResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReportRestoreLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReportRestoreLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReportRestoreLogic {
+	return &ReportRestoreLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReportRestore 恢复因举报被自动隐藏的目标，权限检查由管理服务完成，token 通过 metadata 传递
+func (l *ReportRestoreLogic) ReportRestore(req *types.ReportRestoreReq) (resp *types.ReportRestoreResp, err error) {
+	r, err := l.svcCtx.AdminRpc.RestoreReportTarget(rbac.WithToken(l.ctx, req.Token), &adminrpc.RestoreReportTargetReq{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.ReportRestoreResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.ReportRestoreResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReportTargetListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReportTargetListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReportTargetListLogic {
+	return &ReportTargetListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReportTargetList 待处理的举报目标列表，权限检查由管理服务完成，token 通过 metadata 传递
+func (l *ReportTargetListLogic) ReportTargetList(req *types.ReportTargetListReq) (resp *types.ReportTargetListResp, err error) {
+	r, err := l.svcCtx.AdminRpc.ListReportTargets(rbac.WithToken(l.ctx, req.Token), &adminrpc.ReportTargetListReq{
+		Status: req.Status,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.ReportTargetListResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	targetList := make([]types.ReportTarget, len(r.TargetList))
+	for i, t := range r.TargetList {
+		targetList[i] = types.ReportTarget{
+			TargetType:  t.TargetType,
+			TargetId:    t.TargetId,
+			OwnerId:     t.OwnerId,
+			ReportCount: t.ReportCount,
+			Status:      t.Status,
+			CreateTime:  t.CreateTime,
+			UpdateTime:  t.UpdateTime,
+		}
+	}
+
+	return &types.ReportTargetListResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		TargetList: targetList,
+		NextCursor: r.NextCursor,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewQueueLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReviewQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewQueueLogic {
+	return &ReviewQueueLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReviewQueue 审核队列，权限检查由管理服务完成，token 通过 metadata 传递
+func (l *ReviewQueueLogic) ReviewQueue(req *types.ReviewQueueReq) (resp *types.ReviewQueueResp, err error) {
+	r, err := l.svcCtx.AdminRpc.ListReviewQueue(rbac.WithToken(l.ctx, req.Token), &adminrpc.ReviewQueueReq{
+		Status: req.Status,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.ReviewQueueResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	videoList := make([]types.Video, len(r.VideoList))
+	for i, v := range r.VideoList {
+		videoList[i] = videoFromAdminRpc(v)
+	}
+
+	return &types.ReviewQueueResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		VideoList:  videoList,
+		NextCursor: r.NextCursor,
+	}, nil
+}
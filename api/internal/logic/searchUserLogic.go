@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/search/app/rpc/searchrpc"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSearchUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchUserLogic {
+	return &SearchUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SearchUser 先由搜索服务按相关度返回用户 id，再向用户服务获取用户信息
+func (l *SearchUserLogic) SearchUser(req *types.SearchUserReq) (resp *types.SearchUserResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.SearchUserResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.SearchRpc.SearchUser(l.ctx, &searchrpc.SearchReq{
+		Keyword: req.Keyword,
+		Cursor:  req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.SearchUserResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	userList := make([]types.User, 0, len(r.IdList))
+	for _, id := range r.IdList {
+		u, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
+			UserID:  userid,
+			QueryID: strconv.FormatUint(id, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+		// 用户不存在时跳过
+		if u.StatusCode != STATUS_SUCCESS || u.User == nil {
+			continue
+		}
+		userList = append(userList, userFromUserRpc(u.User))
+	}
+
+	return &types.SearchUserResp{
+		Response:   types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		UserList:   userList,
+		NextCursor: r.NextCursor,
+		HasMore:    r.HasMore,
+	}, nil
+}
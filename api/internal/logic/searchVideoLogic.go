@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/search/app/rpc/searchrpc"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchVideoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSearchVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchVideoLogic {
+	return &SearchVideoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SearchVideo 先由搜索服务按相关度返回视频 id，再向视频服务获取视频信息
+func (l *SearchVideoLogic) SearchVideo(req *types.SearchVideoReq) (resp *types.SearchVideoResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.SearchVideoResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.SearchRpc.SearchVideo(l.ctx, &searchrpc.SearchReq{
+		Keyword: req.Keyword,
+		Cursor:  req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS || len(r.IdList) == 0 {
+		return &types.SearchVideoResp{
+			Response:   types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+			NextCursor: r.NextCursor,
+			HasMore:    r.HasMore,
+		}, nil
+	}
+
+	// 索引更新是异步的，视频服务会再过滤一次未公开的视频，所以一页的结果可能少于搜索服务返回的数量
+	v, err := l.svcCtx.VideoRpc.GetVideoList(l.ctx, &videorpc.VideoListReq{
+		UserId:      userid,
+		VideoIdList: r.IdList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if v.StatusCode != STATUS_SUCCESS {
+		return &types.SearchVideoResp{
+			Response: types.Response{StatusCode: v.StatusCode, StatusMsg: v.StatusMsg},
+		}, nil
+	}
+
+	videoList := make([]types.Video, len(v.VideoList))
+	for i, vid := range v.VideoList {
+		videoList[i] = videoFromVideoRpc(vid)
+	}
+
+	return &types.SearchVideoResp{
+		Response:   types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		VideoList:  videoList,
+		NextCursor: r.NextCursor,
+		HasMore:    r.HasMore,
+	}, nil
+}
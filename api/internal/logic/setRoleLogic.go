@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetRoleLogic {
+	return &SetRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SetRole 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *SetRoleLogic) SetRole(req *types.SetRoleReq) (resp *types.SetRoleResp, err error) {
+	r, err := l.svcCtx.AdminRpc.SetRole(rbac.WithToken(l.ctx, req.Token), &adminrpc.SetRoleReq{
+		UserId: req.UserId,
+		Role:   req.Role,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.SetRoleResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.SetRoleResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareActionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewShareActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareActionLogic {
+	return &ShareActionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ShareAction 分享视频，返回分享链接，分享给好友时以私信发送
+func (l *ShareActionLogic) ShareAction(req *types.ShareActionReq) (resp *types.ShareActionResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.ShareActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil {
+		return &types.ShareActionResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.ShareAction(l.ctx, &videorpc.ShareReq{
+		UserId:   token.UserID,
+		VideoId:  videoId,
+		Channel:  req.Channel,
+		ToUserId: req.ToUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ShareActionResp{
+		Response:   types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		ShareToken: r.ShareToken,
+		ShareURL:   r.ShareURL,
+		ShareCount: r.ShareCount,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareVideoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewShareVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareVideoLogic {
+	return &ShareVideoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ShareVideo 打开分享链接，获取分享 token 对应的视频
+func (l *ShareVideoLogic) ShareVideo(req *types.ShareVideoReq) (resp *types.ShareVideoResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.ShareVideoResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.VideoRpc.ResolveShare(l.ctx, &videorpc.ResolveShareReq{
+		UserId:     userid,
+		ShareToken: req.ShareToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.ShareVideoResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	v := videoFromVideoRpc(r.Video)
+	return &types.ShareVideoResp{
+		Response: types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		Video:    &v,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
+	"Mini-Tiktok/common/rbac"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TakeDownVideoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTakeDownVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TakeDownVideoLogic {
+	return &TakeDownVideoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// TakeDownVideo 权限检查由管理服务完成，token 通过 metadata 传递
+func (l *TakeDownVideoLogic) TakeDownVideo(req *types.TakeDownVideoReq) (resp *types.TakeDownVideoResp, err error) {
+	r, err := l.svcCtx.AdminRpc.TakeDownVideo(rbac.WithToken(l.ctx, req.Token), &adminrpc.TakeDownVideoReq{
+		VideoId: req.VideoId,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		if res, ok := adminDenied(err); ok {
+			return &types.TakeDownVideoResp{Response: res}, nil
+		}
+		return nil, err
+	}
+
+	return &types.TakeDownVideoResp{Response: types.Response{
+		StatusCode: r.StatusCode,
+		StatusMsg:  r.StatusMsg,
+	}}, nil
+}
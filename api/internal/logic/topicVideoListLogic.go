@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TopicVideoListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTopicVideoListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TopicVideoListLogic {
+	return &TopicVideoListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// TopicVideoList 获取话题页：话题信息与话题下按投稿时间或点赞数排序的视频列表
+func (l *TopicVideoListLogic) TopicVideoList(req *types.TopicVideoListReq) (resp *types.TopicVideoListResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.TopicVideoListResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetTopicVideoList(l.ctx, &videorpc.TopicVideoListReq{
+		UserId:        userid,
+		TopicName:     req.Name,
+		SortType:      req.SortType,
+		Cursor:        req.Cursor,
+		CursorVideoId: req.CursorVideoId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.TopicVideoListResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	videoList := make([]types.Video, len(r.VideoList))
+	for i, v := range r.VideoList {
+		videoList[i] = videoFromVideoRpc(v)
+	}
+
+	return &types.TopicVideoListResp{
+		Response: types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		Topic: &types.Topic{
+			ID:         r.Topic.Id,
+			Name:       r.Topic.Name,
+			VideoCount: r.Topic.VideoCount,
+		},
+		VideoList:         videoList,
+		NextCursor:        r.NextCursor,
+		NextCursorVideoId: r.NextCursorVideoId,
+		HasMore:           r.HasMore,
+	}, nil
+}
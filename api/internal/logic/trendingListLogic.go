@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TrendingListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTrendingListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TrendingListLogic {
+	return &TrendingListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// TrendingList 获取最近一小时、一天或一周的热门视频排行榜
+func (l *TrendingListLogic) TrendingList(req *types.TrendingListReq) (resp *types.TrendingListResp, err error) {
+	userid := USER_NO_LOGIN
+	if req.Token != nil {
+		token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: *req.Token})
+		if err != nil {
+			return &types.TrendingListResp{
+				Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+			}, nil
+		}
+		userid = token.UserID
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetTrendingList(l.ctx, &videorpc.TrendingReq{
+		UserId: userid,
+		Window: req.Window,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.TrendingListResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	videoList := make([]types.Video, len(r.VideoList))
+	for i, v := range r.VideoList {
+		videoList[i] = videoFromVideoRpc(v)
+	}
+
+	return &types.TrendingListResp{
+		Response:   types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		VideoList:  videoList,
+		NextCursor: r.NextCursor,
+		HasMore:    r.HasMore,
+	}, nil
+}
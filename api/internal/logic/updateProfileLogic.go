@@ -0,0 +1,151 @@
+package logic
+
+import (
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// UpdateProfile 修改用户资料，头像与背景图为可选上传的图片文件，上传至 OSS 后将 url 写入用户资料
+func (l *UpdateProfileLogic) UpdateProfile(req *types.UpdateProfileReq, avatar, background multipart.File) (resp *types.UpdateProfileResp, err error) {
+	if avatar != nil {
+		defer avatar.Close()
+	}
+	if background != nil {
+		defer background.Close()
+	}
+
+	// 1. token 鉴权
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.UpdateProfileResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_TOKEN_MSG,
+			},
+		}, nil
+	}
+
+	// 2. 检查参数长度，先于上传文件检查以免上传无用的图片
+	if req.Nickname != nil && utf8.RuneCountInString(*req.Nickname) > NICKNAME_MAX_LENGTH {
+		return &types.UpdateProfileResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_NICKNAME_TOOLONG_MSG,
+			},
+		}, nil
+	}
+	if req.Signature != nil && utf8.RuneCountInString(*req.Signature) > SIGNATURE_MAX_LENGTH {
+		return &types.UpdateProfileResp{
+			Response: types.Response{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_SIGNATURE_TOOLONG_MSG,
+			},
+		}, nil
+	}
+
+	// 3. 检查图片类型
+	for _, f := range []multipart.File{avatar, background} {
+		if f == nil {
+			continue
+		}
+		ok, err := IsFileTypeImage(f)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return &types.UpdateProfileResp{
+				Response: types.Response{
+					StatusCode: STATUS_FAIL,
+					StatusMsg:  FILE_TYPE_ERROR,
+				},
+			}, nil
+		}
+	}
+
+	// 4. 将图片上传至 OSS
+	in := &userrpc.UpdateProfileReq{
+		UserId:    token.UserID,
+		Nickname:  req.Nickname,
+		Signature: req.Signature,
+	}
+	if avatar != nil {
+		url, err := l.uploadImage(avatar)
+		if err != nil {
+			return nil, err
+		}
+		in.Avatar = &url
+	}
+	if background != nil {
+		url, err := l.uploadImage(background)
+		if err != nil {
+			return nil, err
+		}
+		in.BackgroundImage = &url
+	}
+
+	// 5. 写入用户资料
+	r, err := l.svcCtx.UserRpc.UpdateProfile(l.ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	var userInfo *types.User
+	if r.User != nil {
+		u := userFromUserRpc(r.User)
+		userInfo = &u
+	}
+
+	return &types.UpdateProfileResp{
+		Response: types.Response{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		},
+		User: userInfo,
+	}, nil
+}
+
+// uploadImage 将图片上传到 OSS，返回图片 url
+func (l *UpdateProfileLogic) uploadImage(file multipart.File) (string, error) {
+	ossObjKey, err := uploadOss(l.svcCtx, l.svcCtx.Config.AliyunOss.ImagePath, file)
+	if err != nil {
+		return "", err
+	}
+	return l.svcCtx.Config.AliyunOss.UrlPrefix + ossObjKey, nil
+}
+
+// IsFileTypeImage 检查上传文件的前 512 字节来判断文件类型是否为允许的图片格式
+func IsFileTypeImage(formFile multipart.File) (bool, error) {
+	buffer := make([]byte, 512)
+	n, err := formFile.Read(buffer)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	_, err = formFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return false, err
+	}
+	return IMAGE_TYPES[http.DetectContentType(buffer[:n])], nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VideoVisibilityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVideoVisibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VideoVisibilityLogic {
+	return &VideoVisibilityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// VideoVisibility 作者修改已发布视频的可见范围
+func (l *VideoVisibilityLogic) VideoVisibility(req *types.VideoVisibilityReq) (resp *types.VideoVisibilityResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.VideoVisibilityResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil || !VIDEO_VISIBILITIES[req.Visibility] {
+		return &types.VideoVisibilityResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.SetVideoVisibility(l.ctx, &videorpc.VideoVisibilityReq{
+		UserId:     token.UserID,
+		VideoId:    videoId,
+		Visibility: req.Visibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VideoVisibilityResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
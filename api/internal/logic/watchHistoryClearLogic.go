@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WatchHistoryClearLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWatchHistoryClearLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchHistoryClearLogic {
+	return &WatchHistoryClearLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// WatchHistoryClear 清空当前用户的观看历史
+func (l *WatchHistoryClearLogic) WatchHistoryClear(req *types.WatchHistoryClearReq) (resp *types.WatchHistoryClearResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.WatchHistoryClearResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.ClearWatchHistory(l.ctx, &videorpc.ClearWatchHistoryReq{UserId: token.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WatchHistoryClearResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
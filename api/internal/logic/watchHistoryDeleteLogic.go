@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WatchHistoryDeleteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWatchHistoryDeleteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchHistoryDeleteLogic {
+	return &WatchHistoryDeleteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// WatchHistoryDelete 删除当前用户的一条观看记录
+func (l *WatchHistoryDeleteLogic) WatchHistoryDelete(req *types.WatchHistoryDeleteReq) (resp *types.WatchHistoryDeleteResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.WatchHistoryDeleteResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	videoId, err := strconv.ParseUint(req.VideoId, 10, 64)
+	if err != nil {
+		return &types.WatchHistoryDeleteResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.DeleteWatchHistory(l.ctx, &videorpc.DeleteWatchHistoryReq{
+		UserId:  token.UserID,
+		VideoId: videoId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WatchHistoryDeleteResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WatchHistoryListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWatchHistoryListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchHistoryListLogic {
+	return &WatchHistoryListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// WatchHistoryList 获取当前用户的观看历史
+func (l *WatchHistoryListLogic) WatchHistoryList(req *types.WatchHistoryListReq) (resp *types.WatchHistoryListResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.WatchHistoryListResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.GetWatchHistory(l.ctx, &videorpc.WatchHistoryReq{
+		UserId: token.UserID,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &types.WatchHistoryListResp{
+			Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+		}, nil
+	}
+
+	historyList := make([]types.WatchHistoryItem, len(r.ItemList))
+	for i, item := range r.ItemList {
+		historyList[i] = types.WatchHistoryItem{
+			Video:     videoFromVideoRpc(item.Video),
+			WatchTime: item.WatchTime,
+		}
+	}
+
+	return &types.WatchHistoryListResp{
+		Response:    types.Response{StatusCode: STATUS_SUCCESS, StatusMsg: STATUS_SUCCESS_MSG},
+		HistoryList: historyList,
+		NextCursor:  r.NextCursor,
+		HasMore:     r.HasMore,
+		Paused:      r.Paused,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/video/app/rpc/videorpc"
+	"context"
+
+	"Mini-Tiktok/api/internal/svc"
+	"Mini-Tiktok/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WatchHistorySettingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWatchHistorySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchHistorySettingLogic {
+	return &WatchHistorySettingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// WatchHistorySetting 暂停或恢复记录当前用户的观看历史
+func (l *WatchHistorySettingLogic) WatchHistorySetting(req *types.WatchHistorySettingReq) (resp *types.WatchHistorySettingResp, err error) {
+	token, err := l.svcCtx.JwtRpc.ParseToken(l.ctx, &Jwt.ParseTokenReq{Token: req.Token})
+	if err != nil {
+		return &types.WatchHistorySettingResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_TOKEN_MSG},
+		}, nil
+	}
+
+	if req.ActionType != HISTORY_PAUSE && req.ActionType != HISTORY_RESUME {
+		return &types.WatchHistorySettingResp{
+			Response: types.Response{StatusCode: STATUS_FAIL, StatusMsg: STATUS_FAIL_PARAM_MSG},
+		}, nil
+	}
+
+	r, err := l.svcCtx.VideoRpc.SetWatchHistoryPaused(l.ctx, &videorpc.WatchHistoryPausedReq{
+		UserId: token.UserID,
+		Paused: req.ActionType == HISTORY_PAUSE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WatchHistorySettingResp{
+		Response: types.Response{StatusCode: r.StatusCode, StatusMsg: r.StatusMsg},
+	}, nil
+}
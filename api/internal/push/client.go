@@ -0,0 +1,68 @@
+package push
+
+import (
+	"github.com/gorilla/websocket"
+	"github.com/zeromicro/go-zero/core/logx"
+	"time"
+)
+
+// Client 一个用户的 WebSocket 连接，同一用户可同时有多个连接（多端登录）
+type Client struct {
+	hub    *Hub
+	conn   *websocket.Conn
+	userId uint64
+	send   chan []byte
+}
+
+// readPump 只用于处理 pong 与关闭帧，客户端发来的消息直接丢弃
+// 连接断开后将 Client 从 Hub 中注销
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.unregister(c)
+		c.conn.Close()
+	}()
+
+	pongWait := c.hub.pongWait()
+	c.conn.SetReadLimit(MAX_READ_SIZE)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logx.Errorf("push: read from user %d: %v", c.userId, err)
+			}
+			return
+		}
+	}
+}
+
+// writePump 将 send 中的事件写入连接，并定时发送 ping 保活
+func (c *Client) writePump() {
+	ticker := time.NewTicker(c.hub.pingPeriod())
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case msg, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(WRITE_WAIT))
+			if !ok { // Hub 已关闭该连接
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(WRITE_WAIT))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
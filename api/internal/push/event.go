@@ -0,0 +1,19 @@
+package push
+
+import "encoding/json"
+
+const (
+	EVENT_MESSAGE  = "message"  // 收到新私信
+	EVENT_COMMENT  = "comment"  // 自己的视频收到新评论
+	EVENT_FOLLOW   = "follow"   // 新增粉丝
+	EVENT_FAVORITE = "favorite" // 自己的视频被点赞
+	EVENT_PUBLISH  = "publish"  // 投稿转码完成
+)
+
+// Event 各服务写入 Kafka 推送主题的事件，网关只根据 UserId 转发，不解析 Data
+type Event struct {
+	Type       string          `json:"type"`        // 事件类型
+	UserId     uint64          `json:"user_id"`     // 接收事件的用户 id
+	Data       json.RawMessage `json:"data"`        // 事件内容，由产生事件的服务定义
+	CreateTime int64           `json:"create_time"` // 事件产生时间（毫秒时间戳）
+}
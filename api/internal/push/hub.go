@@ -0,0 +1,233 @@
+package push
+
+import (
+	"Mini-Tiktok/api/internal/config"
+	"context"
+	"encoding/json"
+	"github.com/gomodule/redigo/redis"
+	"github.com/gorilla/websocket"
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	WRITE_WAIT      = 10 * time.Second // 单次写入超时时间
+	MAX_READ_SIZE   = 512              // 客户端发来消息的最大字节数
+	RETRY_INTERVAL  = 3 * time.Second  // Redis 订阅断开后的重试间隔
+	DEFAULT_CHANNEL = "Push:Event"
+)
+
+// Hub 维护本节点上所有用户的 WebSocket 连接
+// 事件流向：各服务 -> Kafka 推送主题 -> 任一网关节点消费（同一消费组） -> Redis PUBLISH -> 所有网关节点 SUBSCRIBE -> 本地连接
+// 这样用户连接在任意节点上都能收到自己的事件
+type Hub struct {
+	conf     config.PushConfig
+	pool     *redis.Pool
+	reader   *kafka.Reader
+	upgrader websocket.Upgrader
+
+	mu      sync.RWMutex
+	clients map[uint64]map[*Client]struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewHub 新建推送网关，需调用 Start 后才会开始消费与转发事件
+func NewHub(c config.PushConfig, pool *redis.Pool) *Hub {
+	if c.Channel == "" {
+		c.Channel = DEFAULT_CHANNEL
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Hub{
+		conf: c,
+		pool: pool,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  strings.Split(c.KafkaHost, ","),
+			GroupID:  c.GroupId,
+			Topic:    c.Topic,
+			MinBytes: c.MinBytes,
+			MaxBytes: c.MaxBytes,
+		}),
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     func(r *http.Request) bool { return true }, // 客户端为 App，不校验 Origin
+		},
+		clients: make(map[uint64]map[*Client]struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start 启动 Kafka 消费与 Redis 订阅
+func (h *Hub) Start() {
+	go h.consume()
+	go h.subscribe()
+}
+
+// Stop 停止消费与订阅，并关闭本节点所有连接
+func (h *Hub) Stop() {
+	h.cancel()
+	_ = h.reader.Close()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for userId, set := range h.clients {
+		for c := range set {
+			close(c.send)
+		}
+		delete(h.clients, userId)
+	}
+}
+
+// Serve 将 http 请求升级为 WebSocket 连接并注册到 Hub，读写在独立的 goroutine 中进行，函数会立即返回
+func (h *Hub) Serve(w http.ResponseWriter, r *http.Request, userId uint64) error {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return err
+	}
+
+	c := &Client{
+		hub:    h,
+		conn:   conn,
+		userId: userId,
+		send:   make(chan []byte, h.conf.SendBufferSize),
+	}
+	h.register(c)
+
+	go c.writePump()
+	go c.readPump()
+	return nil
+}
+
+func (h *Hub) register(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	set, ok := h.clients[c.userId]
+	if !ok {
+		set = make(map[*Client]struct{})
+		h.clients[c.userId] = set
+	}
+	set[c] = struct{}{}
+}
+
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	set, ok := h.clients[c.userId]
+	if !ok {
+		return
+	}
+	if _, ok := set[c]; ok {
+		delete(set, c)
+		close(c.send)
+	}
+	if len(set) == 0 {
+		delete(h.clients, c.userId)
+	}
+}
+
+// deliver 将事件发送给本节点上该用户的所有连接，发送缓冲区已满的连接视为过慢，直接断开
+func (h *Hub) deliver(userId uint64, msg []byte) {
+	h.mu.RLock()
+	var slow []*Client
+	for c := range h.clients[userId] {
+		select {
+		case c.send <- msg:
+		default:
+			slow = append(slow, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range slow {
+		h.unregister(c)
+	}
+}
+
+// consume 从 Kafka 推送主题消费事件并发布到 Redis 频道
+// 所有网关节点使用同一个消费组，每个事件只会被一个节点消费
+func (h *Hub) consume() {
+	for {
+		m, err := h.reader.ReadMessage(h.ctx)
+		if err != nil {
+			if h.ctx.Err() != nil {
+				return
+			}
+			logx.Errorf("push: read kafka: %v", err)
+			time.Sleep(RETRY_INTERVAL)
+			continue
+		}
+
+		var e Event
+		if err = json.Unmarshal(m.Value, &e); err != nil || e.UserId == 0 {
+			logx.Errorf("push: bad event %s: %v", string(m.Value), err)
+			continue
+		}
+
+		conn := h.pool.Get()
+		_, err = conn.Do("PUBLISH", h.conf.Channel, m.Value)
+		conn.Close()
+		if err != nil {
+			logx.Errorf("push: publish event: %v", err)
+		}
+	}
+}
+
+// subscribe 订阅 Redis 频道，将事件转发给本节点上的连接，订阅断开后自动重试
+func (h *Hub) subscribe() {
+	for h.ctx.Err() == nil {
+		if err := h.receive(); err != nil && h.ctx.Err() == nil {
+			logx.Errorf("push: subscribe %s: %v", h.conf.Channel, err)
+			time.Sleep(RETRY_INTERVAL)
+		}
+	}
+}
+
+func (h *Hub) receive() error {
+	psc := redis.PubSubConn{Conn: h.pool.Get()}
+	defer psc.Close()
+
+	if err := psc.Subscribe(h.conf.Channel); err != nil {
+		return err
+	}
+
+	// Stop 时关闭订阅连接，使 Receive 返回
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-h.ctx.Done():
+			_ = psc.Unsubscribe()
+			psc.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			var e Event
+			if err := json.Unmarshal(v.Data, &e); err != nil {
+				continue
+			}
+			h.deliver(e.UserId, v.Data)
+		case error:
+			return v
+		}
+	}
+}
+
+func (h *Hub) pingPeriod() time.Duration {
+	return time.Duration(h.conf.PingPeriod) * time.Second
+}
+
+// pongWait 超过该时间未收到 pong 则认为连接已断开
+func (h *Hub) pongWait() time.Duration {
+	return h.pingPeriod() * 2
+}
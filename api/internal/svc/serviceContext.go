@@ -1,12 +1,19 @@
 package svc
 
 import (
+	"Mini-Tiktok/admin/app/rpc/adminrpc"
 	"Mini-Tiktok/api/internal/config"
+	"Mini-Tiktok/api/internal/push"
+	"Mini-Tiktok/common/moderation"
 	"Mini-Tiktok/jwt/app/rpc/jwtrpc"
+	"Mini-Tiktok/search/app/rpc/searchrpc"
 	"Mini-Tiktok/user/app/rpc/userrpc"
 	"Mini-Tiktok/video/app/rpc/videorpc"
+	"fmt"
+	"github.com/gomodule/redigo/redis"
 	"github.com/segmentio/kafka-go"
 	"github.com/zeromicro/go-zero/zrpc"
+	"log"
 	"time"
 )
 
@@ -15,10 +22,38 @@ type ServiceContext struct {
 	UserRpc     userrpc.UserRpc
 	JwtRpc      jwtrpc.JwtRpc
 	KafkaWriter *kafka.Writer
+	PlayWriter  *kafka.Writer
 	VideoRpc    videorpc.VideoRpc
+	AdminRpc    adminrpc.AdminRpc
+	SearchRpc   searchrpc.SearchRpc
+	PushHub     *push.Hub
+	Moderation  *moderation.Filter
+	Redis       *redis.Pool // 推送网关转发事件与投稿次数计数共用
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	pool := newRedisPool(c.RedisConfig)
+	conn := pool.Get()
+	_, err := conn.Do("PING")
+	conn.Close()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	filter, err := moderation.NewFilter(c.Moderation)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// 播放事件按视频 id 分区，同一视频的事件由同一个消费者处理
+	playWriter := getKafkaWriter(c.PlayConfig.Host,
+		c.PlayConfig.Topic,
+		c.PlayConfig.BatchTimeout,
+		c.PlayConfig.BatchSize,
+		c.PlayConfig.BatchBytes,
+	)
+	playWriter.Balancer = &kafka.Hash{}
+
 	return &ServiceContext{
 		Config:  c,
 		UserRpc: userrpc.NewUserRpc(zrpc.MustNewClient(c.UserRpc)),
@@ -29,7 +64,13 @@ func NewServiceContext(c config.Config) *ServiceContext {
 			c.KafkaConfig.BatchSize,
 			c.KafkaConfig.BatchBytes,
 		),
-		VideoRpc: videorpc.NewVideoRpc(zrpc.MustNewClient(c.VideoRpc)),
+		PlayWriter: playWriter,
+		VideoRpc:   videorpc.NewVideoRpc(zrpc.MustNewClient(c.VideoRpc)),
+		AdminRpc:   adminrpc.NewAdminRpc(zrpc.MustNewClient(c.AdminRpc)),
+		SearchRpc:  searchrpc.NewSearchRpc(zrpc.MustNewClient(c.SearchRpc)),
+		PushHub:    push.NewHub(c.PushConfig, pool),
+		Moderation: filter,
+		Redis:      pool,
 	}
 }
 
@@ -43,3 +84,21 @@ func getKafkaWriter(host, topic string, timeout int, size int, bytes int64) *kaf
 		BatchBytes:   bytes,
 	}
 }
+
+func newRedisPool(c config.RedisConfig) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     c.MaxIdle, //最大空闲连接数
+		MaxActive:   c.Active,  //最大连接数
+		IdleTimeout: time.Duration(c.IdleTimeout) * time.Second,
+		Wait:        true, //超过连接数后是否等待
+		Dial: func() (redis.Conn, error) {
+			redisUri := fmt.Sprintf("%s:%d", c.Host, c.Port)
+			if c.Auth {
+				return redis.Dial("tcp", redisUri,
+					redis.DialUsername(c.Username),
+					redis.DialPassword(c.Password))
+			}
+			return redis.Dial("tcp", redisUri)
+		},
+	}
+}
@@ -17,8 +17,11 @@ type LoginReq struct {
 }
 
 type PublishReq struct {
-	Token string `form:"token"` // 用户鉴权 token
-	Title string `form:"title"` // 视频标题
+	Token       string `form:"token"`                 // 用户鉴权 token
+	Title       string `form:"title"`                 // 视频标题
+	Visibility  string `form:"visibility,optional"`   // 可见范围：0-公开（默认），1-粉丝可见，2-互关好友可见，3-仅自己可见
+	PublishType string `form:"publish_type,optional"` // 发布方式：0-立即发布（默认），1-保存为草稿，2-定时发布
+	PublishTime string `form:"publish_time,optional"` // 定时发布的时间戳，精确到秒，仅定时发布时需要
 }
 
 type PublicReq struct {
@@ -76,28 +79,314 @@ type FollowerListReq struct {
 	UserId string `form:"user_id"` // 用户id
 }
 
+type ChangePasswordReq struct {
+	Token       string `form:"token"`        // 用户鉴权 token
+	OldPassword string `form:"old_password"` // 旧密码
+	NewPassword string `form:"new_password"` // 新密码
+}
+
+type UpdateProfileReq struct {
+	Token     string  `form:"token"`              // 用户鉴权 token
+	Nickname  *string `form:"nickname,optional"`  // 昵称，不传则不修改
+	Signature *string `form:"signature,optional"` // 个性签名，不传则不修改
+}
+
+type FriendListReq struct {
+	Token  string `form:"token"`   // 用户鉴权 token
+	UserId string `form:"user_id"` // 用户id
+}
+
+type MessageActionReq struct {
+	Token      string `form:"token"`       // 用户鉴权 token
+	ToUserId   string `form:"to_user_id"`  // 对方用户id
+	ActionType string `form:"action_type"` // 1-发送消息
+	Content    string `form:"content"`     // 消息内容
+}
+
+type MessageChatReq struct {
+	Token      string `form:"token"`                 // 用户鉴权 token
+	ToUserId   string `form:"to_user_id"`            // 对方用户id
+	PreMsgTime int64  `form:"pre_msg_time,optional"` // 上次最新消息的时间（毫秒），首次拉取时为 0
+}
+
+type PushReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type NotificationListReq struct {
+	Token  string `form:"token"`           // 用户鉴权 token
+	Type   string `form:"type,optional"`   // 通知类型：favorite、comment、follow、publish，为空时返回所有类型
+	Cursor int64  `form:"cursor,optional"` // 上一页返回的 next_cursor，首次拉取时为 0
+}
+
+type NotificationReadReq struct {
+	Token           string `form:"token"`                     // 用户鉴权 token
+	NotificationIds string `form:"notification_ids,optional"` // 要标记已读的通知id，以逗号分隔，为空时标记所有（或 type 类型的）通知
+	Type            string `form:"type,optional"`             // 通知类型
+}
+
+type NotificationUnreadReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type BlockActionReq struct {
+	Token      string `form:"token"`       // 用户鉴权 token
+	ToUserId   string `form:"to_user_id"`  // 对方用户id
+	ActionType string `form:"action_type"` // 1-拉黑，2-取消拉黑
+}
+
+type BlockListReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type MuteActionReq struct {
+	Token      string `form:"token"`       // 用户鉴权 token
+	ToUserId   string `form:"to_user_id"`  // 对方用户id
+	ActionType string `form:"action_type"` // 1-屏蔽，2-取消屏蔽
+}
+
+type MuteListReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type ReportActionReq struct {
+	Token      string `form:"token"`            // 用户鉴权 token
+	TargetType string `form:"target_type"`      // 1-视频，2-评论，3-账号
+	TargetId   string `form:"target_id"`        // 举报目标id
+	Reason     string `form:"reason"`           // 1-垃圾广告，2-色情低俗，3-暴力血腥，4-骚扰谩骂，5-违法犯罪，6-其他
+	Content    string `form:"content,optional"` // 举报说明
+}
+
+type BanUserReq struct {
+	Token      string `form:"token"`           // 用户鉴权 token
+	UserId     string `form:"user_id"`         // 用户id
+	ActionType string `form:"action_type"`     // 1-封禁，2-解封
+	Reason     string `form:"reason,optional"` // 操作原因，记录到审计日志
+}
+
+type SetRoleReq struct {
+	Token  string `form:"token"`           // 用户鉴权 token
+	UserId string `form:"user_id"`         // 用户id
+	Role   string `form:"role"`            // 0-普通用户，1-审核员，2-管理员
+	Reason string `form:"reason,optional"` // 操作原因，记录到审计日志
+}
+
+type ApproveVideoReq struct {
+	Token   string `form:"token"`           // 用户鉴权 token
+	VideoId string `form:"video_id"`        // 视频id
+	Reason  string `form:"reason,optional"` // 操作原因，记录到审计日志
+}
+
+type RejectVideoReq struct {
+	Token   string `form:"token"`           // 用户鉴权 token
+	VideoId string `form:"video_id"`        // 视频id
+	Reason  string `form:"reason,optional"` // 不通过原因，作者可见
+}
+
+type TakeDownVideoReq struct {
+	Token   string `form:"token"`           // 用户鉴权 token
+	VideoId string `form:"video_id"`        // 视频id
+	Reason  string `form:"reason,optional"` // 下架原因，作者可见
+}
+
+type ReviewQueueReq struct {
+	Token  string `form:"token"`           // 用户鉴权 token
+	Status string `form:"status,optional"` // 审核状态：0-待审核（默认），1-已通过，2-未通过，3-已下架
+	Cursor int64  `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type ReportTargetListReq struct {
+	Token  string `form:"token"`           // 用户鉴权 token
+	Status string `form:"status,optional"` // 举报状态：0-未达到隐藏阈值，1-已自动隐藏待审核（默认），2-审核后恢复
+	Cursor int64  `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type ReportRestoreReq struct {
+	Token      string `form:"token"`           // 用户鉴权 token
+	TargetType string `form:"target_type"`     // 1-视频，2-评论，3-账号
+	TargetId   string `form:"target_id"`       // 举报目标id
+	Reason     string `form:"reason,optional"` // 操作原因，记录到审计日志
+}
+
+type DuplicateOriginalReq struct {
+	Token   string `form:"token"`    // 用户鉴权 token
+	VideoId string `form:"video_id"` // 被检测为重复投稿的视频id
+}
+
+type DeleteCommentReq struct {
+	Token     string `form:"token"`           // 用户鉴权 token
+	CommentId string `form:"comment_id"`      // 评论id
+	Reason    string `form:"reason,optional"` // 操作原因，记录到审计日志
+}
+
+type RebuildCacheReq struct {
+	Token      string `form:"token"`       // 用户鉴权 token
+	TargetType string `form:"target_type"` // 1-用户，2-视频
+	TargetId   string `form:"target_id"`   // 用户id或视频id
+}
+
+type SearchVideoReq struct {
+	Token   *string `form:"token,optional"`  // 用户登录状态下设置
+	Keyword string  `form:"keyword"`         // 搜索词，匹配视频标题
+	Cursor  int64   `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type SearchUserReq struct {
+	Token   *string `form:"token,optional"`  // 用户登录状态下设置
+	Keyword string  `form:"keyword"`         // 搜索词，匹配用户名与昵称
+	Cursor  int64   `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type TopicVideoListReq struct {
+	Token         *string `form:"token,optional"`           // 用户登录状态下设置
+	Name          string  `form:"name"`                     // 话题名，可以带 #
+	SortType      string  `form:"sort_type,optional"`       // 1-按投稿时间（默认），2-按点赞数
+	Cursor        int64   `form:"cursor,optional"`          // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+	CursorVideoId uint64  `form:"cursor_video_id,optional"` // 按投稿时间排序时填上次返回的 next_cursor_video_id
+}
+
+type TrendingListReq struct {
+	Token  *string `form:"token,optional"`  // 用户登录状态下设置
+	Window string  `form:"window,optional"` // 时间窗口：1-最近一小时，2-最近一天（默认），3-最近一周
+	Cursor int64   `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type PlayActionReq struct {
+	Token      *string `form:"token,optional"`     // 用户登录状态下设置
+	VideoId    string  `form:"video_id"`           // 视频id
+	ActionType string  `form:"action_type"`        // 1-开始播放，2-播放完成
+	Duration   int64   `form:"duration,optional"`  // 观看时长，单位 ms，播放完成时上报
+	DeviceId   string  `form:"device_id,optional"` // 设备标识，未登录时用于估计独立观众数
+}
+
+type WatchHistoryListReq struct {
+	Token  string `form:"token"`           // 用户鉴权 token
+	Cursor int64  `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type WatchHistoryDeleteReq struct {
+	Token   string `form:"token"`    // 用户鉴权 token
+	VideoId string `form:"video_id"` // 要删除的观看记录的视频id
+}
+
+type WatchHistoryClearReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type WatchHistorySettingReq struct {
+	Token      string `form:"token"`       // 用户鉴权 token
+	ActionType string `form:"action_type"` // 1-暂停记录观看历史，2-恢复记录
+}
+
+type CreatorStatsReq struct {
+	Token     string `form:"token"`             // 用户鉴权 token
+	VideoId   string `form:"video_id,optional"` // 视频id，不填时查询账号的统计
+	StartDate string `form:"start_date"`        // 开始日期，yyyy-mm-dd
+	EndDate   string `form:"end_date"`          // 结束日期（包括该日），yyyy-mm-dd
+}
+
+type CollectionActionReq struct {
+	Token        string `form:"token"`                  // 用户鉴权 token
+	ActionType   string `form:"action_type"`            // 1-创建，2-修改，3-删除
+	CollectionId string `form:"collection_id,optional"` // 合集id，修改与删除时填写
+	Name         string `form:"name,optional"`          // 合集名称，创建时必填，修改时不填表示不修改
+	IsPublic     string `form:"is_public,optional"`     // 0-私密（创建时默认），1-公开，修改时不填表示不修改
+}
+
+type CollectionSortReq struct {
+	Token         string `form:"token"`          // 用户鉴权 token
+	CollectionIds string `form:"collection_ids"` // 用户全部合集id按新顺序以逗号分隔
+}
+
+type CollectionVideoActionReq struct {
+	Token        string `form:"token"`         // 用户鉴权 token
+	CollectionId string `form:"collection_id"` // 合集id
+	VideoId      string `form:"video_id"`      // 视频id
+	ActionType   string `form:"action_type"`   // 1-添加，2-移除
+}
+
+type CollectionListReq struct {
+	Token  *string `form:"token,optional"` // 用户登录状态下设置
+	UserId string  `form:"user_id"`        // 用户id
+}
+
+type CollectionVideoListReq struct {
+	Token        *string `form:"token,optional"`  // 用户登录状态下设置
+	CollectionId string  `form:"collection_id"`   // 合集id
+	Cursor       int64   `form:"cursor,optional"` // 分页游标，首次请求不填，之后填上次返回的 next_cursor
+}
+
+type ShareActionReq struct {
+	Token    string `form:"token"`               // 用户鉴权 token
+	VideoId  string `form:"video_id"`            // 视频id
+	Channel  string `form:"channel"`             // 1-复制链接，2-私信好友，3-分享到站外
+	ToUserId string `form:"to_user_id,optional"` // 私信好友时的接收者id
+}
+
+type ShareVideoReq struct {
+	Token      *string `form:"token,optional"` // 用户登录状态下设置
+	ShareToken string  `form:"share_token"`    // 分享链接中的 token
+}
+
+type VideoVisibilityReq struct {
+	Token      string `form:"token"`      // 用户鉴权 token
+	VideoId    string `form:"video_id"`   // 视频 id
+	Visibility string `form:"visibility"` // 可见范围：0-公开，1-粉丝可见，2-互关好友可见，3-仅自己可见
+}
+
+type DraftListReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
+type DraftActionReq struct {
+	Token       string `form:"token"`                 // 用户鉴权 token
+	VideoId     string `form:"video_id"`              // 视频 id
+	ActionType  string `form:"action_type"`           // 1-立即发布，2-定时发布（或修改发布时间），3-取消定时发布（转为草稿），4-删除
+	PublishTime string `form:"publish_time,optional"` // 定时发布的时间戳，精确到秒，仅 action_type 为 2 时需要
+}
+
+type PublishQuotaReq struct {
+	Token string `form:"token"` // 用户鉴权 token
+}
+
 type Response struct {
 	StatusCode string `json:"status_code"`          // 状态码，0-成功，其他值-失败
 	StatusMsg  string `json:"status_msg,omitempty"` // 返回状态描述
 }
 
 type User struct {
-	FollowCount   int64  `json:"follow_count"`   // 关注总数
-	FollowerCount int64  `json:"follower_count"` // 粉丝总数
-	ID            uint64 `json:"id"`             // 用户id
-	IsFollow      bool   `json:"is_follow"`      // true-已关注，false-未关注
-	Name          string `json:"name"`           // 用户名称
+	FollowCount     int64  `json:"follow_count"`     // 关注总数
+	FollowerCount   int64  `json:"follower_count"`   // 粉丝总数
+	ID              uint64 `json:"id"`               // 用户id
+	IsFollow        bool   `json:"is_follow"`        // true-已关注，false-未关注
+	Name            string `json:"name"`             // 用户名称
+	Nickname        string `json:"nickname"`         // 昵称
+	Avatar          string `json:"avatar"`           // 头像地址
+	BackgroundImage string `json:"background_image"` // 个人页背景图地址
+	Signature       string `json:"signature"`        // 个性签名
+	TotalFavorited  int64  `json:"total_favorited"`  // 获赞总数
+	WorkCount       int64  `json:"work_count"`       // 作品数
+	FavoriteCount   int64  `json:"favorite_count"`   // 点赞数
 }
 
 type Video struct {
-	Author        User   `json:"author"`         // 视频作者信息
-	CommentCount  int64  `json:"comment_count"`  // 视频的评论总数
-	CoverURL      string `json:"cover_url"`      // 视频封面地址
-	FavoriteCount int64  `json:"favorite_count"` // 视频的点赞总数
-	ID            uint64 `json:"id"`             // 视频唯一标识
-	IsFavorite    bool   `json:"is_favorite"`    // true-已点赞，false-未点赞
-	PlayURL       string `json:"play_url"`       // 视频播放地址
-	Title         string `json:"title"`          // 视频标题
+	Author        User   `json:"author"`                   // 视频作者信息
+	CommentCount  int64  `json:"comment_count"`            // 视频的评论总数
+	CoverURL      string `json:"cover_url"`                // 视频封面地址
+	FavoriteCount int64  `json:"favorite_count"`           // 视频的点赞总数
+	ID            uint64 `json:"id"`                       // 视频唯一标识
+	IsFavorite    bool   `json:"is_favorite"`              // true-已点赞，false-未点赞
+	PlayURL       string `json:"play_url"`                 // 视频播放地址
+	DownloadURL   string `json:"download_url,omitempty"`   // 视频下载地址（带水印），没有下载版本时为空
+	PreviewURL    string `json:"preview_url,omitempty"`    // 动态预览地址，用于悬停与自动播放
+	SpriteURL     string `json:"sprite_url,omitempty"`     // 进度条缩略图雪碧图地址
+	SpriteVTTURL  string `json:"sprite_vtt_url,omitempty"` // 缩略图 WebVTT 索引地址，记录每段时间对应的雪碧图区域
+	Title         string `json:"title"`                    // 视频标题
+	Status        string `json:"status,omitempty"`         // 审核状态（仅作者本人可见）：0-待审核，1-已通过，2-未通过，3-已下架
+	ReviewReason  string `json:"review_reason,omitempty"`  // 未通过或下架的原因（仅作者本人可见）
+	Visibility    string `json:"visibility,omitempty"`     // 可见范围（仅作者本人可见）：0-公开，1-粉丝可见，2-互关好友可见，3-仅自己可见
+	ViewCount     int64  `json:"view_count"`               // 视频的播放次数
+	ShareCount    int64  `json:"share_count"`              // 视频的分享数
 }
 
 type GetUserResp struct {
@@ -171,3 +460,317 @@ type FollowerListResp struct {
 	Response
 	UserList []User `json:"user_list"`
 }
+
+type ChangePasswordResp struct {
+	Response
+	Token *string `json:"token"` // 修改成功后重新签发的用户鉴权 token，旧 token 全部失效
+}
+
+type UpdateProfileResp struct {
+	Response
+	User *User `json:"user"` // 修改后的用户信息
+}
+
+type FriendUser struct {
+	User
+	Message string `json:"message,omitempty"` // 和该好友的最新聊天消息
+	MsgType int64  `json:"msgType"`           // 0-当前请求用户接收的消息，1-当前请求用户发送的消息，-1-没有消息
+}
+
+type FriendListResp struct {
+	Response
+	UserList []FriendUser `json:"user_list"` // 好友列表
+}
+
+type MessageActionResp struct {
+	Response
+}
+
+type Message struct {
+	ID         uint64 `json:"id"`           // 消息id
+	ToUserID   uint64 `json:"to_user_id"`   // 消息接收者id
+	FromUserID uint64 `json:"from_user_id"` // 消息发送者id
+	Content    string `json:"content"`      // 消息内容
+	CreateTime int64  `json:"create_time"`  // 消息发送时间（毫秒时间戳）
+}
+
+type MessageChatResp struct {
+	Response
+	MessageList []Message `json:"message_list"` // 消息列表
+}
+
+type Notification struct {
+	ID         uint64 `json:"id"`              // 通知id
+	Type       string `json:"type"`            // 通知类型
+	TargetID   uint64 `json:"target_id"`       // 通知对象id（视频id），关注通知为 0
+	Actor      *User  `json:"actor,omitempty"` // 最近一次触发通知的用户，投稿通知没有该字段
+	ActorCount int64  `json:"actor_count"`     // 聚合的不同用户数，如 "X 等 12 人赞了你的视频"
+	Content    string `json:"content"`         // 最近一次事件的内容 json
+	IsRead     bool   `json:"is_read"`         // 是否已读
+	CreateTime int64  `json:"create_time"`     // 通知创建时间（毫秒时间戳）
+	UpdateTime int64  `json:"update_time"`     // 最近一次聚合的时间（毫秒时间戳）
+}
+
+type NotificationListResp struct {
+	Response
+	NotificationList []Notification `json:"notification_list"` // 通知列表
+	NextCursor       int64          `json:"next_cursor"`       // 下一页的游标，为 0 时表示没有更多通知
+}
+
+type NotificationReadResp struct {
+	Response
+}
+
+type NotificationUnreadResp struct {
+	Response
+	Total       int64            `json:"total"`        // 未读通知总数
+	UnreadCount map[string]int64 `json:"unread_count"` // 各类型的未读通知数
+}
+
+type BlockActionResp struct {
+	Response
+}
+
+type BlockListResp struct {
+	Response
+	UserList []User `json:"user_list"`
+}
+
+type MuteActionResp struct {
+	Response
+}
+
+type MuteListResp struct {
+	Response
+	UserList []User `json:"user_list"`
+}
+
+type ReportActionResp struct {
+	Response
+}
+
+type BanUserResp struct {
+	Response
+}
+
+type SetRoleResp struct {
+	Response
+}
+
+type ApproveVideoResp struct {
+	Response
+}
+
+type RejectVideoResp struct {
+	Response
+}
+
+type TakeDownVideoResp struct {
+	Response
+}
+
+type ReviewQueueResp struct {
+	Response
+	VideoList  []Video `json:"video_list"`  // 按投稿时间正序排序的视频列表
+	NextCursor int64   `json:"next_cursor"` // 下一页的分页游标，没有更多时为 0
+}
+
+type ReportTarget struct {
+	TargetType  string `json:"target_type"`  // 1-视频，2-评论，3-账号
+	TargetId    uint64 `json:"target_id"`    // 举报目标id
+	OwnerId     uint64 `json:"owner_id"`     // 被举报内容的作者（账号举报时为账号本身）
+	ReportCount int64  `json:"report_count"` // 举报人数
+	Status      string `json:"status"`       // 0-未达到隐藏阈值，1-已自动隐藏待审核，2-审核后恢复
+	CreateTime  int64  `json:"create_time"`  // 首次被举报的时间
+	UpdateTime  int64  `json:"update_time"`  // 最近一次被举报的时间
+}
+
+type ReportTargetListResp struct {
+	Response
+	TargetList []ReportTarget `json:"target_list"` // 按首次被举报时间正序排序的举报目标
+	NextCursor int64          `json:"next_cursor"` // 下一页的分页游标，没有更多时为 0
+}
+
+type ReportRestoreResp struct {
+	Response
+}
+
+type DuplicateOriginalResp struct {
+	Response
+	Original *Video  `json:"original"` // 重复投稿检测命中的原视频
+	Score    float64 `json:"score"`    // 与原视频的相似度，0~1
+}
+
+type DeleteCommentResp struct {
+	Response
+}
+
+type RebuildCacheResp struct {
+	Response
+}
+
+type SearchVideoResp struct {
+	Response
+	VideoList  []Video `json:"video_list"`  // 按相关度排序的视频列表
+	NextCursor int64   `json:"next_cursor"` // 下一页的分页游标
+	HasMore    bool    `json:"has_more"`    // 是否还有更多结果
+}
+
+type SearchUserResp struct {
+	Response
+	UserList   []User `json:"user_list"`   // 按相关度排序的用户列表
+	NextCursor int64  `json:"next_cursor"` // 下一页的分页游标
+	HasMore    bool   `json:"has_more"`    // 是否还有更多结果
+}
+
+type Topic struct {
+	ID         uint64 `json:"id"`          // 话题id
+	Name       string `json:"name"`        // 话题名
+	VideoCount int64  `json:"video_count"` // 话题下的视频数
+}
+
+type TopicVideoListResp struct {
+	Response
+	Topic             *Topic  `json:"topic,omitempty"`                // 话题信息
+	VideoList         []Video `json:"video_list"`                     // 话题下的视频列表
+	NextCursor        int64   `json:"next_cursor"`                    // 下一页的分页游标
+	NextCursorVideoId uint64  `json:"next_cursor_video_id,omitempty"` // 按投稿时间排序时下一页的分页游标，与 next_cursor 一起填写
+	HasMore           bool    `json:"has_more"`                       // 是否还有更多视频
+}
+
+type TrendingListResp struct {
+	Response
+	VideoList  []Video `json:"video_list"`  // 按热度从高到低排序的视频列表
+	NextCursor int64   `json:"next_cursor"` // 下一页的分页游标
+	HasMore    bool    `json:"has_more"`    // 是否还有更多视频
+}
+
+type PlayActionResp struct {
+	Response
+}
+
+type WatchHistoryItem struct {
+	Video     Video `json:"video"`      // 视频信息
+	WatchTime int64 `json:"watch_time"` // 最近一次观看的时间戳
+}
+
+type WatchHistoryListResp struct {
+	Response
+	HistoryList []WatchHistoryItem `json:"history_list"` // 按观看时间从新到旧排序的观看历史
+	NextCursor  int64              `json:"next_cursor"`  // 下一页的分页游标
+	HasMore     bool               `json:"has_more"`     // 是否还有更多记录
+	Paused      bool               `json:"paused"`       // 是否暂停记录观看历史
+}
+
+type WatchHistoryDeleteResp struct {
+	Response
+}
+
+type WatchHistoryClearResp struct {
+	Response
+}
+
+type WatchHistorySettingResp struct {
+	Response
+}
+
+type DailyStat struct {
+	Date          string `json:"date"`                     // 日期，yyyy-mm-dd
+	Likes         int64  `json:"likes"`                    // 新点赞数（账号为收到的新点赞数）
+	Comments      int64  `json:"comments,omitempty"`       // 新评论数（视频）
+	Views         int64  `json:"views,omitempty"`          // 播放次数（视频）
+	Shares        int64  `json:"shares,omitempty"`         // 分享次数（视频）
+	NewFollowers  int64  `json:"new_followers,omitempty"`  // 新粉丝数（账号）
+	Unfollows     int64  `json:"unfollows,omitempty"`      // 取关数（账号）
+	FollowerTotal int64  `json:"follower_total,omitempty"` // 当天结束时的粉丝数（账号）
+	LikeTotal     int64  `json:"like_total,omitempty"`     // 当天结束时收到的点赞总数（账号）
+}
+
+type CreatorStatsResp struct {
+	Response
+	StatList []DailyStat `json:"stat_list"` // 按日期从早到晚的每日统计
+}
+
+type Collection struct {
+	ID         uint64 `json:"id"`          // 合集id
+	UserId     uint64 `json:"user_id"`     // 创建者id
+	Name       string `json:"name"`        // 合集名称
+	IsPublic   bool   `json:"is_public"`   // 是否公开
+	VideoCount int64  `json:"video_count"` // 合集中的视频数
+	CreateTime int64  `json:"create_time"` // 创建时间戳
+	UpdateTime int64  `json:"update_time"` // 最近修改时间戳
+}
+
+type CollectionActionResp struct {
+	Response
+	Collection *Collection `json:"collection,omitempty"` // 创建或修改后的合集信息
+}
+
+type CollectionSortResp struct {
+	Response
+}
+
+type CollectionVideoActionResp struct {
+	Response
+	Collection *Collection `json:"collection,omitempty"` // 操作后的合集信息
+}
+
+type CollectionListResp struct {
+	Response
+	CollectionList []Collection `json:"collection_list"` // 按用户设置的顺序排序的合集列表，他人只能看到公开合集
+}
+
+type CollectionVideoListResp struct {
+	Response
+	Collection *Collection `json:"collection,omitempty"` // 合集信息
+	VideoList  []Video     `json:"video_list"`           // 按加入合集的时间从新到旧排序的视频列表
+	NextCursor int64       `json:"next_cursor"`          // 下一页的分页游标
+	HasMore    bool        `json:"has_more"`             // 是否还有更多视频
+}
+
+type ShareActionResp struct {
+	Response
+	ShareToken string `json:"share_token"` // 分享 token，用于获取分享的视频
+	ShareURL   string `json:"share_url"`   // 分享链接
+	ShareCount int64  `json:"share_count"` // 分享后视频的分享数
+}
+
+type ShareVideoResp struct {
+	Response
+	Video *Video `json:"video,omitempty"` // 分享的视频
+}
+
+type VideoVisibilityResp struct {
+	Response
+}
+
+type Draft struct {
+	ID           uint64 `json:"id"`                      // 视频唯一标识
+	Title        string `json:"title"`                   // 视频标题
+	PlayURL      string `json:"play_url"`                // 视频播放地址
+	CoverURL     string `json:"cover_url"`               // 视频封面地址
+	Status       string `json:"status"`                  // 审核状态：0-待审核，1-已通过，2-未通过，3-已下架
+	ReviewReason string `json:"review_reason,omitempty"` // 未通过或下架的原因
+	Visibility   string `json:"visibility"`              // 可见范围：0-公开，1-粉丝可见，2-互关好友可见，3-仅自己可见
+	PublishState string `json:"publish_state"`           // 发布状态：1-草稿，2-定时发布
+	PublishTime  int64  `json:"publish_time"`            // 定时发布的时间戳
+	CreateTime   int64  `json:"create_time"`             // 投稿时间戳
+}
+
+type DraftListResp struct {
+	Response
+	DraftList []Draft `json:"draft_list"` // 草稿与定时发布的视频列表
+}
+
+type DraftActionResp struct {
+	Response
+}
+
+type PublishQuotaResp struct {
+	Response
+	MaxBytes         int64   `json:"max_bytes"`         // 单个视频的最大字节数，0 表示不限制
+	MaxDuration      float64 `json:"max_duration"`      // 单个视频的最大时长（秒），0 表示不限制
+	DailyUploads     int64   `json:"daily_uploads"`     // 每天最多投稿次数，0 表示不限制
+	UsedUploads      int64   `json:"used_uploads"`      // 今天已投稿次数
+	RemainingUploads int64   `json:"remaining_uploads"` // 今天剩余投稿次数，不限制时为 -1
+}
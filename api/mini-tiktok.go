@@ -26,6 +26,10 @@ func main() {
 	ctx := svc.NewServiceContext(c)
 	handler.RegisterHandlers(server, ctx)
 
+	// 启动 WebSocket 推送网关
+	ctx.PushHub.Start()
+	defer ctx.PushHub.Stop()
+
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
 }
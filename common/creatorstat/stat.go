@@ -0,0 +1,41 @@
+package creatorstat
+
+import (
+	"strconv"
+	"time"
+)
+
+// DailyStat 表结构，创作者数据的每日统计，由视频服务的消费者汇总点赞、评论、关注表，播放次数与分享次数在写入时累加
+// 视频的统计使用 Likes, Comments, Views, Shares；账号的统计使用 Likes（收到的新点赞）, NewFollowers, Unfollows, FollowerTotal, LikeTotal
+type DailyStat struct {
+	TargetType    string `gorm:"column:target_type"` // 1-视频，2-账号
+	TargetId      uint64 `gorm:"column:target_id"`
+	Date          int    `gorm:"column:date"` // yyyymmdd
+	Likes         int64  `gorm:"column:likes"`
+	Comments      int64  `gorm:"column:comments"`
+	Views         int64  `gorm:"column:views"`
+	Shares        int64  `gorm:"column:shares"`
+	NewFollowers  int64  `gorm:"column:new_followers"`
+	Unfollows     int64  `gorm:"column:unfollows"`
+	FollowerTotal int64  `gorm:"column:follower_total"` // 当天结束时的粉丝数
+	LikeTotal     int64  `gorm:"column:like_total"`     // 当天结束时收到的点赞总数
+	UpdateTime    int64  `gorm:"column:update_time"`
+}
+
+const (
+	TARGET_VIDEO = "1"
+	TARGET_USER  = "2"
+)
+
+// ROLLUP_LOCK_KEY 每日统计汇总的锁，多个消费者实例中每个汇总周期只有取得锁的实例执行汇总
+const ROLLUP_LOCK_KEY = "Lock:DailyStat:Rollup"
+
+func (DailyStat) TableName() string {
+	return "daily_stat"
+}
+
+// Date 返回时间所在日期的 yyyymmdd 表示
+func Date(t time.Time) int {
+	date, _ := strconv.Atoi(t.Format("20060102"))
+	return date
+}
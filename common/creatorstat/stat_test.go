@@ -0,0 +1,22 @@
+package creatorstat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	cases := []struct {
+		t    time.Time
+		want int
+	}{
+		{time.Date(2023, 1, 2, 0, 0, 0, 0, time.Local), 20230102},
+		{time.Date(2023, 12, 31, 23, 59, 59, 0, time.Local), 20231231},
+		{time.Date(2024, 2, 29, 12, 0, 0, 0, time.Local), 20240229},
+	}
+	for _, c := range cases {
+		if got := Date(c.t); got != c.want {
+			t.Errorf("Date(%v) = %d, want %d", c.t, got, c.want)
+		}
+	}
+}
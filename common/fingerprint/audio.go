@@ -0,0 +1,54 @@
+package fingerprint
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	MAX_AUDIO_OFFSET  = 40 // 比较音频指纹时允许的最大偏移（指纹项数，每项约 0.12 秒）
+	MIN_AUDIO_OVERLAP = 20 // 偏移后至少需要重叠的指纹项数
+)
+
+// ParseAudio 解析 ffmpeg chromaprint 输出的原始指纹（小端序的 32 位整数数组）
+func ParseAudio(raw []byte) []uint32 {
+	fp := make([]uint32, len(raw)/4)
+	for i := range fp {
+		fp[i] = binary.LittleEndian.Uint32(raw[i*4:])
+	}
+	return fp
+}
+
+// EncodeAudio 将音频指纹编码为小端序字节，与 ParseAudio 互逆，用于存储
+func EncodeAudio(fp []uint32) []byte {
+	raw := make([]byte, len(fp)*4)
+	for i, v := range fp {
+		binary.LittleEndian.PutUint32(raw[i*4:], v)
+	}
+	return raw
+}
+
+// AudioSimilarity 比较两个音频指纹，在 ±MAX_AUDIO_OFFSET 的偏移范围内取最小的误码率，
+// 返回 1 - 2 * 误码率（不相关的指纹误码率约为 0.5，相似度约为 0），重叠部分过短时返回 0
+func AudioSimilarity(a, b []uint32) float64 {
+	best := 0.0
+	for offset := -MAX_AUDIO_OFFSET; offset <= MAX_AUDIO_OFFSET; offset++ {
+		diff, total := 0, 0
+		for i := range a {
+			j := i + offset
+			if j < 0 || j >= len(b) {
+				continue
+			}
+			diff += bits.OnesCount32(a[i] ^ b[j])
+			total += 32
+		}
+		if total < MIN_AUDIO_OVERLAP*32 {
+			continue
+		}
+		sim := 1 - 2*float64(diff)/float64(total)
+		if sim > best {
+			best = sim
+		}
+	}
+	return best
+}
@@ -0,0 +1,72 @@
+package fingerprint
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func randomAudio(r *rand.Rand, n int) []uint32 {
+	fp := make([]uint32, n)
+	for i := range fp {
+		fp[i] = r.Uint32()
+	}
+	return fp
+}
+
+func TestEncodeParseAudio(t *testing.T) {
+	fp := []uint32{0, 1, 0xDEADBEEF, 0xFFFFFFFF}
+	raw := EncodeAudio(fp)
+	if len(raw) != len(fp)*4 {
+		t.Fatalf("len(raw) = %d, want %d", len(raw), len(fp)*4)
+	}
+	if raw[8] != 0xEF || raw[11] != 0xDE {
+		t.Errorf("not little endian: % x", raw[8:12])
+	}
+	if got := ParseAudio(raw); !reflect.DeepEqual(got, fp) {
+		t.Errorf("ParseAudio(EncodeAudio(fp)) = %v, want %v", got, fp)
+	}
+	// 末尾不足 4 字节的部分忽略
+	if got := ParseAudio(raw[:7]); len(got) != 1 {
+		t.Errorf("len(ParseAudio(7 bytes)) = %d, want 1", len(got))
+	}
+}
+
+func TestAudioSimilarity(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := randomAudio(r, 200)
+
+	noisy := append([]uint32(nil), a...)
+	for i := range noisy {
+		noisy[i] ^= 1 << uint(r.Intn(32))
+	}
+
+	tests := []struct {
+		name     string
+		b        []uint32
+		min, max float64
+	}{
+		{"identical", a, 1, 1},
+		{"shifted within offset", a[MAX_AUDIO_OFFSET/2:], 1, 1},
+		{"one bit flipped per item", noisy, 0.9, 0.95},
+		{"unrelated", randomAudio(r, 200), 0, 0.2},
+		{"shifted beyond offset", a[MAX_AUDIO_OFFSET+20:], 0, 0.2},
+		{"too short", a[:MIN_AUDIO_OVERLAP-1], 0, 0},
+		{"empty", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		got := AudioSimilarity(a, tt.b)
+		if got < tt.min || got > tt.max {
+			t.Errorf("%s: AudioSimilarity = %v, want within [%v, %v]", tt.name, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestAudioSimilaritySymmetric(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a := randomAudio(r, 100)
+	b := append(randomAudio(r, 10), a[:80]...)
+	if x, y := AudioSimilarity(a, b), AudioSimilarity(b, a); x != y {
+		t.Errorf("AudioSimilarity(a, b) = %v, AudioSimilarity(b, a) = %v", x, y)
+	}
+}
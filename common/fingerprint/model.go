@@ -0,0 +1,38 @@
+package fingerprint
+
+// VideoFingerprint 表结构，视频抽样帧的感知哈希，哈希按位分为 4 段分别建立索引，用于按汉明距离查找相似帧
+type VideoFingerprint struct {
+	VideoId    uint64 `gorm:"column:video_id"`
+	FrameIndex int    `gorm:"column:frame_index"` // 抽样帧的序号
+	Hash       uint64 `gorm:"column:hash"`
+	Band0      uint16 `gorm:"column:band0"`
+	Band1      uint16 `gorm:"column:band1"`
+	Band2      uint16 `gorm:"column:band2"`
+	Band3      uint16 `gorm:"column:band3"`
+}
+
+func (VideoFingerprint) TableName() string {
+	return "video_fingerprint"
+}
+
+// VideoAudioFingerprint 表结构，视频开头一段音频的 chromaprint 指纹
+type VideoAudioFingerprint struct {
+	VideoId     uint64 `gorm:"column:video_id"`
+	Fingerprint []byte `gorm:"column:fingerprint"` // 小端序的 32 位整数数组
+}
+
+func (VideoAudioFingerprint) TableName() string {
+	return "video_audio_fingerprint"
+}
+
+// VideoDuplicate 表结构，检测为重复投稿的视频与其原视频
+type VideoDuplicate struct {
+	VideoId    uint64  `gorm:"column:video_id"`
+	OriginalId uint64  `gorm:"column:original_id"`
+	Score      float64 `gorm:"column:score"` // 相似度，0~1
+	CreateTime int64   `gorm:"column:create_time"`
+}
+
+func (VideoDuplicate) TableName() string {
+	return "video_duplicate"
+}
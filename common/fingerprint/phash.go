@@ -0,0 +1,93 @@
+package fingerprint
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	FRAME_SIZE  = 32 // 计算感知哈希前将帧缩放为 32x32 的灰度图
+	HASH_SIZE   = 8  // 取 DCT 左上角 8x8 的低频系数，得到 64 位哈希
+	BAND_COUNT  = 4  // 哈希分为 4 段，每段 16 位，汉明距离不超过 BAND_COUNT-1 的两个哈希至少有一段完全相同
+	BAND_BITS   = 64 / BAND_COUNT
+	FRAME_BYTES = FRAME_SIZE * FRAME_SIZE
+	FLAT_STDDEV = 4.0 // 像素亮度的标准差低于该值的帧（黑屏、纯色转场）视为无内容，不参与哈希比较
+)
+
+// dctTable DCT-II 的余弦系数，dctTable[u][x] = cos((2x+1)uπ/2N)
+var dctTable = func() [HASH_SIZE][FRAME_SIZE]float64 {
+	var t [HASH_SIZE][FRAME_SIZE]float64
+	for u := 0; u < HASH_SIZE; u++ {
+		for x := 0; x < FRAME_SIZE; x++ {
+			t[u][x] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / (2 * FRAME_SIZE))
+		}
+	}
+	return t
+}()
+
+// FrameHash 计算一帧 32x32 灰度图（按行存储，每个像素一个字节）的感知哈希（pHash）：
+// 二维 DCT 后取左上角 8x8 的低频系数，大于中位数的系数记为 1
+// 画面经过重新编码、缩放、轻微调色后哈希基本不变，汉明距离很小
+func FrameHash(pixels []byte) uint64 {
+	// 先对每一行做 DCT，只需要计算前 HASH_SIZE 个系数
+	var rows [FRAME_SIZE][HASH_SIZE]float64
+	for y := 0; y < FRAME_SIZE; y++ {
+		for u := 0; u < HASH_SIZE; u++ {
+			var sum float64
+			for x := 0; x < FRAME_SIZE; x++ {
+				sum += float64(pixels[y*FRAME_SIZE+x]) * dctTable[u][x]
+			}
+			rows[y][u] = sum
+		}
+	}
+	// 再对每一列做 DCT
+	coefs := make([]float64, 0, HASH_SIZE*HASH_SIZE)
+	for v := 0; v < HASH_SIZE; v++ {
+		for u := 0; u < HASH_SIZE; u++ {
+			var sum float64
+			for y := 0; y < FRAME_SIZE; y++ {
+				sum += rows[y][u] * dctTable[v][y]
+			}
+			coefs = append(coefs, sum)
+		}
+	}
+
+	sorted := append([]float64(nil), coefs...)
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+	var hash uint64
+	for i, c := range coefs {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// IsFlatFrame 判断一帧 32x32 灰度图是否为几乎没有内容的纯色画面
+// 纯色画面的 DCT 系数都接近 0，哈希由噪声决定，不同视频的黑屏帧容易互相命中，计算与索引指纹时跳过
+func IsFlatFrame(pixels []byte) bool {
+	var sum, sumSquare float64
+	for _, p := range pixels {
+		sum += float64(p)
+		sumSquare += float64(p) * float64(p)
+	}
+	n := float64(len(pixels))
+	mean := sum / n
+	return math.Sqrt(math.Max(sumSquare/n-mean*mean, 0)) < FLAT_STDDEV
+}
+
+// Hamming 返回两个哈希的汉明距离
+func Hamming(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Bands 将哈希按位分为 BAND_COUNT 段，用于在索引中按段精确匹配查找候选帧
+func Bands(hash uint64) [BAND_COUNT]uint16 {
+	var bands [BAND_COUNT]uint16
+	for i := range bands {
+		bands[i] = uint16(hash >> (uint(i) * BAND_BITS))
+	}
+	return bands
+}
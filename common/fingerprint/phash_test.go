@@ -0,0 +1,159 @@
+package fingerprint
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// gradientFrame 生成一帧亮度从左上到右下渐变的画面，offset 整体调整亮度
+func gradientFrame(offset int) []byte {
+	pixels := make([]byte, FRAME_BYTES)
+	for y := 0; y < FRAME_SIZE; y++ {
+		for x := 0; x < FRAME_SIZE; x++ {
+			v := x*4 + y*3 + offset
+			if v > 255 {
+				v = 255
+			}
+			pixels[y*FRAME_SIZE+x] = byte(v)
+		}
+	}
+	return pixels
+}
+
+// texturedFrame 生成一帧由随机 8x8 网格双线性放大得到的画面，低频系数分布与真实画面接近
+func texturedFrame(r *rand.Rand) []byte {
+	var grid [9][9]float64
+	for i := range grid {
+		for j := range grid[i] {
+			grid[i][j] = float64(r.Intn(200) + 28)
+		}
+	}
+	pixels := make([]byte, FRAME_BYTES)
+	for y := 0; y < FRAME_SIZE; y++ {
+		for x := 0; x < FRAME_SIZE; x++ {
+			gy, gx := y/4, x/4
+			fy, fx := float64(y%4)/4, float64(x%4)/4
+			v := grid[gy][gx]*(1-fy)*(1-fx) + grid[gy][gx+1]*(1-fy)*fx +
+				grid[gy+1][gx]*fy*(1-fx) + grid[gy+1][gx+1]*fy*fx
+			pixels[y*FRAME_SIZE+x] = byte(v)
+		}
+	}
+	return pixels
+}
+
+func randomFrame(r *rand.Rand) []byte {
+	pixels := make([]byte, FRAME_BYTES)
+	r.Read(pixels)
+	return pixels
+}
+
+func TestFrameHashSimilarFrames(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	base := texturedFrame(r)
+	brighter := make([]byte, len(base))
+	noisy := make([]byte, len(base))
+	for i, p := range base {
+		brighter[i] = p + 20
+		noisy[i] = byte(int(p) + r.Intn(5) - 2)
+	}
+
+	h := FrameHash(base)
+	if d := Hamming(h, FrameHash(brighter)); d > 3 {
+		t.Errorf("brightness change: hamming distance %d, want <= 3", d)
+	}
+	if d := Hamming(h, FrameHash(noisy)); d > 3 {
+		t.Errorf("slight noise: hamming distance %d, want <= 3", d)
+	}
+}
+
+func TestFrameHashDifferentFrames(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	h := FrameHash(texturedFrame(r))
+	for i := 0; i < 10; i++ {
+		if d := Hamming(h, FrameHash(texturedFrame(r))); d < 10 {
+			t.Errorf("random frame %d: hamming distance %d, want >= 10", i, d)
+		}
+	}
+}
+
+func TestFrameHashBitBalance(t *testing.T) {
+	// 以中位数为阈值，64 个系数中一半记为 1
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 10; i++ {
+		if n := Hamming(FrameHash(randomFrame(r)), 0); n != 32 {
+			t.Errorf("frame %d: %d bits set, want 32", i, n)
+		}
+	}
+}
+
+func TestIsFlatFrame(t *testing.T) {
+	black := make([]byte, FRAME_BYTES)
+	gray := make([]byte, FRAME_BYTES)
+	for i := range gray {
+		gray[i] = 128 + byte(i%3)
+	}
+	tests := []struct {
+		name   string
+		pixels []byte
+		want   bool
+	}{
+		{"black", black, true},
+		{"nearly uniform", gray, true},
+		{"gradient", gradientFrame(0), false},
+		{"textured", texturedFrame(rand.New(rand.NewSource(4))), false},
+		{"random", randomFrame(rand.New(rand.NewSource(4))), false},
+	}
+	for _, tt := range tests {
+		if got := IsFlatFrame(tt.pixels); got != tt.want {
+			t.Errorf("%s: IsFlatFrame = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHamming(t *testing.T) {
+	tests := []struct {
+		a, b uint64
+		want int
+	}{
+		{0, 0, 0},
+		{0, 1, 1},
+		{0xFFFF, 0, 16},
+		{^uint64(0), 0, 64},
+		{0xF0F0, 0x0FF0, 8},
+	}
+	for _, tt := range tests {
+		if got := Hamming(tt.a, tt.b); got != tt.want {
+			t.Errorf("Hamming(%#x, %#x) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBands(t *testing.T) {
+	got := Bands(0x0123456789ABCDEF)
+	want := [BAND_COUNT]uint16{0xCDEF, 0x89AB, 0x4567, 0x0123}
+	if got != want {
+		t.Errorf("Bands = %#x, want %#x", got, want)
+	}
+}
+
+func TestBandsShareBandWithinDistance(t *testing.T) {
+	// 汉明距离不超过 BAND_COUNT-1 时至少有一段完全相同
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < 1000; i++ {
+		a := r.Uint64()
+		b := a
+		for j := 0; j < BAND_COUNT-1; j++ {
+			b ^= 1 << uint(r.Intn(64))
+		}
+		ba, bb := Bands(a), Bands(b)
+		shared := false
+		for k := range ba {
+			if ba[k] == bb[k] {
+				shared = true
+			}
+		}
+		if !shared {
+			t.Fatalf("%#x and %#x (distance %d) share no band", a, b, Hamming(a, b))
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package moderation
+
+// Config 敏感词过滤设置，各服务在自己的配置文件中引用
+type Config struct {
+	Lists          []ListConfig `json:",optional" yaml:"Lists"`            // 敏感词库列表，为空时不过滤
+	ReloadInterval int          `json:",default=30" yaml:"ReloadInterval"` // 检查词库文件是否修改的间隔（秒），0 表示不热加载
+}
+
+// ListConfig 敏感词库设置，每个词库文件对应一种处理方式
+type ListConfig struct {
+	Path   string `json:"Path" yaml:"Path"`                                         // 词库文件路径，每行一个词，# 开头为注释
+	Action string `json:",default=reject,options=reject|mask|review" yaml:"Action"` // 命中后的处理方式：reject-拒绝，mask-替换为 *，review-标记待审核
+}
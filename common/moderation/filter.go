@@ -0,0 +1,195 @@
+package moderation
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	ACTION_PASS   = "pass"   // 未命中敏感词
+	ACTION_MASK   = "mask"   // 将敏感词替换为 *
+	ACTION_REVIEW = "review" // 允许提交，但需要标记待人工审核
+	ACTION_REJECT = "reject" // 拒绝提交
+
+	MASK_RUNE = '*'
+)
+
+// actionLevel 处理方式的优先级，同时命中多种处理方式的敏感词时取优先级最高的
+var actionLevel = map[string]int{
+	ACTION_PASS:   0,
+	ACTION_MASK:   1,
+	ACTION_REVIEW: 2,
+	ACTION_REJECT: 3,
+}
+
+// Result 敏感词检查结果
+type Result struct {
+	Action string   // 命中的敏感词中优先级最高的处理方式，未命中为 pass
+	Text   string   // 将 mask 类敏感词替换为 * 之后的文本
+	Words  []string // 命中的敏感词（去重）
+}
+
+// Filter 敏感词过滤器，词库文件修改后会自动重新加载，可并发使用
+type Filter struct {
+	conf     Config
+	matcher  atomic.Value // *matcher
+	modTimes []time.Time  // 上次加载时各词库文件的修改时间
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewFilter 新建敏感词过滤器并加载词库，ReloadInterval 大于 0 时启动后台协程定期检查词库文件是否修改
+func NewFilter(c Config) (*Filter, error) {
+	f := &Filter{
+		conf: c,
+		done: make(chan struct{}),
+	}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	if c.ReloadInterval > 0 && len(c.Lists) > 0 {
+		go f.watch(time.Duration(c.ReloadInterval) * time.Second)
+	}
+	return f, nil
+}
+
+// Stop 停止热加载协程
+func (f *Filter) Stop() {
+	f.stopOnce.Do(func() {
+		close(f.done)
+	})
+}
+
+// Check 检查文本中的敏感词
+func (f *Filter) Check(text string) *Result {
+	res := &Result{Action: ACTION_PASS, Text: text}
+	m := f.matcher.Load().(*matcher)
+	if len(m.words) == 0 {
+		return res
+	}
+
+	runes := []rune(text)
+	norm, pos := normalize(runes)
+	hits := m.match(norm)
+	if len(hits) == 0 {
+		return res
+	}
+
+	seen := make(map[int32]struct{}, len(hits))
+	masked := false
+	for _, h := range hits {
+		w := m.words[h.word]
+		if actionLevel[w.action] > actionLevel[res.Action] {
+			res.Action = w.action
+		}
+		if w.action == ACTION_MASK {
+			// 从敏感词第一个字符到最后一个字符（包括其中插入的分隔符）都替换为 *
+			for i := pos[h.start]; i <= pos[h.end]; i++ {
+				runes[i] = MASK_RUNE
+			}
+			masked = true
+		}
+		if _, ok := seen[h.word]; !ok {
+			seen[h.word] = struct{}{}
+			res.Words = append(res.Words, w.text)
+		}
+	}
+	if masked {
+		res.Text = string(runes)
+	}
+	return res
+}
+
+// load 读取所有词库文件并重新构建自动机，失败时保留原有的自动机
+func (f *Filter) load() error {
+	var words []word
+	modTimes := make([]time.Time, len(f.conf.Lists))
+	for i, l := range f.conf.Lists {
+		stat, err := os.Stat(l.Path)
+		if err != nil {
+			return err
+		}
+		modTimes[i] = stat.ModTime()
+
+		list, err := readWordList(l.Path)
+		if err != nil {
+			return err
+		}
+		action := l.Action
+		if _, ok := actionLevel[action]; !ok || action == ACTION_PASS {
+			action = ACTION_REJECT
+		}
+		for _, w := range list {
+			words = append(words, word{text: w, action: action})
+		}
+	}
+
+	f.matcher.Store(newMatcher(words))
+	f.modTimes = modTimes
+	return nil
+}
+
+// watch 定期检查词库文件的修改时间，有修改时重新加载
+func (f *Filter) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			if !f.modified() {
+				continue
+			}
+			if err := f.load(); err != nil {
+				logx.Errorf("reload sensitive word lists: %v", err)
+				continue
+			}
+			logx.Info("sensitive word lists reloaded")
+		}
+	}
+}
+
+// modified 判断词库文件自上次加载后是否被修改过
+func (f *Filter) modified() bool {
+	for i, l := range f.conf.Lists {
+		stat, err := os.Stat(l.Path)
+		if err != nil {
+			logx.Errorf("stat sensitive word list: %v", err)
+			return false
+		}
+		if !stat.ModTime().Equal(f.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// readWordList 读取词库文件，每行一个词，忽略空行与 # 开头的注释
+func readWordList(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var list []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list = append(list, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,53 @@
+package moderation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ncghost1/snowflake-go"
+	"gorm.io/gorm"
+)
+
+// Flag 表结构，命中待审核（review）敏感词的内容与重复投稿的视频记录，供人工审核
+type Flag struct {
+	Id         uint64 `json:"id" gorm:"column:id"`
+	Type       string `json:"type" gorm:"column:type"`           // 内容类型：comment-评论，title-视频标题，username-用户名，duplicate-重复投稿的视频
+	TargetId   uint64 `json:"target_id" gorm:"column:target_id"` // 内容对应的 id（评论 id，视频 id，用户 id）
+	UserId     uint64 `json:"user_id" gorm:"column:user_id"`     // 内容作者 id
+	Content    string `json:"content" gorm:"column:content"`
+	Words      string `json:"words" gorm:"column:words"` // 命中的敏感词，以逗号分隔；重复投稿的视频为原视频 id
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"`
+}
+
+const (
+	FLAG_COMMENT   = "comment"
+	FLAG_TITLE     = "title"
+	FLAG_USERNAME  = "username"
+	FLAG_DUPLICATE = "duplicate"
+)
+
+func (Flag) TableName() string {
+	return "moderation_flag"
+}
+
+// FlagForReview 将内容记录到待审核列表，db 可以是事务
+func FlagForReview(db *gorm.DB, workerId uint32, flagType string, targetId, userid uint64, content string, words []string) error {
+	sf, err := snowflake.New(workerId)
+	if err != nil {
+		return err
+	}
+	id, err := sf.Generate()
+	if err != nil {
+		return err
+	}
+
+	return db.Create(&Flag{
+		Id:         id,
+		Type:       flagType,
+		TargetId:   targetId,
+		UserId:     userid,
+		Content:    content,
+		Words:      strings.Join(words, ","),
+		CreateTime: time.Now().Unix(),
+	}).Error
+}
@@ -0,0 +1,101 @@
+package moderation
+
+// word 词库中的敏感词
+type word struct {
+	text   string // 原始词
+	length int    // 归一化后的字符数
+	action string // 命中后的处理方式
+}
+
+// node Aho-Corasick 自动机节点
+type node struct {
+	next map[rune]int32 // 子节点
+	fail int32          // 失配指针
+	out  []int32        // 在该节点结束的敏感词下标（已合并失配链上的敏感词）
+}
+
+// hit 一次命中，start 与 end 为归一化字符序列中的下标（闭区间）
+type hit struct {
+	start int
+	end   int
+	word  int32
+}
+
+// matcher Aho-Corasick 多模式匹配自动机，构建后只读，可并发使用
+type matcher struct {
+	nodes []node
+	words []word
+}
+
+// newMatcher 使用敏感词构建自动机，归一化后为空的词会被忽略
+func newMatcher(words []word) *matcher {
+	m := &matcher{nodes: []node{{next: make(map[rune]int32)}}}
+
+	// 1. 构建字典树
+	for _, w := range words {
+		norm, _ := normalize([]rune(w.text))
+		if len(norm) == 0 {
+			continue
+		}
+		cur := int32(0)
+		for _, r := range norm {
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = int32(len(m.nodes))
+				m.nodes = append(m.nodes, node{next: make(map[rune]int32)})
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		w.length = len(norm)
+		m.nodes[cur].out = append(m.nodes[cur].out, int32(len(m.words)))
+		m.words = append(m.words, w)
+	}
+
+	// 2. 按层序构建失配指针
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f != 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// match 在归一化字符序列中查找所有命中的敏感词（包括相互重叠的）
+func (m *matcher) match(norm []rune) []hit {
+	var hits []hit
+	cur := int32(0)
+	for i, r := range norm {
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for _, w := range m.nodes[cur].out {
+			hits = append(hits, hit{start: i - m.words[w].length + 1, end: i, word: w})
+		}
+	}
+	return hits
+}
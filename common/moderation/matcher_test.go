@@ -0,0 +1,104 @@
+package moderation
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// matchWords 返回文本中命中的敏感词原文，按命中位置排序
+func matchWords(m *matcher, text string) []string {
+	norm, _ := normalize([]rune(text))
+	hits := m.match(norm)
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].end != hits[j].end {
+			return hits[i].end < hits[j].end
+		}
+		return hits[i].start < hits[j].start
+	})
+	words := make([]string, 0, len(hits))
+	for _, h := range hits {
+		words = append(words, m.words[h.word].text)
+	}
+	return words
+}
+
+func newTestMatcher(texts ...string) *matcher {
+	words := make([]word, len(texts))
+	for i, t := range texts {
+		words[i] = word{text: t, action: ACTION_REJECT}
+	}
+	return newMatcher(words)
+}
+
+func TestMatcherMatch(t *testing.T) {
+	cases := []struct {
+		name  string
+		words []string
+		text  string
+		want  []string
+	}{
+		{"no words", nil, "hello", []string{}},
+		{"no hit", []string{"abc"}, "abd", []string{}},
+		{"single hit", []string{"abc"}, "xxabcxx", []string{"abc"}},
+		{"repeated hit", []string{"ab"}, "abab", []string{"ab", "ab"}},
+		{"overlapping words", []string{"abc", "bcd"}, "abcd", []string{"abc", "bcd"}},
+		{"suffix via fail link", []string{"she", "he", "hers"}, "ushers", []string{"she", "he", "hers"}},
+		{"restart after mismatch", []string{"aab"}, "aaab", []string{"aab"}},
+		{"chinese", []string{"敏感词"}, "这是敏感词吗", []string{"敏感词"}},
+		{"separators inside word", []string{"敏感词"}, "敏 感*词", []string{"敏感词"}},
+		{"full width and upper case", []string{"abc"}, "ＡＢＣ", []string{"abc"}},
+		{"word normalized before insert", []string{"A-B"}, "xaby", []string{"A-B"}},
+		{"empty word ignored", []string{"", "**", "ab"}, "ab", []string{"ab"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := matchWords(newTestMatcher(c.words...), c.text)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("match(%q) = %v, want %v", c.text, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMatcherHitPosition(t *testing.T) {
+	m := newTestMatcher("bc")
+	text := []rune("a b-c d")
+	norm, pos := normalize(text)
+	hits := m.match(norm)
+	if len(hits) != 1 {
+		t.Fatalf("got %d hits, want 1", len(hits))
+	}
+	// 命中位置映射回原文本时包括中间的分隔符
+	if pos[hits[0].start] != 2 || pos[hits[0].end] != 4 {
+		t.Errorf("hit covers [%d, %d] of the original text, want [2, 4]", pos[hits[0].start], pos[hits[0].end])
+	}
+}
+
+func TestFilterCheck(t *testing.T) {
+	f := &Filter{}
+	f.matcher.Store(newMatcher([]word{
+		{text: "mask", action: ACTION_MASK},
+		{text: "review", action: ACTION_REVIEW},
+		{text: "reject", action: ACTION_REJECT},
+	}))
+
+	cases := []struct {
+		text   string
+		action string
+		masked string
+		words  []string
+	}{
+		{"clean text", ACTION_PASS, "clean text", nil},
+		{"a m.a.s.k here", ACTION_MASK, "a ******* here", []string{"mask"}},
+		{"mask and review", ACTION_REVIEW, "**** and review", []string{"mask", "review"}},
+		{"REJECT mask mask", ACTION_REJECT, "REJECT **** ****", []string{"reject", "mask"}},
+	}
+	for _, c := range cases {
+		res := f.Check(c.text)
+		if res.Action != c.action || res.Text != c.masked || !reflect.DeepEqual(res.Words, c.words) {
+			t.Errorf("Check(%q) = {%s %q %v}, want {%s %q %v}",
+				c.text, res.Action, res.Text, res.Words, c.action, c.masked, c.words)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package moderation
+
+import "unicode"
+
+// normalizeRune 将字符归一化：全角转半角，大写转小写
+func normalizeRune(r rune) rune {
+	switch {
+	case r == 0x3000: // 全角空格
+		r = ' '
+	case r >= 0xFF01 && r <= 0xFF5E: // 全角 ASCII 字符
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+// isSeparator 判断归一化后的字符是否为分隔符（空白、标点与符号），
+// 匹配时跳过分隔符，用于识别 "敏 感 词"、"敏*感*词" 这类在敏感词中插入分隔符的写法
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsControl(r)
+}
+
+// normalize 归一化文本并去除分隔符，返回归一化后的字符序列以及每个字符在原文本中的下标
+func normalize(runes []rune) ([]rune, []int) {
+	norm := make([]rune, 0, len(runes))
+	pos := make([]int, 0, len(runes))
+	for i, r := range runes {
+		r = normalizeRune(r)
+		if isSeparator(r) {
+			continue
+		}
+		norm = append(norm, r)
+		pos = append(pos, i)
+	}
+	return norm, pos
+}
@@ -0,0 +1,33 @@
+package quota
+
+// Config 投稿限额设置，各服务在自己的配置文件中引用
+// api 网关在上传 OSS 之前检查，投稿服务在转码之前按实际的文件大小与时长再次检查
+type Config struct {
+	Default Limit            `json:",optional" yaml:"Default"` // 普通用户与未单独设置的角色使用的限额
+	Roles   map[string]Limit `json:",optional" yaml:"Roles"`   // 角色（0-普通用户，1-审核员，2-管理员）对应的限额
+}
+
+// Limit 投稿限额，各项为 0 时表示不限制
+type Limit struct {
+	MaxBytes     int64   `json:",optional" yaml:"MaxBytes"`     // 单个视频的最大字节数
+	MaxDuration  float64 `json:",optional" yaml:"MaxDuration"`  // 单个视频的最大时长（秒）
+	DailyUploads int64   `json:",optional" yaml:"DailyUploads"` // 每天最多投稿次数
+}
+
+// For 返回角色对应的限额，没有单独设置限额的角色使用默认限额
+func (c Config) For(role string) Limit {
+	if limit, ok := c.Roles[role]; ok {
+		return limit
+	}
+	return c.Default
+}
+
+// AllowBytes 判断文件大小是否在限额内
+func (l Limit) AllowBytes(size int64) bool {
+	return l.MaxBytes == 0 || size <= l.MaxBytes
+}
+
+// AllowDuration 判断视频时长是否在限额内
+func (l Limit) AllowDuration(duration float64) bool {
+	return l.MaxDuration == 0 || duration <= l.MaxDuration
+}
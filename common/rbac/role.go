@@ -0,0 +1,25 @@
+package rbac
+
+const (
+	ROLE_USER      = "0" // 普通用户
+	ROLE_MODERATOR = "1" // 审核员：审核、下架视频，删除评论
+	ROLE_ADMIN     = "2" // 管理员：拥有审核员的全部权限，另外可以封禁用户、设置角色、重建缓存
+)
+
+// roleLevel 角色的权限等级，高等级角色拥有低等级角色的全部权限
+var roleLevel = map[string]int{
+	ROLE_USER:      0,
+	ROLE_MODERATOR: 1,
+	ROLE_ADMIN:     2,
+}
+
+// IsValidRole 判断是否为已定义的角色
+func IsValidRole(role string) bool {
+	_, ok := roleLevel[role]
+	return ok
+}
+
+// HasRole 判断 role 是否拥有 required 角色的权限，未定义的角色（如升级前签发的 token 中为空）视为普通用户
+func HasRole(role, required string) bool {
+	return roleLevel[role] >= roleLevel[required]
+}
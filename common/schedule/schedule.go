@@ -0,0 +1,9 @@
+package schedule
+
+// MAX_AHEAD 定时发布时间距当前时间的最大秒数（30 天），api 网关在投稿时与视频服务在修改定时发布时间时使用同一限制
+const MAX_AHEAD = 30 * 24 * 3600
+
+// IsValidPublishTime 判断定时发布的时间是否晚于当前时间并且不超过 MAX_AHEAD 秒
+func IsValidPublishTime(publishTime, now int64) bool {
+	return publishTime > now && publishTime <= now+MAX_AHEAD
+}
@@ -0,0 +1,61 @@
+package topic
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	NAME_MAX_LENGTH = 32 // 话题名最大长度（字符数），超出的话题忽略
+	MAX_PER_TITLE   = 10 // 一个标题最多提取的话题数量
+)
+
+// isNameRune 话题名只能包含字母、数字与下划线（包括中日韩文字），遇到其他字符时话题结束
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// Normalize 归一化话题名：去掉开头的 #，转为小写，使 "#Cat" 与 "#cat" 为同一个话题
+func Normalize(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimLeft(name, "#＃")
+	return strings.ToLower(name)
+}
+
+// IsValid 判断归一化后的话题名是否合法
+func IsValid(name string) bool {
+	if name == "" || utf8.RuneCountInString(name) > NAME_MAX_LENGTH {
+		return false
+	}
+	for _, r := range name {
+		if !isNameRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Extract 从视频标题中按出现顺序提取去重后的话题名（已归一化），如 "cat #funny #Pets #funny" 提取出 funny, pets
+// 支持全角 ＃，话题名从 # 之后开始，遇到空白、标点等字符时结束
+func Extract(title string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	runes := []rune(title)
+	for i := 0; i < len(runes) && len(names) < MAX_PER_TITLE; i++ {
+		if runes[i] != '#' && runes[i] != '＃' {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && isNameRune(runes[j]) {
+			j++
+		}
+		name := Normalize(string(runes[i+1 : j]))
+		if IsValid(name) && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		i = j - 1
+	}
+	return names
+}
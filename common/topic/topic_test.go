@@ -0,0 +1,79 @@
+package topic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"cat", "cat"},
+		{"#Cat", "cat"},
+		{"  ##CAT ", "cat"},
+		{"＃猫咪", "猫咪"},
+		{"#", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"cat", true},
+		{"cat_2023", true},
+		{"猫咪", true},
+		{"", false},
+		{"cat dog", false},
+		{"cat-dog", false},
+		{"cat!", false},
+		{strings.Repeat("a", NAME_MAX_LENGTH), true},
+		{strings.Repeat("a", NAME_MAX_LENGTH+1), false},
+		{strings.Repeat("猫", NAME_MAX_LENGTH), true},
+	}
+	for _, tt := range tests {
+		if got := IsValid(tt.name); got != tt.want {
+			t.Errorf("IsValid(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		title string
+		want  []string
+	}{
+		{"no topics here", nil},
+		{"cat #funny #Pets #funny", []string{"funny", "pets"}},
+		{"#a,#b.#c", []string{"a", "b", "c"}},
+		{"全角＃猫咪 与 #猫咪", []string{"猫咪"}},
+		{"#cat#dog", []string{"cat", "dog"}},
+		{"# alone", nil},
+		{"#" + strings.Repeat("a", NAME_MAX_LENGTH+1) + " #ok", []string{"ok"}},
+	}
+	for _, tt := range tests {
+		if got := Extract(tt.title); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Extract(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestExtractLimit(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < MAX_PER_TITLE+5; i++ {
+		b.WriteString("#t")
+		b.WriteByte(byte('a' + i))
+		b.WriteByte(' ')
+	}
+	if got := Extract(b.String()); len(got) != MAX_PER_TITLE {
+		t.Errorf("len(Extract) = %d, want %d", len(got), MAX_PER_TITLE)
+	}
+}
@@ -0,0 +1,60 @@
+package trending
+
+import "strconv"
+
+// Window 热门排行榜的滑动时间窗口，视频服务与视频消费者共用，窗口由 Buckets 个长度为 BucketSeconds 秒的时间桶组成，
+// 点赞、评论等互动按发生时间累加到当前时间桶，排行榜由窗口内的时间桶按衰减权重合并得到
+type Window struct {
+	Name          string
+	BucketSeconds int64
+	Buckets       int64
+}
+
+const (
+	WINDOW_HOUR = "1" // 最近一小时
+	WINDOW_DAY  = "2" // 最近一天
+	WINDOW_WEEK = "3" // 最近一周
+
+	BUCKET_CACHE_KEY_PREFIX = "Trending:Window:Bucket:ZSET:"
+	CACHE_KEY_PREFIX        = "Trending:Window:VideoId:ZSET:"
+)
+
+// Windows 所有时间窗口，每次互动都会累加到每个窗口的当前时间桶
+var Windows = []Window{
+	{Name: WINDOW_HOUR, BucketSeconds: 300, Buckets: 12},
+	{Name: WINDOW_DAY, BucketSeconds: 3600, Buckets: 24},
+	{Name: WINDOW_WEEK, BucketSeconds: 86400, Buckets: 7},
+}
+
+// GetWindow 根据窗口名获取时间窗口
+func GetWindow(name string) (Window, bool) {
+	for _, w := range Windows {
+		if w.Name == name {
+			return w, true
+		}
+	}
+	return Window{}, false
+}
+
+// Bucket 返回时间戳 t 所在的时间桶编号
+func (w Window) Bucket(t int64) int64 {
+	return t / w.BucketSeconds
+}
+
+// BucketTTL 返回时间桶缓存的过期时间，时间桶滑出窗口后过期
+func (w Window) BucketTTL() int64 {
+	return w.BucketSeconds * (w.Buckets + 1)
+}
+
+// BucketCacheKey 返回时间桶对应的缓存 key 名称，
+// 缓存类型为 ZSET 类型，key: Trending:Window:Bucket:ZSET:{窗口}:{时间桶编号}, member: {视频id}, score: 时间桶内的互动分数
+func (w Window) BucketCacheKey(bucket int64) string {
+	return BUCKET_CACHE_KEY_PREFIX + w.Name + ":" + strconv.FormatInt(bucket, 10)
+}
+
+// CacheKey 返回窗口排行榜对应的缓存 key 名称，
+// 缓存类型为 ZSET 类型，key: Trending:Window:VideoId:ZSET:{窗口}, member: {视频id}, score: 衰减后的热度分数
+// 排行榜缓存时间较短，过期后由时间桶重新合并计算
+func (w Window) CacheKey() string {
+	return CACHE_KEY_PREFIX + w.Name
+}
@@ -22,6 +22,7 @@ const (
 	JwtRpc_CreateToken_FullMethodName  = "/jwt.JwtRpc/createToken"
 	JwtRpc_ParseToken_FullMethodName   = "/jwt.JwtRpc/parseToken"
 	JwtRpc_IsValidToken_FullMethodName = "/jwt.JwtRpc/IsValidToken"
+	JwtRpc_RevokeToken_FullMethodName  = "/jwt.JwtRpc/RevokeToken"
 )
 
 // JwtRpcClient is the client API for JwtRpc service.
@@ -31,6 +32,7 @@ type JwtRpcClient interface {
 	CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error)
 	ParseToken(ctx context.Context, in *ParseTokenReq, opts ...grpc.CallOption) (*ParseTokenResp, error)
 	IsValidToken(ctx context.Context, in *IsValidTokenReq, opts ...grpc.CallOption) (*IsValidTokenResp, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*RevokeTokenResp, error)
 }
 
 type jwtRpcClient struct {
@@ -68,6 +70,15 @@ func (c *jwtRpcClient) IsValidToken(ctx context.Context, in *IsValidTokenReq, op
 	return out, nil
 }
 
+func (c *jwtRpcClient) RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*RevokeTokenResp, error) {
+	out := new(RevokeTokenResp)
+	err := c.cc.Invoke(ctx, JwtRpc_RevokeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JwtRpcServer is the server API for JwtRpc service.
 // All implementations must embed UnimplementedJwtRpcServer
 // for forward compatibility
@@ -75,6 +86,7 @@ type JwtRpcServer interface {
 	CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error)
 	ParseToken(context.Context, *ParseTokenReq) (*ParseTokenResp, error)
 	IsValidToken(context.Context, *IsValidTokenReq) (*IsValidTokenResp, error)
+	RevokeToken(context.Context, *RevokeTokenReq) (*RevokeTokenResp, error)
 	mustEmbedUnimplementedJwtRpcServer()
 }
 
@@ -91,6 +103,9 @@ func (UnimplementedJwtRpcServer) ParseToken(context.Context, *ParseTokenReq) (*P
 func (UnimplementedJwtRpcServer) IsValidToken(context.Context, *IsValidTokenReq) (*IsValidTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsValidToken not implemented")
 }
+func (UnimplementedJwtRpcServer) RevokeToken(context.Context, *RevokeTokenReq) (*RevokeTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
 func (UnimplementedJwtRpcServer) mustEmbedUnimplementedJwtRpcServer() {}
 
 // UnsafeJwtRpcServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +173,24 @@ func _JwtRpc_IsValidToken_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JwtRpc_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JwtRpcServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JwtRpc_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JwtRpcServer).RevokeToken(ctx, req.(*RevokeTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JwtRpc_ServiceDesc is the grpc.ServiceDesc for JwtRpc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +210,10 @@ var JwtRpc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsValidToken",
 			Handler:    _JwtRpc_IsValidToken_Handler,
 		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _JwtRpc_RevokeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jwt.proto",
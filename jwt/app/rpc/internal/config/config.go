@@ -6,5 +6,16 @@ type Config struct {
 	zrpc.RpcServerConf
 	JwtConfig struct {
 		AccessSecret string
+		RevokeTTL    int64
+	}
+	RedisConfig struct {
+		Host        string
+		Port        int
+		Username    string
+		Password    string
+		Auth        bool
+		MaxIdle     int
+		Active      int
+		IdleTimeout int
 	}
 }
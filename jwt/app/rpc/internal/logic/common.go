@@ -1,10 +1,36 @@
 package logic
 
-import "errors"
+import (
+	"Mini-Tiktok/jwt/app/rpc/internal/svc"
+	"errors"
+	"github.com/golang-jwt/jwt/v4"
+	"time"
+)
+
+func init() {
+	// 签发时间精确到毫秒，与毫秒精度的吊销时间比较，吊销前同一秒内签发的 token 也会失效
+	jwt.TimePrecision = time.Millisecond
+}
 
 var (
 	TokenExpired     = errors.New("Token is expired")
 	TokenNotValidYet = errors.New("Token not active yet")
 	TokenMalformed   = errors.New("That's not even a token")
 	TokenInvalid     = errors.New("Couldn't handle this token")
+	TokenRevoked     = errors.New("Token has been revoked")
 )
+
+// isRevoked 判断 token 是否在签发后被吊销（如用户修改了密码），签发时间与吊销时间均为毫秒精度
+func isRevoked(svcCtx *svc.ServiceContext, claims *Claims) (bool, error) {
+	conn := svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	revokeTime, err := svcCtx.Redis.GetRevokeTime(conn, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	if revokeTime == 0 || claims.IssuedAt == nil {
+		return false, nil
+	}
+	return claims.IssuedAt.UnixMilli() < revokeTime, nil
+}
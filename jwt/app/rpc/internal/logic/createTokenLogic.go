@@ -19,7 +19,8 @@ type CreateTokenLogic struct {
 
 type Claims struct {
 	UserID               string
-	jwt.RegisteredClaims //jwt 自带载荷
+	Role                 string // 用户角色，0-普通用户，1-审核员，2-管理员
+	jwt.RegisteredClaims        //jwt 自带载荷
 }
 
 func NewCreateTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTokenLogic {
@@ -31,9 +32,10 @@ func NewCreateTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Creat
 }
 
 // BuildClaims 构建 payload
-func (l *CreateTokenLogic) BuildClaims(userID string, ttl int64) Claims {
+func (l *CreateTokenLogic) BuildClaims(userID, role string, ttl int64) Claims {
 	return Claims{
 		UserID: userID,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ttl) * time.Second)), //过期时间
 			IssuedAt:  jwt.NewNumericDate(time.Now()),                                       //签发时间
@@ -42,7 +44,7 @@ func (l *CreateTokenLogic) BuildClaims(userID string, ttl int64) Claims {
 }
 
 func (l *CreateTokenLogic) CreateToken(in *Jwt.CreateTokenReq) (*Jwt.CreateTokenResp, error) {
-	claims := l.BuildClaims(in.UserID, in.AccessExpire)
+	claims := l.BuildClaims(in.UserID, in.Role, in.AccessExpire)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	fmt.Println("token:", token)
 	tokenStr, err := token.SignedString([]byte(l.svcCtx.Config.JwtConfig.AccessSecret))
@@ -32,7 +32,8 @@ func (l *IsValidTokenLogic) secret() jwt.Keyfunc {
 
 func (l *IsValidTokenLogic) IsValidToken(in *Jwt.IsValidTokenReq) (*Jwt.IsValidTokenResp, error) {
 	t := in.Token
-	_, err := jwt.ParseWithClaims(t, &Claims{}, l.secret())
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(t, claims, l.secret())
 	if err != nil {
 		if err != nil {
 			if ve, ok := err.(*jwt.ValidationError); ok {
@@ -48,6 +49,14 @@ func (l *IsValidTokenLogic) IsValidToken(in *Jwt.IsValidTokenReq) (*Jwt.IsValidT
 			}
 		}
 	}
+
+	revoked, err := isRevoked(l.svcCtx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, TokenRevoked
+	}
 	return &Jwt.IsValidTokenResp{
 		IsValid: true,
 	}, nil
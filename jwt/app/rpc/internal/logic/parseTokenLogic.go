@@ -48,9 +48,17 @@ func (l *ParseTokenLogic) ParseToken(in *Jwt.ParseTokenReq) (*Jwt.ParseTokenResp
 		}
 	}
 	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+		revoked, err := isRevoked(l.svcCtx, claims)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, TokenRevoked
+		}
 		return &Jwt.ParseTokenResp{
 			UserID:       claims.UserID,
 			AccessExpire: claims.ExpiresAt.Unix(),
+			Role:         claims.Role,
 		}, nil
 	}
 	return nil, errors.New("couldn't handle this token")
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/Jwt"
+	"Mini-Tiktok/jwt/app/rpc/internal/svc"
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeTokenLogic {
+	return &RevokeTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RevokeToken 吊销用户当前已签发的所有 token
+// 只记录毫秒精度的吊销时间，签发时间早于吊销时间的 token 在解析时会被拒绝
+func (l *RevokeTokenLogic) RevokeToken(in *Jwt.RevokeTokenReq) (*Jwt.RevokeTokenResp, error) {
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	err := l.svcCtx.Redis.SetRevokeTime(conn, in.UserID, time.Now().UnixMilli(), l.svcCtx.Config.JwtConfig.RevokeTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &Jwt.RevokeTokenResp{}, nil
+}
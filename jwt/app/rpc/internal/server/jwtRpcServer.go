@@ -35,3 +35,8 @@ func (s *JwtRpcServer) IsValidToken(ctx context.Context, in *Jwt2.IsValidTokenRe
 	l := logic2.NewIsValidTokenLogic(ctx, s.svcCtx)
 	return l.IsValidToken(in)
 }
+
+func (s *JwtRpcServer) RevokeToken(ctx context.Context, in *Jwt2.RevokeTokenReq) (*Jwt2.RevokeTokenResp, error) {
+	l := logic2.NewRevokeTokenLogic(ctx, s.svcCtx)
+	return l.RevokeToken(in)
+}
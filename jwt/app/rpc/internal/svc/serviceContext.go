@@ -2,14 +2,27 @@ package svc
 
 import (
 	"Mini-Tiktok/jwt/app/rpc/internal/config"
+	"Mini-Tiktok/jwt/app/rpc/model/redisCache"
+	"log"
 )
 
 type ServiceContext struct {
 	Config config.Config
+	Redis  *redisCache.RedisPool
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	pool := redisCache.NewRedisPool(c)
+	conn := pool.NewRedisConn()
+	_, err := conn.Do("PING")
+	defer conn.Close()
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
 	return &ServiceContext{
 		Config: c,
+		Redis:  pool,
 	}
 }
@@ -18,11 +18,14 @@ type (
 	IsValidTokenResp = Jwt2.IsValidTokenResp
 	ParseTokenReq    = Jwt2.ParseTokenReq
 	ParseTokenResp   = Jwt2.ParseTokenResp
+	RevokeTokenReq   = Jwt2.RevokeTokenReq
+	RevokeTokenResp  = Jwt2.RevokeTokenResp
 
 	JwtRpc interface {
 		CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error)
 		ParseToken(ctx context.Context, in *ParseTokenReq, opts ...grpc.CallOption) (*ParseTokenResp, error)
 		IsValidToken(ctx context.Context, in *IsValidTokenReq, opts ...grpc.CallOption) (*IsValidTokenResp, error)
+		RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*RevokeTokenResp, error)
 	}
 
 	defaultJwtRpc struct {
@@ -50,3 +53,8 @@ func (m *defaultJwtRpc) IsValidToken(ctx context.Context, in *IsValidTokenReq, o
 	client := Jwt2.NewJwtRpcClient(m.cli.Conn())
 	return client.IsValidToken(ctx, in, opts...)
 }
+
+func (m *defaultJwtRpc) RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*RevokeTokenResp, error) {
+	client := Jwt2.NewJwtRpcClient(m.cli.Conn())
+	return client.RevokeToken(ctx, in, opts...)
+}
@@ -0,0 +1,67 @@
+package redisCache
+
+import (
+	"Mini-Tiktok/jwt/app/rpc/internal/config"
+	"Mini-Tiktok/jwt/app/rpc/model"
+	"fmt"
+	"github.com/gomodule/redigo/redis"
+	"time"
+)
+
+type RedisPool struct {
+	pool *redis.Pool
+}
+
+// NewRedisPool 新建一个 redis 连接池
+func NewRedisPool(config config.Config) *RedisPool {
+	return &RedisPool{&redis.Pool{
+		MaxIdle:     config.RedisConfig.MaxIdle, //最大空闲连接数
+		MaxActive:   config.RedisConfig.Active,  //最大连接数
+		IdleTimeout: time.Duration(config.RedisConfig.IdleTimeout) * time.Second,
+		Wait:        true, //超过连接数后是否等待
+		Dial: func() (redis.Conn, error) {
+			redisUri := fmt.Sprintf("%s:%d", config.RedisConfig.Host, config.RedisConfig.Port)
+			if config.RedisConfig.Auth {
+				redisConn, err := redis.Dial("tcp", redisUri,
+					redis.DialUsername(config.RedisConfig.Username),
+					redis.DialPassword(config.RedisConfig.Password))
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			} else {
+				redisConn, err := redis.Dial("tcp", redisUri)
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			}
+		},
+	}}
+}
+
+// NewRedisConn 从连接池中获取一个连接
+func (p *RedisPool) NewRedisConn() redis.Conn {
+	return p.pool.Get()
+}
+
+// SetRevokeTime 记录用户 token 的吊销时间（毫秒时间戳），签发时间早于该时间的 token 均失效
+func (p *RedisPool) SetRevokeTime(conn redis.Conn, userid string, revokeTime int64, ttl int64) error {
+	_, err := conn.Do("SETEX", model.Token{}.RevokeCacheKey(userid), ttl, revokeTime)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRevokeTime 获取用户 token 的吊销时间，不存在则返回 0
+func (p *RedisPool) GetRevokeTime(conn redis.Conn, userid string) (int64, error) {
+	revokeTime, err := redis.Int64(conn.Do("GET", model.Token{}.RevokeCacheKey(userid)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return revokeTime, nil
+}
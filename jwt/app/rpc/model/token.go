@@ -0,0 +1,14 @@
+package model
+
+const (
+	RevokeCacheKeyPrefix = "Jwt:UserId:RevokeTime:"
+)
+
+type Token struct{}
+
+// RevokeCacheKey 返回用户 token 吊销时间对应的缓存 key 名称，
+// Revoke 缓存类型为 string 类型，key: Jwt:UserId:RevokeTime:{用户id} value: 吊销时间戳
+// 签发时间早于该时间戳的 token 均视为无效，过期时间不小于 token 的有效期
+func (Token) RevokeCacheKey(userid string) string {
+	return RevokeCacheKeyPrefix + userid
+}
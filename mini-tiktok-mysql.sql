@@ -17,6 +17,104 @@
 SET NAMES utf8mb4;
 SET FOREIGN_KEY_CHECKS = 0;
 
+-- ----------------------------
+-- Table structure for audit_log
+-- ----------------------------
+DROP TABLE IF EXISTS `audit_log`;
+CREATE TABLE `audit_log`
+(
+    `id`            bigint UNSIGNED                                              NOT NULL,
+    `operator_id`   bigint UNSIGNED                                              NOT NULL,
+    `operator_role` char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL,
+    `action`        varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `detail`        text CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci        NOT NULL,
+    `status_code`   varchar(8) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci  NOT NULL,
+    `status_msg`    varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `create_time`   bigint UNSIGNED                                              NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_operator_time` (`operator_id`, `create_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for audio_stat
+-- ----------------------------
+DROP TABLE IF EXISTS `audio_stat`;
+CREATE TABLE `audio_stat`
+(
+    `video_id`      bigint UNSIGNED                                          NOT NULL,
+    `state`         char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `channels`      int                                                      NOT NULL DEFAULT 0,
+    `input_i`       double                                                   NULL DEFAULT NULL,
+    `input_tp`      double                                                   NULL DEFAULT NULL,
+    `input_lra`     double                                                   NULL DEFAULT NULL,
+    `input_thresh`  double                                                   NULL DEFAULT NULL,
+    `target_offset` double                                                   NULL DEFAULT NULL,
+    `target_i`      double                                                   NOT NULL,
+    `create_time`   bigint UNSIGNED                                          NOT NULL,
+    PRIMARY KEY (`video_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for block
+-- ----------------------------
+DROP TABLE IF EXISTS `block`;
+CREATE TABLE `block`
+(
+    `user_id`     bigint UNSIGNED                                             NOT NULL,
+    `target_id`   bigint UNSIGNED                                             NOT NULL,
+    `type`        char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci    NOT NULL,
+    `create_time` bigint UNSIGNED                                             NOT NULL,
+    PRIMARY KEY (`user_id`, `target_id`, `type`) USING BTREE,
+    INDEX `idx_target` (`target_id`, `type`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for collection
+-- ----------------------------
+DROP TABLE IF EXISTS `collection`;
+CREATE TABLE `collection`
+(
+    `id`          bigint UNSIGNED                                              NOT NULL,
+    `user_id`     bigint UNSIGNED                                              NOT NULL,
+    `name`        varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `status`      char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL DEFAULT '0',
+    `video_count` bigint UNSIGNED                                              NOT NULL DEFAULT 0,
+    `sort`        bigint                                                       NOT NULL DEFAULT 0,
+    `create_time` bigint UNSIGNED                                              NOT NULL,
+    `update_time` bigint UNSIGNED                                              NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_user_sort` (`user_id`, `sort`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for collection_video
+-- ----------------------------
+DROP TABLE IF EXISTS `collection_video`;
+CREATE TABLE `collection_video`
+(
+    `collection_id` bigint UNSIGNED NOT NULL,
+    `video_id`      bigint UNSIGNED NOT NULL,
+    `create_time`   bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`collection_id`, `video_id`) USING BTREE,
+    INDEX `idx_collection_time` (`collection_id`, `create_time`) USING BTREE,
+    INDEX `idx_video_id` (`video_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for comment
 -- ----------------------------
@@ -28,6 +126,7 @@ CREATE TABLE `comment`
     `user_id`     bigint UNSIGNED                                               NOT NULL,
     `content`     varchar(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NULL DEFAULT NULL,
     `create_time` bigint UNSIGNED                                               NOT NULL,
+    `status`      char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci      NOT NULL DEFAULT '1',
     PRIMARY KEY (`id`) USING BTREE,
     INDEX `idx_create_time` (`create_time`) USING BTREE
 ) ENGINE = InnoDB
@@ -35,6 +134,30 @@ CREATE TABLE `comment`
   COLLATE = utf8mb4_0900_ai_ci
   ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for daily_stat
+-- ----------------------------
+DROP TABLE IF EXISTS `daily_stat`;
+CREATE TABLE `daily_stat`
+(
+    `target_type`    char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `target_id`      bigint UNSIGNED                                          NOT NULL,
+    `date`           int UNSIGNED                                             NOT NULL,
+    `likes`          bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `comments`       bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `views`          bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `shares`         bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `new_followers`  bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `unfollows`      bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `follower_total` bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `like_total`     bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `update_time`    bigint UNSIGNED                                          NOT NULL,
+    PRIMARY KEY (`target_type`, `target_id`, `date`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for favorite
 -- ----------------------------
@@ -69,6 +192,146 @@ CREATE TABLE `follow`
   COLLATE = utf8mb4_0900_ai_ci
   ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for message
+-- ----------------------------
+DROP TABLE IF EXISTS `message`;
+CREATE TABLE `message`
+(
+    `id`           bigint UNSIGNED                                               NOT NULL,
+    `from_user_id` bigint UNSIGNED                                               NOT NULL,
+    `to_user_id`   bigint UNSIGNED                                               NOT NULL,
+    `content`      varchar(2048) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `create_time`  bigint UNSIGNED                                               NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_chat_time` (`from_user_id`, `to_user_id`, `create_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for moderation_flag
+-- ----------------------------
+DROP TABLE IF EXISTS `moderation_flag`;
+CREATE TABLE `moderation_flag`
+(
+    `id`          bigint UNSIGNED                                                NOT NULL,
+    `type`        varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci   NOT NULL,
+    `target_id`   bigint UNSIGNED                                                NOT NULL,
+    `user_id`     bigint UNSIGNED                                                NOT NULL,
+    `content`     varchar(2048) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `words`       varchar(1024) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `create_time` bigint UNSIGNED                                                NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_type_time` (`type`, `create_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for notification
+-- ----------------------------
+DROP TABLE IF EXISTS `notification`;
+CREATE TABLE `notification`
+(
+    `id`          bigint UNSIGNED                                                NOT NULL,
+    `user_id`     bigint UNSIGNED                                                NOT NULL,
+    `type`        varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci   NOT NULL,
+    `target_id`   bigint UNSIGNED                                                NOT NULL DEFAULT 0,
+    `actor_id`    bigint UNSIGNED                                                NOT NULL DEFAULT 0,
+    `actor_count` bigint UNSIGNED                                                NOT NULL DEFAULT 1,
+    `content`     varchar(4096) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `is_read`     char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci       NOT NULL DEFAULT '0',
+    `create_time` bigint UNSIGNED                                                NOT NULL,
+    `update_time` bigint UNSIGNED                                                NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_user_time` (`user_id`, `update_time`) USING BTREE,
+    INDEX `idx_user_unread` (`user_id`, `is_read`, `type`, `target_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for report
+-- ----------------------------
+DROP TABLE IF EXISTS `report`;
+CREATE TABLE `report`
+(
+    `id`          bigint UNSIGNED                                               NOT NULL,
+    `reporter_id` bigint UNSIGNED                                               NOT NULL,
+    `target_type` char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci      NOT NULL,
+    `target_id`   bigint UNSIGNED                                               NOT NULL,
+    `reason`      char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci      NOT NULL,
+    `content`     varchar(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `create_time` bigint UNSIGNED                                               NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    UNIQUE INDEX `uk_reporter_target` (`reporter_id`, `target_type`, `target_id`) USING BTREE,
+    INDEX `idx_target` (`target_type`, `target_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for report_target
+-- ----------------------------
+DROP TABLE IF EXISTS `report_target`;
+CREATE TABLE `report_target`
+(
+    `target_type`  char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL,
+    `target_id`    bigint UNSIGNED                                              NOT NULL,
+    `owner_id`     bigint UNSIGNED                                              NOT NULL,
+    `report_count` bigint UNSIGNED                                              NOT NULL DEFAULT 0,
+    `status`       char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL DEFAULT '0',
+    `create_time`  bigint UNSIGNED                                              NOT NULL,
+    `update_time`  bigint UNSIGNED                                              NOT NULL,
+    PRIMARY KEY (`target_type`, `target_id`) USING BTREE,
+    INDEX `idx_status_time` (`status`, `create_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for share
+-- ----------------------------
+DROP TABLE IF EXISTS `share`;
+CREATE TABLE `share`
+(
+    `id`          bigint UNSIGNED                                          NOT NULL,
+    `user_id`     bigint UNSIGNED                                          NOT NULL,
+    `video_id`    bigint UNSIGNED                                          NOT NULL,
+    `channel`     char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `to_user_id`  bigint UNSIGNED                                          NOT NULL DEFAULT 0,
+    `create_time` bigint UNSIGNED                                          NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_video_id` (`video_id`) USING BTREE,
+    INDEX `idx_user_id` (`user_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for topic
+-- ----------------------------
+DROP TABLE IF EXISTS `topic`;
+CREATE TABLE `topic`
+(
+    `id`          bigint UNSIGNED                                              NOT NULL,
+    `name`        varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `video_count` bigint                                                       NOT NULL DEFAULT 0,
+    `create_time` bigint UNSIGNED                                              NOT NULL,
+    PRIMARY KEY (`id`) USING BTREE,
+    UNIQUE INDEX `uni_name` (`name`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for user
 -- ----------------------------
@@ -78,6 +341,12 @@ CREATE TABLE `user`
     `id`       bigint UNSIGNED                                              NOT NULL AUTO_INCREMENT,
     `username` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
     `password` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    `nickname` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `avatar` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `background_image` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `signature` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `role`     char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL DEFAULT '0',
+    `status`   char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci     NOT NULL DEFAULT '0',
     PRIMARY KEY (`id`) USING BTREE,
     UNIQUE INDEX `uni_username` (`username`) USING BTREE
 ) ENGINE = InnoDB
@@ -96,15 +365,162 @@ CREATE TABLE `video`
     `user_id`     bigint UNSIGNED                                               NULL DEFAULT NULL,
     `title`       varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NULL DEFAULT NULL,
     `play_url`    varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NULL DEFAULT NULL,
+    `download_url` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `preview_url` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `sprite_url`  varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `sprite_vtt_url` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
     `cover_url`   varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NULL DEFAULT NULL,
     `create_time` bigint UNSIGNED                                               NOT NULL,
+    `status`      char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci      NOT NULL DEFAULT '1',
+    `review_reason` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '',
+    `review_time` bigint UNSIGNED                                               NOT NULL DEFAULT 0,
+    `visibility`  char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci      NOT NULL DEFAULT '0',
+    `publish_state` char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci    NOT NULL DEFAULT '0',
+    `publish_time` bigint UNSIGNED                                              NOT NULL DEFAULT 0,
     PRIMARY KEY (`id`) USING BTREE,
     INDEX `idx_create_time` (`create_time`) USING BTREE,
-    INDEX `idx_user_id` (`user_id`) USING BTREE
+    INDEX `idx_user_id` (`user_id`) USING BTREE,
+    INDEX `idx_status_time` (`status`, `create_time`) USING BTREE,
+    INDEX `idx_publish_state_time` (`publish_state`, `publish_time`) USING BTREE
 ) ENGINE = InnoDB
   AUTO_INCREMENT = 642948764234944512
   CHARACTER SET = utf8mb4
   COLLATE = utf8mb4_0900_ai_ci
   ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for video_audio_fingerprint
+-- ----------------------------
+DROP TABLE IF EXISTS `video_audio_fingerprint`;
+CREATE TABLE `video_audio_fingerprint`
+(
+    `video_id`    bigint UNSIGNED NOT NULL,
+    `fingerprint` mediumblob      NOT NULL,
+    PRIMARY KEY (`video_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for video_cache_task
+-- ----------------------------
+DROP TABLE IF EXISTS `video_cache_task`;
+CREATE TABLE `video_cache_task`
+(
+    `video_id`    bigint UNSIGNED NOT NULL,
+    `create_time` bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`video_id`) USING BTREE,
+    INDEX `idx_create_time` (`create_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for video_duplicate
+-- ----------------------------
+DROP TABLE IF EXISTS `video_duplicate`;
+CREATE TABLE `video_duplicate`
+(
+    `video_id`    bigint UNSIGNED NOT NULL,
+    `original_id` bigint UNSIGNED NOT NULL,
+    `score`       double          NOT NULL,
+    `create_time` bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`video_id`) USING BTREE,
+    INDEX `idx_original_id` (`original_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for video_fingerprint
+-- ----------------------------
+DROP TABLE IF EXISTS `video_fingerprint`;
+CREATE TABLE `video_fingerprint`
+(
+    `video_id`    bigint UNSIGNED   NOT NULL,
+    `frame_index` int               NOT NULL,
+    `hash`        bigint UNSIGNED   NOT NULL,
+    `band0`       smallint UNSIGNED NOT NULL,
+    `band1`       smallint UNSIGNED NOT NULL,
+    `band2`       smallint UNSIGNED NOT NULL,
+    `band3`       smallint UNSIGNED NOT NULL,
+    PRIMARY KEY (`video_id`, `frame_index`) USING BTREE,
+    INDEX `idx_band0` (`band0`) USING BTREE,
+    INDEX `idx_band1` (`band1`) USING BTREE,
+    INDEX `idx_band2` (`band2`) USING BTREE,
+    INDEX `idx_band3` (`band3`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for video_play_stat
+-- ----------------------------
+DROP TABLE IF EXISTS `video_play_stat`;
+CREATE TABLE `video_play_stat`
+(
+    `video_id`       bigint UNSIGNED NOT NULL,
+    `view_count`     bigint UNSIGNED NOT NULL DEFAULT 0,
+    `complete_count` bigint UNSIGNED NOT NULL DEFAULT 0,
+    `watch_duration` bigint UNSIGNED NOT NULL DEFAULT 0,
+    `unique_viewers` bigint UNSIGNED NOT NULL DEFAULT 0,
+    `update_time`    bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`video_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for video_topic
+-- ----------------------------
+DROP TABLE IF EXISTS `video_topic`;
+CREATE TABLE `video_topic`
+(
+    `topic_id`    bigint UNSIGNED NOT NULL,
+    `video_id`    bigint UNSIGNED NOT NULL,
+    `create_time` bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`topic_id`, `video_id`) USING BTREE,
+    INDEX `idx_topic_time` (`topic_id`, `create_time`) USING BTREE,
+    INDEX `idx_video_id` (`video_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for watch_history
+-- ----------------------------
+DROP TABLE IF EXISTS `watch_history`;
+CREATE TABLE `watch_history`
+(
+    `user_id`    bigint UNSIGNED NOT NULL,
+    `video_id`   bigint UNSIGNED NOT NULL,
+    `watch_time` bigint UNSIGNED NOT NULL,
+    PRIMARY KEY (`user_id`, `video_id`) USING BTREE,
+    INDEX `idx_user_time` (`user_id`, `watch_time`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for watch_history_setting
+-- ----------------------------
+DROP TABLE IF EXISTS `watch_history_setting`;
+CREATE TABLE `watch_history_setting`
+(
+    `user_id`     bigint UNSIGNED                                          NOT NULL,
+    `paused`      char(1) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT '0',
+    `update_time` bigint UNSIGNED                                          NOT NULL,
+    PRIMARY KEY (`user_id`) USING BTREE
+) ENGINE = InnoDB
+  CHARACTER SET = utf8mb4
+  COLLATE = utf8mb4_0900_ai_ci
+  ROW_FORMAT = Dynamic;
+
 SET FOREIGN_KEY_CHECKS = 1;
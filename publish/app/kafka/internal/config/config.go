@@ -1,17 +1,29 @@
 package config
 
 import (
+	"errors"
 	"gopkg.in/yaml.v3"
 	"os"
 	"strconv"
 )
 
 type Config struct {
-	DbConfig    DbConfig        `yaml:"DbConfig"`
-	KafkaConfig KafkaConfig     `yaml:"KafkaConfig"`
-	RedisConfig RedisConfig     `yaml:"RedisConfig"`
-	AliyunOss   AliyunOssConfig `yaml:"AliyunOss"`
-	WorkerId    uint32          `yaml:"WorkerId"`
+	DbConfig     DbConfig        `yaml:"DbConfig"`
+	KafkaConfig  KafkaConfig     `yaml:"KafkaConfig"`
+	PushConfig   PushConfig      `yaml:"PushConfig"`
+	SearchConfig PushConfig      `yaml:"SearchConfig"` // 搜索索引事件与推送事件的生产者设置相同
+	RedisConfig  RedisConfig     `yaml:"RedisConfig"`
+	AliyunOss    AliyunOssConfig `yaml:"AliyunOss"`
+	WorkerId     uint32          `yaml:"WorkerId"`
+	Watermark    WatermarkConfig `yaml:"Watermark"`
+	Renditions   struct {
+		Play     RenditionConfig `yaml:"Play"`     // 应用内播放的版本，总是生成
+		Download RenditionConfig `yaml:"Download"` // 下载的版本
+	} `yaml:"Renditions"`
+	Loudness    LoudnessConfig  `yaml:"Loudness"`
+	Preview     PreviewConfig   `yaml:"Preview"`
+	Sprite      SpriteConfig    `yaml:"Sprite"`
+	Duplicate   DuplicateConfig `yaml:"Duplicate"`
 	CacheConfig struct {
 		FEED_MAX_CACHE_SIZE int
 		VIDEO_CACHE_TTL     int
@@ -26,6 +38,14 @@ type KafkaConfig struct {
 	MaxBytes int    `yaml:"MaxBytes"`
 }
 
+type PushConfig struct {
+	Host         string `yaml:"Host"`
+	Topic        string `yaml:"Topic"`
+	BatchTimeout int    `yaml:"BatchTimeout"`
+	BatchSize    int    `yaml:"BatchSize"`
+	BatchBytes   int64  `yaml:"BatchBytes"`
+}
+
 type RedisConfig struct {
 	Host        string `yaml:"Host"`
 	Port        int    `yaml:"Port"`
@@ -44,9 +64,74 @@ type AliyunOssConfig struct {
 	Bucket          string `yaml:"Bucket"`
 	VideoPath       string `yaml:"VideoPath"`
 	CoverPath       string `yaml:"CoverPath"`
+	DownloadPath    string `yaml:"DownloadPath"`
+	PreviewPath     string `yaml:"PreviewPath"`
+	SpritePath      string `yaml:"SpritePath"`
 	UrlPrefix       string `yaml:"UrlPrefix"`
 }
 
+// WatermarkConfig 转码时叠加的水印：logo 图片与作者的 @用户名
+type WatermarkConfig struct {
+	LogoPath     string  `yaml:"LogoPath"`     // logo 图片的本地路径，为空时只叠加用户名
+	LogoHeight   int     `yaml:"LogoHeight"`   // logo 缩放后的高度（像素）
+	FontFile     string  `yaml:"FontFile"`     // 用户名使用的字体文件，为空时使用 ffmpeg 默认字体
+	FontSize     int     `yaml:"FontSize"`     // 用户名字号（像素）
+	FontColor    string  `yaml:"FontColor"`    // 用户名颜色
+	Position     string  `yaml:"Position"`     // 位置：top-left，top-right，bottom-left，bottom-right，moving
+	Margin       int     `yaml:"Margin"`       // 与视频边缘的距离（像素）
+	Opacity      float64 `yaml:"Opacity"`      // 不透明度，0~1
+	MoveInterval int     `yaml:"MoveInterval"` // moving 模式下每隔多少秒移动到下一个角
+}
+
+// RenditionConfig 转码生成的视频版本
+type RenditionConfig struct {
+	Enabled   bool `yaml:"Enabled"`   // 是否生成该版本
+	Watermark bool `yaml:"Watermark"` // 是否叠加水印
+}
+
+// LoudnessConfig EBU R128 响度归一化，先测量源视频的响度，再按测量结果线性调整到目标响度
+type LoudnessConfig struct {
+	Enabled          bool    `yaml:"Enabled"`
+	TargetI          float64 `yaml:"TargetI"`          // 目标综合响度（LUFS）
+	TargetTP         float64 `yaml:"TargetTP"`         // 最大真峰值（dBTP）
+	TargetLRA        float64 `yaml:"TargetLRA"`        // 目标响度范围（LU）
+	SilenceThreshold float64 `yaml:"SilenceThreshold"` // 测得的综合响度低于该值时视为静音，不做归一化
+	SampleRate       int     `yaml:"SampleRate"`       // 输出音频的采样率
+}
+
+// PreviewConfig 无声的动态预览，用于悬停与自动播放
+type PreviewConfig struct {
+	Enabled  bool    `yaml:"Enabled"`
+	Format   string  `yaml:"Format"`   // 格式：webp 或 mp4（循环播放由客户端处理）
+	Start    float64 `yaml:"Start"`    // 从第几秒开始截取，视频较短时从头截取
+	Duration float64 `yaml:"Duration"` // 预览时长（秒）
+	Width    int     `yaml:"Width"`    // 宽度（像素），高度按比例缩放
+	Fps      int     `yaml:"Fps"`      // 帧率
+}
+
+// SpriteConfig 进度条预览的缩略图雪碧图与 WebVTT 索引
+type SpriteConfig struct {
+	Enabled  bool `yaml:"Enabled"`
+	Interval int  `yaml:"Interval"` // 每隔多少秒截取一张缩略图
+	Width    int  `yaml:"Width"`    // 缩略图宽度（像素）
+	Height   int  `yaml:"Height"`   // 缩略图高度（像素），画面按比例缩放后居中补边
+	Columns  int  `yaml:"Columns"`  // 雪碧图每行的缩略图数量
+	MaxCount int  `yaml:"MaxCount"` // 缩略图最大数量，视频较长时增大截取间隔
+}
+
+// DuplicateConfig 重复投稿检测：比较抽样帧的感知哈希与音频指纹，与已有视频的相似度达到阈值时标记或拒绝
+type DuplicateConfig struct {
+	Enabled       bool    `yaml:"Enabled"`
+	Action        string  `yaml:"Action"`        // 命中后的处理：flag-标记到待审核列表，reject-拒绝投稿
+	FrameInterval int     `yaml:"FrameInterval"` // 每隔多少秒抽样一帧
+	MaxFrames     int     `yaml:"MaxFrames"`     // 抽样帧的最大数量，视频较长时增大抽样间隔
+	MaxDistance   int     `yaml:"MaxDistance"`   // 两帧哈希的汉明距离不超过该值时视为相同画面，最大为 3
+	AudioSeconds  int     `yaml:"AudioSeconds"`  // 计算音频指纹的时长（秒），为 0 时不比较音频
+	AudioWeight   float64 `yaml:"AudioWeight"`   // 音频相似度在总相似度中的权重，0~1
+	Threshold     float64 `yaml:"Threshold"`     // 总相似度达到该值时视为重复投稿，0~1
+	MaxCandidates int     `yaml:"MaxCandidates"` // 按哈希分段命中帧数排序后参与比较的候选视频的最大数量
+}
+
 type DbConfig struct {
 	Path         string `json:"path" yaml:"path"`                     // 服务器地址
 	Port         int    `json:"port" yaml:"port"`                     //:端口
@@ -76,4 +161,90 @@ func MustLoad(configPath string, c *Config) {
 	if err != nil {
 		panic(err)
 	}
+	err = c.Validate()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Validate 检查启用的功能的设置，避免转码时出现除以 0 等错误，设置有误时服务启动失败
+func (c *Config) Validate() error {
+	if c.Duplicate.Enabled {
+		err := c.Duplicate.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if c.Renditions.Play.Watermark || (c.Renditions.Download.Enabled && c.Renditions.Download.Watermark) {
+		err := c.Watermark.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if c.Preview.Enabled {
+		err := c.Preview.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if c.Sprite.Enabled {
+		err := c.Sprite.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate 检查水印的设置，moving 模式下 MoveInterval 为 0 时 ffmpeg 表达式会除以 0
+func (c WatermarkConfig) Validate() error {
+	switch c.Position {
+	case "top-left", "top-right", "bottom-left", "bottom-right":
+	case "moving":
+		if c.MoveInterval <= 0 {
+			return errors.New("Watermark.MoveInterval must be positive in moving mode")
+		}
+	default:
+		return errors.New("Watermark.Position must be top-left, top-right, bottom-left, bottom-right or moving")
+	}
+	if c.FontSize <= 0 || (c.LogoPath != "" && c.LogoHeight <= 0) {
+		return errors.New("Watermark.FontSize and LogoHeight must be positive")
+	}
+	if c.Margin < 0 || c.Opacity < 0 || c.Opacity > 1 {
+		return errors.New("Watermark.Margin must not be negative and Opacity must be within [0, 1]")
+	}
+	return nil
+}
+
+// Validate 检查动态预览的设置
+func (c PreviewConfig) Validate() error {
+	if c.Format != "webp" && c.Format != "mp4" {
+		return errors.New("Preview.Format must be webp or mp4")
+	}
+	if c.Start < 0 || c.Duration <= 0 || c.Width <= 0 || c.Fps <= 0 {
+		return errors.New("Preview.Duration, Width and Fps must be positive and Start must not be negative")
+	}
+	return nil
+}
+
+// Validate 检查进度条缩略图的设置
+func (c SpriteConfig) Validate() error {
+	if c.Interval <= 0 || c.Width <= 0 || c.Height <= 0 || c.Columns <= 0 || c.MaxCount <= 0 {
+		return errors.New("Sprite.Interval, Width, Height, Columns and MaxCount must be positive")
+	}
+	return nil
+}
+
+// Validate 检查重复投稿检测的设置
+func (c DuplicateConfig) Validate() error {
+	if c.FrameInterval <= 0 || c.MaxFrames <= 0 || c.MaxCandidates <= 0 {
+		return errors.New("Duplicate.FrameInterval, MaxFrames and MaxCandidates must be positive")
+	}
+	if c.MaxDistance < 0 || c.AudioSeconds < 0 {
+		return errors.New("Duplicate.MaxDistance and AudioSeconds must not be negative")
+	}
+	if c.AudioWeight < 0 || c.AudioWeight > 1 || c.Threshold <= 0 || c.Threshold > 1 {
+		return errors.New("Duplicate.AudioWeight must be within [0, 1] and Threshold within (0, 1]")
+	}
+	return nil
 }
@@ -5,4 +5,12 @@ const (
 	OUTPUT_FILEPATH = "/tmp/Mini-Tiktok/complete/"
 	MP4_SUFFIX      = ".mp4"
 	JPG_SUFFIX      = ".jpg"
+	WEBP_SUFFIX     = ".webp"
+	VTT_SUFFIX      = ".vtt"
+	PUBLISH_SUCCESS = "0"
+	PUBLISH_FAIL    = "1"
+
+	PUBLISH_REASON_DUPLICATE = "duplicate" // 投稿失败的原因：重复投稿
+	PUBLISH_REASON_TOO_LARGE = "too_large" // 投稿失败的原因：文件大小超出限额
+	PUBLISH_REASON_TOO_LONG  = "too_long"  // 投稿失败的原因：时长超出限额
 )
@@ -0,0 +1,249 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/fingerprint"
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/publish/app/kafka/internal/config"
+	"Mini-Tiktok/publish/app/kafka/model"
+	"fmt"
+	"gorm.io/gorm"
+	"log"
+	"math"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+const (
+	DUPLICATE_FLAG   = "flag"   // 重复投稿记录到待审核列表，投稿正常处理
+	DUPLICATE_REJECT = "reject" // 拒绝重复投稿
+)
+
+// videoFingerprint 投稿视频的指纹：抽样帧的感知哈希与开头一段音频的 chromaprint 指纹
+type videoFingerprint struct {
+	Frames []uint64
+	Audio  []uint32 // 没有音轨或计算失败时为空，不参与比较
+}
+
+// duplicateMatch 重复投稿检测命中的原视频
+type duplicateMatch struct {
+	OriginalId uint64
+	Score      float64 // 相似度，0~1
+}
+
+// checkDuplicate 计算投稿视频的指纹，并在已有视频的指纹索引中查找相似度达到阈值的视频
+// 检测失败只记录日志，不影响投稿，计算指纹失败时返回的指纹为 nil，不写入索引
+func (l *TranscodingLogic) checkDuplicate(input string) (*videoFingerprint, *duplicateMatch) {
+	fp, err := computeFingerprint(l.svcCtx.Config.Duplicate, input)
+	if err != nil {
+		log.Println(err)
+		return nil, nil
+	}
+	match, err := l.findDuplicate(fp)
+	if err != nil {
+		log.Println(err)
+		return fp, nil
+	}
+	return fp, match
+}
+
+// computeFingerprint 调用 ffmpeg 按间隔抽样帧并缩放为 32x32 的灰度图计算感知哈希，再计算开头一段音频的指纹
+// 视频较长时增大抽样间隔，使抽样帧均匀覆盖整个视频，黑屏、纯色转场等没有内容的帧不计算哈希；
+// 音频指纹需要 ffmpeg 启用 chromaprint，计算失败只记录日志
+func computeFingerprint(conf config.DuplicateConfig, input string) (*videoFingerprint, error) {
+	duration, err := probeDuration(input)
+	if err != nil {
+		return nil, err
+	}
+	interval := math.Max(float64(conf.FrameInterval), duration/float64(conf.MaxFrames))
+	vf := fmt.Sprintf("fps=1/%s,scale=%d:%d:flags=area,format=gray",
+		formatSeconds(interval), fingerprint.FRAME_SIZE, fingerprint.FRAME_SIZE)
+	raw, err := exec.Command("ffmpeg", "-v", "error", "-i", input, "-vf", vf,
+		"-frames:v", strconv.Itoa(conf.MaxFrames), "-f", "rawvideo", "-").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	fp := &videoFingerprint{}
+	for i := 0; i+fingerprint.FRAME_BYTES <= len(raw); i += fingerprint.FRAME_BYTES {
+		frame := raw[i : i+fingerprint.FRAME_BYTES]
+		if fingerprint.IsFlatFrame(frame) {
+			continue
+		}
+		fp.Frames = append(fp.Frames, fingerprint.FrameHash(frame))
+	}
+
+	if conf.AudioSeconds > 0 {
+		raw, err = exec.Command("ffmpeg", "-v", "error", "-i", input, "-map", "0:a:0", "-vn",
+			"-t", strconv.Itoa(conf.AudioSeconds), "-f", "chromaprint", "-fp_format", "raw", "-").Output()
+		if err != nil {
+			log.Println(err)
+		} else {
+			fp.Audio = fingerprint.ParseAudio(raw)
+		}
+	}
+	return fp, nil
+}
+
+// candidateVideo 按哈希分段命中的候选视频与命中的帧数
+type candidateVideo struct {
+	VideoId uint64
+	Hits    int
+}
+
+// findDuplicate 在指纹索引中查找与投稿视频最相似的已有视频，相似度未达到阈值时返回 nil
+// 汉明距离不超过 BAND_COUNT-1 的两个哈希至少有一段完全相同，所以先按分段精确匹配查找候选视频，再计算汉明距离
+// 候选视频按分段命中的帧数排序后取前 MaxCandidates 个，未通过审核与已下架的视频不作为原视频
+// 画面相似度为投稿视频中能找到相同画面的抽样帧比例，两个视频都有音频指纹时按权重与音频相似度加权
+// 命中的视频本身是重复投稿时返回其原视频
+func (l *TranscodingLogic) findDuplicate(fp *videoFingerprint) (*duplicateMatch, error) {
+	conf := l.svcCtx.Config.Duplicate
+	if len(fp.Frames) == 0 {
+		return nil, nil
+	}
+	maxDistance := conf.MaxDistance
+	if maxDistance > fingerprint.BAND_COUNT-1 {
+		maxDistance = fingerprint.BAND_COUNT - 1
+	}
+
+	var bands [fingerprint.BAND_COUNT][]uint16
+	for _, hash := range fp.Frames {
+		for i, band := range fingerprint.Bands(hash) {
+			bands[i] = append(bands[i], band)
+		}
+	}
+	var ranked []candidateVideo
+	err := l.svcCtx.Db.Table("video_fingerprint f").Select("f.video_id, count(*) as hits").
+		Joins("join video v on v.id = f.video_id").
+		Where("v.status in ?", []string{model.VideoStatusPending, model.VideoStatusApproved}).
+		Where("(f.band0 in ? or f.band1 in ? or f.band2 in ? or f.band3 in ?)", bands[0], bands[1], bands[2], bands[3]).
+		Group("f.video_id").Order("hits DESC").Limit(conf.MaxCandidates).Scan(&ranked).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) == 0 {
+		return nil, nil
+	}
+	candidateIds := make([]uint64, len(ranked))
+	for i, c := range ranked {
+		candidateIds[i] = c.VideoId
+	}
+
+	var candidates []fingerprint.VideoFingerprint
+	err = l.svcCtx.Db.Select("video_id", "hash").Where("video_id in ?", candidateIds).Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[uint64][]uint64)
+	for _, c := range candidates {
+		hashes[c.VideoId] = append(hashes[c.VideoId], c.Hash)
+	}
+
+	scores := make(map[uint64]float64, len(hashes))
+	videoIds := make([]uint64, 0, len(hashes))
+	for videoId, candidateHashes := range hashes {
+		matched := 0
+		for _, hash := range fp.Frames {
+			for _, c := range candidateHashes {
+				if fingerprint.Hamming(hash, c) <= maxDistance {
+					matched++
+					break
+				}
+			}
+		}
+		scores[videoId] = float64(matched) / float64(len(fp.Frames))
+		videoIds = append(videoIds, videoId)
+	}
+
+	if len(fp.Audio) > 0 && conf.AudioWeight > 0 {
+		var audios []fingerprint.VideoAudioFingerprint
+		err = l.svcCtx.Db.Where("video_id in ?", videoIds).Find(&audios).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range audios {
+			similarity := fingerprint.AudioSimilarity(fp.Audio, fingerprint.ParseAudio(a.Fingerprint))
+			scores[a.VideoId] = scores[a.VideoId]*(1-conf.AudioWeight) + similarity*conf.AudioWeight
+		}
+	}
+
+	// 相似度相同时取 id 较小（较早投稿）的视频
+	var match *duplicateMatch
+	for videoId, score := range scores {
+		if score < conf.Threshold {
+			continue
+		}
+		if match == nil || score > match.Score || (score == match.Score && videoId < match.OriginalId) {
+			match = &duplicateMatch{OriginalId: videoId, Score: score}
+		}
+	}
+	if match == nil {
+		return nil, nil
+	}
+
+	var duplicates []fingerprint.VideoDuplicate
+	err = l.svcCtx.Db.Where("video_id = ?", match.OriginalId).Limit(1).Find(&duplicates).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(duplicates) > 0 {
+		match.OriginalId = duplicates[0].OriginalId
+	}
+	return match, nil
+}
+
+// saveFingerprint 将视频的指纹写入索引，match 不为 nil 时同时记录重复投稿与原视频，并记录到待审核列表
+func (l *TranscodingLogic) saveFingerprint(videoInfo *model.Video, fp *videoFingerprint, match *duplicateMatch) error {
+	uid, err := strconv.ParseUint(videoInfo.UserId, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	frames := make([]fingerprint.VideoFingerprint, len(fp.Frames))
+	for i, hash := range fp.Frames {
+		bands := fingerprint.Bands(hash)
+		frames[i] = fingerprint.VideoFingerprint{
+			VideoId:    videoInfo.Id,
+			FrameIndex: i,
+			Hash:       hash,
+			Band0:      bands[0],
+			Band1:      bands[1],
+			Band2:      bands[2],
+			Band3:      bands[3],
+		}
+	}
+
+	return l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		if len(frames) > 0 {
+			err := tx.Create(&frames).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(fp.Audio) > 0 {
+			err := tx.Create(&fingerprint.VideoAudioFingerprint{
+				VideoId:     videoInfo.Id,
+				Fingerprint: fingerprint.EncodeAudio(fp.Audio),
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if match == nil {
+			return nil
+		}
+
+		now := time.Now().Unix()
+		err := tx.Create(&fingerprint.VideoDuplicate{
+			VideoId:    videoInfo.Id,
+			OriginalId: match.OriginalId,
+			Score:      match.Score,
+			CreateTime: now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return moderation.FlagForReview(tx, l.svcCtx.Config.WorkerId, moderation.FLAG_DUPLICATE, videoInfo.Id, uid,
+			videoInfo.Title, []string{strconv.FormatUint(match.OriginalId, 10)})
+	})
+}
@@ -0,0 +1,135 @@
+package logic
+
+import (
+	"Mini-Tiktok/publish/app/kafka/internal/config"
+	"Mini-Tiktok/publish/app/kafka/model"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const SILENT_AUDIO_SOURCE = "anullsrc=channel_layout=stereo:sample_rate=%d" // 没有音轨的视频添加的静音音轨
+
+// loudnormStats loudnorm 第一遍测量输出的 json，数值均为字符串，完全静音时响度为 -inf
+type loudnormStats struct {
+	InputI       string `json:"input_i"`
+	InputTP      string `json:"input_tp"`
+	InputLRA     string `json:"input_lra"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// audioPlan 转码时的音频处理方式，由源视频的音频分析得到，所有版本使用相同的处理方式
+type audioPlan struct {
+	State    string         // 同 model.AudioStat.State
+	Channels int            // 源视频的声道数，没有音轨时为 0
+	Stats    *loudnormStats // 第一遍测量的结果，没有测量时为 nil
+}
+
+// analyzeAudio 分析源视频的音频：没有音轨时添加静音音轨；开启归一化时用 loudnorm 测量响度，
+// 综合响度低于 SilenceThreshold 的静音音轨不做归一化，避免放大底噪
+func analyzeAudio(conf config.LoudnessConfig, input string) (*audioPlan, error) {
+	out, err := exec.Command("ffprobe", "-v", "error", "-select_streams", "a:0",
+		"-show_entries", "stream=channels", "-of", "csv=p=0", input).Output()
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return &audioPlan{State: model.AudioStateAbsent}, nil
+	}
+	channels, err := strconv.Atoi(strings.TrimSpace(string(out)))
+	if err != nil {
+		return nil, err
+	}
+	plan := &audioPlan{State: model.AudioStateUnmeasured, Channels: channels}
+	if !conf.Enabled {
+		return plan, nil
+	}
+
+	// 第一遍：测量响度，loudnorm 将 json 格式的测量结果输出到 stderr 的最后
+	filter := fmt.Sprintf("loudnorm=I=%s:TP=%s:LRA=%s:print_format=json",
+		formatLoudness(conf.TargetI), formatLoudness(conf.TargetTP), formatLoudness(conf.TargetLRA))
+	output, err := exec.Command("ffmpeg", "-hide_banner", "-i", input, "-map", "0:a:0", "-af", filter, "-f", "null", "-").CombinedOutput()
+	if err != nil {
+		return nil, err
+	}
+	start, end := strings.LastIndex(string(output), "{"), strings.LastIndex(string(output), "}")
+	if start < 0 || end < start {
+		return nil, errors.New("loudnorm: measurement not found in ffmpeg output")
+	}
+	var stats loudnormStats
+	err = json.Unmarshal(output[start:end+1], &stats)
+	if err != nil {
+		return nil, err
+	}
+	plan.Stats = &stats
+
+	inputI, err := strconv.ParseFloat(stats.InputI, 64)
+	if err != nil || inputI < conf.SilenceThreshold {
+		plan.State = model.AudioStateSilent
+	} else {
+		plan.State = model.AudioStateNormalized
+	}
+	return plan, nil
+}
+
+// args 返回音频处理的 ffmpeg 参数，index 为静音音轨作为输入时的输入序号
+// 返回的 inputs 放在其他输入之后，opts 放在输出文件之前
+func (p *audioPlan) args(conf config.LoudnessConfig, index int) (inputs, opts []string) {
+	if p.State == model.AudioStateAbsent {
+		inputs = []string{"-f", "lavfi", "-i", fmt.Sprintf(SILENT_AUDIO_SOURCE, conf.SampleRate)}
+		opts = []string{"-map", strconv.Itoa(index) + ":a", "-shortest"}
+		return
+	}
+
+	opts = []string{"-map", "0:a:0"}
+	if p.State == model.AudioStateNormalized {
+		// 第二遍：使用第一遍的测量结果线性归一化，不会引入动态压缩
+		opts = append(opts, "-af", fmt.Sprintf("loudnorm=I=%s:TP=%s:LRA=%s:measured_I=%s:measured_TP=%s:measured_LRA=%s:measured_thresh=%s:offset=%s:linear=true",
+			formatLoudness(conf.TargetI), formatLoudness(conf.TargetTP), formatLoudness(conf.TargetLRA),
+			p.Stats.InputI, p.Stats.InputTP, p.Stats.InputLRA, p.Stats.InputThresh, p.Stats.TargetOffset))
+	}
+	if p.Channels > 2 {
+		opts = append(opts, "-ac", "2")
+	}
+	// loudnorm 输出的采样率为 192kHz，需要重新设置
+	opts = append(opts, "-ar", strconv.Itoa(conf.SampleRate))
+	return
+}
+
+// audioStat 将音频处理方式与测量结果转换为表结构
+func (p *audioPlan) audioStat(conf config.LoudnessConfig, videoId uint64) *model.AudioStat {
+	stat := &model.AudioStat{
+		VideoId:    videoId,
+		State:      p.State,
+		Channels:   p.Channels,
+		TargetI:    conf.TargetI,
+		CreateTime: time.Now().Unix(),
+	}
+	if p.Stats != nil {
+		stat.InputI = parseLoudness(p.Stats.InputI)
+		stat.InputTP = parseLoudness(p.Stats.InputTP)
+		stat.InputLRA = parseLoudness(p.Stats.InputLRA)
+		stat.InputThresh = parseLoudness(p.Stats.InputThresh)
+		stat.TargetOffset = parseLoudness(p.Stats.TargetOffset)
+	}
+	return stat
+}
+
+// parseLoudness 解析测量结果，-inf 等无法存入 DB 的值返回 nil
+func parseLoudness(s string) *float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
+
+func formatLoudness(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
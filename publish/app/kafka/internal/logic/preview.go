@@ -0,0 +1,167 @@
+package logic
+
+import (
+	"Mini-Tiktok/publish/app/kafka/internal/config"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const PREVIEW_FORMAT_MP4 = "mp4"
+
+// spriteLayout 雪碧图的布局，缩略图按时间顺序从左到右、从上到下排列
+type spriteLayout struct {
+	Interval float64 // 截取间隔（秒）
+	Count    int     // 缩略图数量
+	Columns  int
+	Rows     int
+}
+
+// thumbnails 生成动态预览与进度条缩略图并上传到 OSS，返回预览、雪碧图与 WebVTT 索引的地址
+// 生成或上传失败只记录日志，对应的地址为空，不影响投稿
+func (l *TranscodingLogic) thumbnails(input, baseName string) (previewUrl, spriteUrl, vttUrl string) {
+	conf := l.svcCtx.Config
+	if !conf.Preview.Enabled && !conf.Sprite.Enabled {
+		return
+	}
+	duration, err := probeDuration(input)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if conf.Preview.Enabled {
+		name := baseName + "_preview" + WEBP_SUFFIX
+		if conf.Preview.Format == PREVIEW_FORMAT_MP4 {
+			name = baseName + "_preview" + MP4_SUFFIX
+		}
+		previewUrl, err = l.generateAndUpload(name, conf.AliyunOss.PreviewPath, func(path string) error {
+			return makePreview(conf.Preview, input, path, duration)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if conf.Sprite.Enabled {
+		layout := newSpriteLayout(conf.Sprite, duration)
+		spriteUrl, err = l.generateAndUpload(baseName+"_sprite"+JPG_SUFFIX, conf.AliyunOss.SpritePath, func(path string) error {
+			return makeSprite(conf.Sprite, input, path, layout)
+		})
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		// WebVTT 索引中使用雪碧图的完整地址，需要在雪碧图上传后生成
+		vttUrl, err = l.generateAndUpload(baseName+"_sprite"+VTT_SUFFIX, conf.AliyunOss.SpritePath, func(path string) error {
+			return os.WriteFile(path, spriteVTT(conf.Sprite, layout, spriteUrl, duration), 0644)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	return
+}
+
+// generateAndUpload 在本地生成文件后上传到 OSS，返回文件 url，本地文件在返回前删除
+func (l *TranscodingLogic) generateAndUpload(name, ossPath string, generate func(path string) error) (string, error) {
+	path := OUTPUT_FILEPATH + name
+	defer os.Remove(path)
+	err := generate(path)
+	if err != nil {
+		return "", err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	return l.OssUploadFile(file, ossPath, name)
+}
+
+// probeDuration 使用 ffprobe 获取视频时长（秒）
+func probeDuration(path string) (float64, error) {
+	out, err := exec.Command("ffprobe", "-v", "error", "-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1", path).Output()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+}
+
+// makePreview 截取一段去掉音频的动态预览，视频短于 Start + Duration 时从头截取
+func makePreview(conf config.PreviewConfig, input, output string, duration float64) error {
+	start := conf.Start
+	if start+conf.Duration > duration {
+		start = 0
+	}
+	args := []string{"-ss", formatSeconds(start), "-t", formatSeconds(conf.Duration), "-i", input,
+		"-an", "-vf", fmt.Sprintf("fps=%d,scale=%d:-2", conf.Fps, conf.Width)}
+	if conf.Format == PREVIEW_FORMAT_MP4 {
+		args = append(args, "-movflags", "+faststart")
+	} else {
+		args = append(args, "-loop", "0")
+	}
+	args = append(args, "-y", output)
+	return exec.Command("ffmpeg", args...).Run()
+}
+
+// newSpriteLayout 按视频时长计算缩略图数量与雪碧图的行列数，数量超过 MaxCount 时增大截取间隔
+func newSpriteLayout(conf config.SpriteConfig, duration float64) spriteLayout {
+	interval := float64(conf.Interval)
+	count := int(math.Ceil(duration / interval))
+	if count > conf.MaxCount {
+		count = conf.MaxCount
+		interval = duration / float64(count)
+	}
+	if count < 1 {
+		count = 1
+	}
+	columns := conf.Columns
+	if count < columns {
+		columns = count
+	}
+	return spriteLayout{
+		Interval: interval,
+		Count:    count,
+		Columns:  columns,
+		Rows:     (count + columns - 1) / columns,
+	}
+}
+
+// makeSprite 每隔 Interval 秒截取一张缩略图，缩放并补边到相同大小后拼接为一张雪碧图
+func makeSprite(conf config.SpriteConfig, input, output string, layout spriteLayout) error {
+	vf := fmt.Sprintf("fps=1/%s,scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2,tile=%dx%d",
+		formatSeconds(layout.Interval), conf.Width, conf.Height, conf.Width, conf.Height, layout.Columns, layout.Rows)
+	return exec.Command("ffmpeg", "-i", input, "-vf", vf, "-frames:v", "1", "-q:v", "5", "-y", output).Run()
+}
+
+// spriteVTT 生成缩略图的 WebVTT 索引，每段时间对应雪碧图中的一个区域（spriteUrl#xywh=x,y,w,h）
+func spriteVTT(conf config.SpriteConfig, layout spriteLayout, spriteUrl string, duration float64) []byte {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for i := 0; i < layout.Count; i++ {
+		start := float64(i) * layout.Interval
+		if start >= duration {
+			break
+		}
+		end := math.Min(start+layout.Interval, duration)
+		fmt.Fprintf(&b, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n", vttTime(start), vttTime(end), spriteUrl,
+			i%layout.Columns*conf.Width, i/layout.Columns*conf.Height, conf.Width, conf.Height)
+	}
+	return []byte(b.String())
+}
+
+// vttTime 将秒数格式化为 WebVTT 时间戳 hh:mm:ss.ttt
+func vttTime(seconds float64) string {
+	ms := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', 3, 64)
+}
@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/quota"
+	"os"
+)
+
+// publishRejection 投稿被拒绝的原因，随投稿失败事件推送给用户
+type publishRejection struct {
+	Reason     string
+	OriginalId uint64 // 重复投稿时原视频的 id
+}
+
+// checkQuota 按作者角色的投稿限额检查源视频的实际大小与时长，超出限额时返回拒绝原因
+// 限额只在 api 网关中设置，随投稿消息传递；api 网关在上传 OSS 之前已检查过一次，但无法解析文件头时不检查时长
+func checkQuota(limit quota.Limit, input string) (*publishRejection, error) {
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, err
+	}
+	if !limit.AllowBytes(info.Size()) {
+		return &publishRejection{Reason: PUBLISH_REASON_TOO_LARGE}, nil
+	}
+	if limit.MaxDuration > 0 {
+		duration, err := probeDuration(input)
+		if err != nil {
+			return nil, err
+		}
+		if !limit.AllowDuration(duration) {
+			return &publishRejection{Reason: PUBLISH_REASON_TOO_LONG}, nil
+		}
+	}
+	return nil, nil
+}
+
+// removeSource 删除原视频（本地与OSS），用于拒绝投稿时
+func (l *TranscodingLogic) removeSource(filePath, objectKey string) error {
+	err := os.Remove(filePath)
+	if err != nil {
+		return err
+	}
+	return l.OssDeleteFile(objectKey)
+}
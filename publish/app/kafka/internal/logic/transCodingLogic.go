@@ -1,14 +1,22 @@
 package logic
 
 import (
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/common/quota"
+	"Mini-Tiktok/common/topic"
 	"Mini-Tiktok/publish/app/kafka/internal/svc"
 	"Mini-Tiktok/publish/app/kafka/model"
 	"context"
 	"encoding/json"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/ncghost1/snowflake-go"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -26,8 +34,13 @@ func NewTranscodingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Trans
 }
 
 type MsgInfo struct {
-	Title        string `json:"title"`
-	OssObjectKey string `json:"ossObjectKey"`
+	Title        string      `json:"title"`
+	OssObjectKey string      `json:"ossObjectKey"`
+	ReviewWords  []string    `json:"reviewWords,omitempty"` // 标题命中的审核类敏感词
+	Visibility   string      `json:"visibility,omitempty"`  // 视频可见范围，为空表示公开
+	PublishType  string      `json:"publishType,omitempty"` // 发布方式：为空或 0-立即发布，1-保存为草稿，2-定时发布
+	PublishTime  int64       `json:"publishTime,omitempty"` // 定时发布的时间
+	Quota        quota.Limit `json:"quota"`                 // 作者角色的投稿限额，由 api 网关按其设置填写
 }
 
 // TransCoding 视频转码服务
@@ -37,6 +50,14 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 	if err != nil {
 		return err
 	}
+
+	// 投稿处理结束后通知用户，视频信息写入 DB 之前发生的错误都视为投稿失败
+	var published *model.Video
+	var rejected *publishRejection
+	defer func() {
+		l.pushPublishEvent(userid, msgInfo.Title, published, rejected)
+	}()
+
 	// 1. 从 Oss 下载待处理的视频文件
 	filePath, err := l.OssDownloadFile(msgInfo.OssObjectKey)
 	if err != nil {
@@ -47,8 +68,30 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 		return err
 	}
 
+	// 按 api 网关随消息传递的投稿限额检查源视频的实际大小与时长，超出限额时拒绝投稿并删除原视频（本地与OSS）
+	rejected, err = checkQuota(msgInfo.Quota, filePath)
+	if err != nil {
+		return err
+	}
+	if rejected != nil {
+		return l.removeSource(filePath, msgInfo.OssObjectKey)
+	}
+
+	// 检测重复投稿，按配置拒绝投稿并删除原视频（本地与OSS），或在视频信息写入 db 后记录到待审核列表
+	var fp *videoFingerprint
+	var duplicate *duplicateMatch
+	if l.svcCtx.Config.Duplicate.Enabled {
+		fp, duplicate = l.checkDuplicate(filePath)
+		if duplicate != nil && l.svcCtx.Config.Duplicate.Action == DUPLICATE_REJECT {
+			rejected = &publishRejection{Reason: PUBLISH_REASON_DUPLICATE, OriginalId: duplicate.OriginalId}
+			return l.removeSource(filePath, msgInfo.OssObjectKey)
+		}
+	}
+
 	fileName := splits[len(splits)-1]
-	coverName := strings.TrimSuffix(fileName, MP4_SUFFIX) + "_cover" + JPG_SUFFIX
+	baseName := strings.TrimSuffix(fileName, MP4_SUFFIX)
+	coverName := baseName + "_cover" + JPG_SUFFIX
+	downloadName := baseName + "_download" + MP4_SUFFIX
 	outputPath := OUTPUT_FILEPATH + fileName
 	coverPath := OUTPUT_FILEPATH + coverName
 	if !pathExist(OUTPUT_FILEPATH) {
@@ -59,11 +102,38 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 	}
 
 	// 2. 调用 ffmpeg 视频转码与截取封面（请确保本地安装了 ffmpeg，并设置了环境变量）
-	cmd := exec.Command("ffmpeg", "-i", filePath, "-preset", "fast", outputPath)
-	cmd.Run()
-	cmd = exec.Command("ffmpeg", "-i", outputPath, "-ss", "00:00:00", "-frames:v", "1", coverPath)
+	// 先分析源视频的音频，所有版本使用相同的音频处理方式
+	audio, err := analyzeAudio(l.svcCtx.Config.Loudness, filePath)
+	if err != nil {
+		return err
+	}
+	// 应用内播放与下载两个版本按配置分别决定是否叠加水印，两个版本的水印设置相同时下载版本直接使用播放版本
+	renditions := l.svcCtx.Config.Renditions
+	var textFile string
+	if renditions.Play.Watermark || (renditions.Download.Enabled && renditions.Download.Watermark) {
+		textFile = OUTPUT_FILEPATH + baseName + "_watermark.txt"
+		err = l.writeWatermarkText(userid, textFile)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(textFile)
+	}
+	err = l.transcode(filePath, outputPath, renditions.Play.Watermark, textFile, audio)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("ffmpeg", "-i", outputPath, "-ss", "00:00:00", "-frames:v", "1", coverPath)
 	cmd.Run()
 
+	var downloadPath string
+	if renditions.Download.Enabled && renditions.Download.Watermark != renditions.Play.Watermark {
+		downloadPath = OUTPUT_FILEPATH + downloadName
+		err = l.transcode(filePath, downloadPath, renditions.Download.Watermark, textFile, audio)
+		if err != nil {
+			return err
+		}
+	}
+
 	// 3. 将转码后视频与封面上传至 OSS
 	outputVideo, err := os.Open(outputPath)
 	outputCover, err := os.Open(coverPath)
@@ -77,7 +147,27 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 		return err
 	}
 
-	// 4.将视频信息写入 db 和 cache
+	// 动态预览与进度条缩略图从播放版本生成
+	previewUrl, spriteUrl, vttUrl := l.thumbnails(outputPath, baseName)
+
+	var downloadUrl string
+	if renditions.Download.Enabled {
+		downloadUrl = playUrl
+	}
+	if downloadPath != "" {
+		outputDownload, err := os.Open(downloadPath)
+		if err != nil {
+			return err
+		}
+		downloadUrl, err = l.OssUploadFile(outputDownload, l.svcCtx.Config.AliyunOss.DownloadPath, downloadName)
+		outputDownload.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	// 4.将视频信息写入 db，状态为待审核，由视频服务审核通过后再写入 Feed 流缓存与更新作者的作品数
+	// 草稿与定时发布的视频同样先审核，由作者发布或到达发布时间后才写入 Feed 流缓存
 	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
 	videoId, err := sf.Generate()
 	if err != nil {
@@ -86,28 +176,63 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 
 	createTime := time.Now().Unix()
 	videoInfo := &model.Video{
-		Id:         videoId,
-		UserId:     userid,
-		Title:      msgInfo.Title,
-		PlayUrl:    playUrl,
-		CoverUrl:   coverUrl,
-		CreateTime: createTime,
+		Id:           videoId,
+		UserId:       userid,
+		Title:        msgInfo.Title,
+		PlayUrl:      playUrl,
+		DownloadUrl:  downloadUrl,
+		PreviewUrl:   previewUrl,
+		SpriteUrl:    spriteUrl,
+		SpriteVttUrl: vttUrl,
+		CoverUrl:     coverUrl,
+		CreateTime:   createTime,
+		Status:       model.VideoStatusPending,
+		Visibility:   msgInfo.Visibility,
+		PublishState: msgInfo.PublishType,
+		PublishTime:  msgInfo.PublishTime,
+	}
+	if videoInfo.Visibility == "" {
+		videoInfo.Visibility = model.VideoVisibilityPublic
+	}
+	if videoInfo.PublishState == "" || videoInfo.PublishState == model.VideoPublishStatePublished {
+		videoInfo.PublishState = model.VideoPublishStatePublished
+		videoInfo.PublishTime = createTime
 	}
 	err = l.svcCtx.Db.Model(&videoInfo).Create(&videoInfo).Error
 	if err != nil {
 		return err
 	}
+	published = videoInfo
 
-	videoJson, err := json.Marshal(&videoInfo)
+	// 记录音频的测量结果，写入失败不影响投稿结果
+	err = l.svcCtx.Db.Create(audio.audioStat(l.svcCtx.Config.Loudness, videoInfo.Id)).Error
 	if err != nil {
-		return err
+		log.Println(err)
 	}
 
-	conn := l.svcCtx.Redis.NewRedisConn()
-	defer conn.Close()
-	err = l.svcCtx.Redis.AddVideoInfoAndFeed(conn, videoId, videoJson, createTime, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL, l.svcCtx.Config.CacheConfig.FEED_MAX_CACHE_SIZE)
+	// 将指纹写入索引，写入失败不影响投稿结果
+	if fp != nil {
+		err = l.saveFingerprint(videoInfo, fp, duplicate)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	// 提取标题中的话题，写入失败不影响投稿结果
+	err = l.saveTopics(videoInfo)
 	if err != nil {
-		return err
+		log.Println(err)
+	}
+
+	// 写入搜索索引，视频待审核时不可见，审核通过后由视频服务更新为可见
+	l.searchVideoEvent(videoInfo)
+
+	// 标题命中审核类敏感词，记录到待审核列表，记录失败不影响投稿结果
+	if len(msgInfo.ReviewWords) > 0 {
+		err = l.flagTitleForReview(videoInfo, msgInfo.ReviewWords)
+		if err != nil {
+			log.Println(err)
+		}
 	}
 
 	// 5. 最后将原视频（本地与OSS）删除
@@ -123,6 +248,13 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 		return err
 	}
 
+	if downloadPath != "" {
+		err = os.Remove(downloadPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = os.Remove(filePath)
 	if err != nil {
 		return err
@@ -135,6 +267,165 @@ func (l *TranscodingLogic) TransCoding(userid string, msg []byte) error {
 	return nil
 }
 
+// transcode 调用 ffmpeg 将视频转码为一个版本，watermark 为 true 时叠加水印，水印中的用户名从 textFile 读取
+// ffmpeg 的输入参数需要在所有输出参数之前，所以分别收集输入与输出参数，静音音轨的输入序号在水印 logo 之后
+func (l *TranscodingLogic) transcode(input, output string, watermark bool, textFile string, audio *audioPlan) error {
+	inputs := []string{"-i", input}
+	inputCount := 1
+	videoMap := "0:v:0"
+	var opts []string
+	if watermark {
+		wmInputs, filter := watermarkArgs(l.svcCtx.Config.Watermark, textFile)
+		inputs = append(inputs, wmInputs...)
+		inputCount += len(wmInputs) / 2
+		opts = append(opts, "-filter_complex", filter)
+		videoMap = "[out]"
+	}
+	opts = append(opts, "-map", videoMap)
+
+	audioInputs, audioOpts := audio.args(l.svcCtx.Config.Loudness, inputCount)
+	inputs = append(inputs, audioInputs...)
+	opts = append(opts, audioOpts...)
+
+	args := append(inputs, opts...)
+	args = append(args, "-preset", "fast", "-y", output)
+	return exec.Command("ffmpeg", args...).Run()
+}
+
+// writeWatermarkText 将作者的 @用户名 写入水印文本文件
+func (l *TranscodingLogic) writeWatermarkText(userid, textFile string) error {
+	var user model.User
+	err := l.svcCtx.Db.Select("id", "username").Where("id = ?", userid).Take(&user).Error
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(textFile, []byte("@"+user.Username), 0644)
+}
+
+// saveTopics 提取视频标题中的话题，写入话题表（话题已存在时不写入）与视频话题对应表
+// 视频此时待审核，话题的视频数由视频服务在审核通过后更新
+func (l *TranscodingLogic) saveTopics(videoInfo *model.Video) error {
+	names := topic.Extract(videoInfo.Title)
+	if len(names) == 0 {
+		return nil
+	}
+
+	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+	if err != nil {
+		return err
+	}
+	topics := make([]model.Topic, len(names))
+	for i, name := range names {
+		id, err := sf.Generate()
+		if err != nil {
+			return err
+		}
+		topics[i] = model.Topic{
+			Id:         id,
+			Name:       name,
+			CreateTime: videoInfo.CreateTime,
+		}
+	}
+
+	return l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		// 话题表上有 name 唯一索引，已存在的话题不会写入
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&topics).Error
+		if err != nil {
+			return err
+		}
+
+		var saved []model.Topic
+		err = tx.Where("name in ?", names).Find(&saved).Error
+		if err != nil {
+			return err
+		}
+		mappings := make([]model.VideoTopic, len(saved))
+		for i, t := range saved {
+			mappings[i] = model.VideoTopic{
+				TopicId:    t.Id,
+				VideoId:    videoInfo.Id,
+				CreateTime: videoInfo.CreateTime,
+			}
+		}
+		return tx.Create(&mappings).Error
+	})
+}
+
+// flagTitleForReview 将命中审核类敏感词的视频标题记录到待审核列表
+func (l *TranscodingLogic) flagTitleForReview(videoInfo *model.Video, words []string) error {
+	uid, err := strconv.ParseUint(videoInfo.UserId, 10, 64)
+	if err != nil {
+		return err
+	}
+	return moderation.FlagForReview(l.svcCtx.Db, l.svcCtx.Config.WorkerId, moderation.FLAG_TITLE, videoInfo.Id, uid,
+		videoInfo.Title, words)
+}
+
+// pushPublishEvent 将投稿结果写入 Kafka 推送主题，由 api 网关通过 WebSocket 推送给投稿用户
+// videoInfo 为 nil 表示投稿失败，rejected 不为 nil 表示投稿被拒绝（超出限额或重复投稿），推送失败只记录日志
+func (l *TranscodingLogic) pushPublishEvent(userid, title string, videoInfo *model.Video, rejected *publishRejection) {
+	uid, err := strconv.ParseUint(userid, 10, 64)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	data := &model.PublishEventData{
+		Status: PUBLISH_FAIL,
+		Title:  title,
+	}
+	if videoInfo != nil {
+		data.Status = PUBLISH_SUCCESS
+		data.VideoId = videoInfo.Id
+		data.PlayUrl = videoInfo.PlayUrl
+		data.CoverUrl = videoInfo.CoverUrl
+	} else if rejected != nil {
+		data.Reason = rejected.Reason
+		data.OriginalId = rejected.OriginalId
+	}
+
+	marshal, err := json.Marshal(&model.PushEvent{
+		Type:       model.PushEventPublish,
+		UserId:     uid,
+		Data:       data,
+		CreateTime: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = l.svcCtx.PushWriter.WriteMessages(l.ctx, kafka.Message{
+		Key:   []byte(userid),
+		Value: marshal,
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// searchVideoEvent 将新投稿的视频写入 Kafka 搜索主题，由搜索服务建立索引，写入失败只记录日志
+func (l *TranscodingLogic) searchVideoEvent(videoInfo *model.Video) {
+	marshal, err := json.Marshal(&model.SearchEvent{
+		Type:       model.SearchEventVideo,
+		Action:     model.SearchActionUpsert,
+		Id:         videoInfo.Id,
+		Text:       videoInfo.Title,
+		Visible:    false, // 新投稿的视频待审核
+		CreateTime: videoInfo.CreateTime,
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = l.svcCtx.SearchWriter.WriteMessages(l.ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(videoInfo.Id, 10)),
+		Value: marshal,
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
 // OssDownloadFile 从 OSS 下载文件至本地
 func (l *TranscodingLogic) OssDownloadFile(objectKey string) (string, error) {
 	bucket, err := l.svcCtx.Oss.Bucket(l.svcCtx.Config.AliyunOss.Bucket)
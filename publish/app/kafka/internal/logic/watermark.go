@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"Mini-Tiktok/publish/app/kafka/internal/config"
+	"fmt"
+	"strconv"
+)
+
+const (
+	WATERMARK_TOP_LEFT     = "top-left"
+	WATERMARK_TOP_RIGHT    = "top-right"
+	WATERMARK_BOTTOM_LEFT  = "bottom-left"
+	WATERMARK_BOTTOM_RIGHT = "bottom-right"
+	WATERMARK_MOVING       = "moving"
+	WATERMARK_TEXT_GAP     = 4 // logo 与用户名之间的距离（像素）
+)
+
+// watermarkArgs 返回叠加水印需要的 ffmpeg 输入参数与 filter_complex，filter 的输出为 [out]
+// logo 作为第二个输入缩放到 LogoHeight 后按不透明度叠加，用户名在 logo 下方，由 drawtext 从 textFile 读取，
+// 读取文件并关闭文本扩展，不需要转义用户名中的特殊字符
+// 水印位置由 right 与 bottom 两个表达式决定，moving 模式下按 t（秒）每隔 MoveInterval 秒顺时针移动到下一个角
+func watermarkArgs(conf config.WatermarkConfig, textFile string) (inputs []string, filter string) {
+	right, bottom := "0", "0"
+	switch conf.Position {
+	case WATERMARK_TOP_RIGHT:
+		right = "1"
+	case WATERMARK_BOTTOM_LEFT:
+		bottom = "1"
+	case WATERMARK_BOTTOM_RIGHT:
+		right, bottom = "1", "1"
+	case WATERMARK_MOVING:
+		// 0-左上，1-右上，2-右下，3-左下
+		corner := fmt.Sprintf("mod(floor(t/%d),4)", conf.MoveInterval)
+		right = fmt.Sprintf("between(%s,1,2)", corner)
+		bottom = fmt.Sprintf("gte(%s,2)", corner)
+	}
+	opacity := strconv.FormatFloat(conf.Opacity, 'f', 2, 64)
+	m, fs := conf.Margin, conf.FontSize
+
+	// 用户名：没有 logo 时位于角落，有 logo 时位于 logo 下方（底部时 logo 位于用户名上方）
+	logoHeight := 0
+	if conf.LogoPath != "" {
+		logoHeight = conf.LogoHeight + WATERMARK_TEXT_GAP
+	}
+	text := fmt.Sprintf("drawtext=textfile=%s:expansion=none:fontsize=%d:fontcolor=%s@%s:x='if(%s,w-tw-%d,%d)':y='if(%s,h-th-%d,%d)'",
+		textFile, fs, conf.FontColor, opacity, right, m, m, bottom, m, m+logoHeight)
+	if conf.FontFile != "" {
+		text += ":fontfile=" + conf.FontFile
+	}
+
+	if conf.LogoPath == "" {
+		return nil, "[0:v]" + text + "[out]"
+	}
+	filter = fmt.Sprintf("[1:v]scale=-1:%d,format=rgba,colorchannelmixer=aa=%s[logo];"+
+		"[0:v][logo]overlay=x='if(%s,W-w-%d,%d)':y='if(%s,H-h-%d,%d)'[wm];[wm]%s[out]",
+		conf.LogoHeight, opacity, right, m, m, bottom, m+fs+WATERMARK_TEXT_GAP, m, text)
+	return []string{"-i", conf.LogoPath}, filter
+}
@@ -6,8 +6,10 @@ import (
 	"Mini-Tiktok/publish/app/kafka/model/redisCache"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/segmentio/kafka-go"
 	"gorm.io/gorm"
 	"log"
+	"time"
 )
 
 type ServiceContext struct {
@@ -15,6 +17,11 @@ type ServiceContext struct {
 	Oss    *oss.Client
 	Redis  *redisCache.RedisPool
 	Db     *gorm.DB
+
+	// PushWriter 推送事件生产者
+	PushWriter *kafka.Writer
+	// SearchWriter 搜索索引事件生产者
+	SearchWriter *kafka.Writer
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -43,5 +50,21 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Oss:    ossCli,
 		Db:     db,
 		Redis:  pool,
+		PushWriter: &kafka.Writer{
+			Addr:         kafka.TCP(c.PushConfig.Host),
+			Topic:        c.PushConfig.Topic,
+			Balancer:     &kafka.LeastBytes{},
+			BatchTimeout: time.Millisecond * time.Duration(c.PushConfig.BatchTimeout),
+			BatchSize:    c.PushConfig.BatchSize,
+			BatchBytes:   c.PushConfig.BatchBytes,
+		},
+		SearchWriter: &kafka.Writer{
+			Addr:         kafka.TCP(c.SearchConfig.Host),
+			Topic:        c.SearchConfig.Topic,
+			Balancer:     &kafka.Hash{}, // 按文档 id 分区，保证同一文档的事件有序
+			BatchTimeout: time.Millisecond * time.Duration(c.SearchConfig.BatchTimeout),
+			BatchSize:    c.SearchConfig.BatchSize,
+			BatchBytes:   c.SearchConfig.BatchBytes,
+		},
 	}
 }
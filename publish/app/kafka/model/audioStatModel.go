@@ -0,0 +1,27 @@
+package model
+
+// AudioStat 表结构，转码时测量的源视频音频响度，用于之后分析
+// 响度为 -inf（完全静音）或没有测量时对应的列为 NULL
+type AudioStat struct {
+	VideoId      uint64   `gorm:"column:video_id"`
+	State        string   `gorm:"column:state"`         // 音频处理方式：0-没有音轨（已添加静音音轨），1-静音（不做归一化），2-已归一化，3-未开启归一化
+	Channels     int      `gorm:"column:channels"`      // 源视频的声道数，超过 2 时混缩为立体声
+	InputI       *float64 `gorm:"column:input_i"`       // 综合响度（LUFS）
+	InputTP      *float64 `gorm:"column:input_tp"`      // 真峰值（dBTP）
+	InputLRA     *float64 `gorm:"column:input_lra"`     // 响度范围（LU）
+	InputThresh  *float64 `gorm:"column:input_thresh"`  // 门限（LUFS）
+	TargetOffset *float64 `gorm:"column:target_offset"` // 归一化后的增益偏移（LU）
+	TargetI      float64  `gorm:"column:target_i"`      // 目标综合响度（LUFS）
+	CreateTime   int64    `gorm:"column:create_time"`
+}
+
+const (
+	AudioStateAbsent     = "0"
+	AudioStateSilent     = "1"
+	AudioStateNormalized = "2"
+	AudioStateUnmeasured = "3"
+)
+
+func (AudioStat) TableName() string {
+	return "audio_stat"
+}
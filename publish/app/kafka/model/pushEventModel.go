@@ -0,0 +1,24 @@
+package model
+
+const (
+	PushEventPublish = "publish" // 投稿处理完成
+)
+
+// PushEvent 写入 Kafka 推送主题的事件，由 api 网关通过 WebSocket 推送给 UserId 对应的用户
+type PushEvent struct {
+	Type       string      `json:"type"`        // 事件类型
+	UserId     uint64      `json:"user_id"`     // 接收事件的用户 id
+	Data       interface{} `json:"data"`        // 事件内容
+	CreateTime int64       `json:"create_time"` // 事件产生时间（毫秒时间戳）
+}
+
+// PublishEventData 投稿处理完成事件内容
+type PublishEventData struct {
+	Status     string `json:"status"` // 0-投稿成功，1-投稿失败
+	Title      string `json:"title"`
+	VideoId    uint64 `json:"video_id,omitempty"`
+	PlayUrl    string `json:"play_url,omitempty"`
+	CoverUrl   string `json:"cover_url,omitempty"`
+	Reason     string `json:"reason,omitempty"`      // 投稿失败的原因，duplicate-重复投稿，too_large-文件大小超出限额，too_long-时长超出限额
+	OriginalId uint64 `json:"original_id,omitempty"` // 重复投稿时原视频的 id
+}
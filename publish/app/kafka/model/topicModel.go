@@ -0,0 +1,25 @@
+package model
+
+// Topic 表结构，投稿时从视频标题中提取的话题（#话题名），
+// VideoCount 为话题下公开视频的数量，由视频服务在视频审核通过或下架时更新
+type Topic struct {
+	Id         uint64 `gorm:"column:id"`
+	Name       string `gorm:"column:name"` // 归一化后的话题名（小写，不含 #）
+	VideoCount int64  `gorm:"column:video_count"`
+	CreateTime int64  `gorm:"column:create_time"`
+}
+
+// VideoTopic 表结构，视频与话题的对应关系
+type VideoTopic struct {
+	TopicId    uint64 `gorm:"column:topic_id"`
+	VideoId    uint64 `gorm:"column:video_id"`
+	CreateTime int64  `gorm:"column:create_time"` // 视频的投稿时间，用于按时间排序话题下的视频
+}
+
+func (Topic) TableName() string {
+	return "topic"
+}
+
+func (VideoTopic) TableName() string {
+	return "video_topic"
+}
@@ -0,0 +1,12 @@
+package model
+
+// User 表结构（由用户服务维护，投稿服务只读取用户名用于视频水印，读取角色用于检查投稿限额）
+type User struct {
+	Id       uint64 `gorm:"column:id"`
+	Username string `gorm:"column:username"`
+	Role     string `gorm:"column:role"` // 0-普通用户，1-审核员，2-管理员
+}
+
+func (User) TableName() string {
+	return "user"
+}
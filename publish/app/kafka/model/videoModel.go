@@ -4,14 +4,31 @@ import "strconv"
 
 // Video 表结构
 type Video struct {
-	Id         uint64 `gorm:"column:id"`
-	UserId     string `gorm:"column:user_id"`
-	Title      string `gorm:"column:title"`
-	PlayUrl    string `gorm:"column:play_url"`
-	CoverUrl   string `gorm:"column:cover_url"`
-	CreateTime int64  `gorm:"column:create_time"`
+	Id           uint64 `gorm:"column:id"`
+	UserId       string `gorm:"column:user_id"`
+	Title        string `gorm:"column:title"`
+	PlayUrl      string `gorm:"column:play_url"`
+	DownloadUrl  string `gorm:"column:download_url"`   // 下载版本的地址，没有生成下载版本时为空
+	PreviewUrl   string `gorm:"column:preview_url"`    // 动态预览的地址
+	SpriteUrl    string `gorm:"column:sprite_url"`     // 进度条缩略图雪碧图的地址
+	SpriteVttUrl string `gorm:"column:sprite_vtt_url"` // 缩略图 WebVTT 索引的地址
+	CoverUrl     string `gorm:"column:cover_url"`
+	CreateTime   int64  `gorm:"column:create_time"`
+	Status       string `gorm:"column:status"`        // 审核状态：0-待审核，1-已通过，2-未通过，3-已下架
+	Visibility   string `gorm:"column:visibility"`    // 可见范围：0-公开，1-粉丝可见，2-互关好友可见，3-仅自己可见
+	PublishState string `gorm:"column:publish_state"` // 发布状态：0-已发布，1-草稿，2-定时发布
+	PublishTime  int64  `gorm:"column:publish_time"`  // 发布时间，定时发布的视频由视频服务到期后发布
 }
 
+const (
+	VideoStatusPending  = "0" // 新投稿的视频需要审核通过后才会进入 Feed 流与公开的发布列表
+	VideoStatusApproved = "1"
+
+	VideoVisibilityPublic = "0"
+
+	VideoPublishStatePublished = "0"
+)
+
 func (Video) TableName() string {
 	return "video"
 }
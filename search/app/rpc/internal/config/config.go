@@ -0,0 +1,46 @@
+package config
+
+import (
+	"github.com/zeromicro/go-zero/zrpc"
+	"strconv"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	DbConfig    DbConfig
+	KafkaConfig struct {
+		Host     string
+		Topic    string
+		GroupId  string // 每个搜索服务节点都维护完整的索引，所以各节点需使用不同的消费组
+		MinBytes int    `json:",default=1"`
+		MaxBytes int    `json:",default=1048576"`
+	}
+	SnapshotConfig struct {
+		Dir      string `json:",default=data/search"` // 快照文件目录
+		Interval int    `json:",default=60"`          // 写快照的间隔，单位秒
+	}
+	SearchConfig struct {
+		ListLimit        int `json:",default=20"` // 每页最多返回的结果数量
+		KeywordMaxLength int `json:",default=50"` // 搜索词最大长度（字符数）
+	}
+}
+
+type DbConfig struct {
+	Path         string `json:"path" yaml:"path"`                     // 服务器地址
+	Port         int    `json:"port" yaml:"port"`                     //:端口
+	Config       string `json:"config" yaml:"config"`                 // 高级配置
+	Dbname       string `json:"db-name" yaml:"db-name"`               // 数据库名
+	Username     string `json:"username" yaml:"username"`             // 数据库用户名
+	Password     string `json:"password" yaml:"password"`             // 数据库密码
+	MaxIdleConns int    `json:"max-idle-conns" yaml:"max-idle-conns"` // 空闲中的最大连接数
+	MaxOpenConns int    `json:"max-open-conns" yaml:"max-open-conns"` // 打开到数据库的最大连接数
+}
+
+type Mysql struct {
+	DbConfig
+}
+
+// Dsn 获取 Database Source Name
+func (m *Mysql) Dsn() string {
+	return m.Username + ":" + m.Password + "@tcp(" + m.Path + ":" + strconv.FormatInt(int64(m.Port), 10) + ")/" + m.Dbname + "?" + m.Config
+}
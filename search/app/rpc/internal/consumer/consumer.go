@@ -0,0 +1,163 @@
+package consumer
+
+import (
+	"Mini-Tiktok/search/app/rpc/internal/index"
+	"Mini-Tiktok/search/app/rpc/internal/svc"
+	"Mini-Tiktok/search/app/rpc/model"
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Consumer 消费搜索主题的索引事件并更新索引，定时将索引写入快照
+// 快照写入成功后才提交 Kafka offset，服务重启时加载快照并从快照之后的事件继续消费，不会丢失索引更新
+type Consumer struct {
+	svcCtx *svc.ServiceContext
+	reader *kafka.Reader
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	uncommitted map[int]kafka.Message // 分区 -> 快照之后收到的最后一条消息
+	videoSaved  uint64                // 上次写快照时视频索引的版本号
+	userSaved   uint64                // 上次写快照时用户索引的版本号
+}
+
+func NewConsumer(svcCtx *svc.ServiceContext) *Consumer {
+	c := svcCtx.Config.KafkaConfig
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Consumer{
+		svcCtx: svcCtx,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  strings.Split(c.Host, ","),
+			GroupID:  c.GroupId,
+			Topic:    c.Topic,
+			MinBytes: c.MinBytes,
+			MaxBytes: c.MaxBytes,
+		}),
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		uncommitted: make(map[int]kafka.Message),
+		videoSaved:  svcCtx.VideoIndex.Version(),
+		userSaved:   svcCtx.UserIndex.Version(),
+	}
+}
+
+// Start 阻塞消费事件，直到调用 Stop
+func (c *Consumer) Start() {
+	defer close(c.done)
+
+	msgs := make(chan kafka.Message)
+	go func() {
+		defer close(msgs)
+		for {
+			m, err := c.reader.FetchMessage(c.ctx)
+			if err != nil {
+				if c.ctx.Err() == nil {
+					log.Println(err)
+				}
+				return
+			}
+			msgs <- m
+		}
+	}()
+
+	ticker := time.NewTicker(time.Duration(c.svcCtx.Config.SnapshotConfig.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case m, ok := <-msgs:
+			if !ok {
+				c.snapshot()
+				return
+			}
+			err := c.apply(m.Value)
+			if err != nil {
+				log.Printf("apply search event at partition:%v offset:%v: %v\n", m.Partition, m.Offset, err)
+			}
+			c.uncommitted[m.Partition] = m
+		case <-ticker.C:
+			c.snapshot()
+		}
+	}
+}
+
+// Stop 停止消费，写入最后一次快照后返回
+func (c *Consumer) Stop() {
+	c.cancel()
+	<-c.done
+	c.reader.Close()
+}
+
+// apply 将事件应用到对应的索引
+func (c *Consumer) apply(value []byte) error {
+	var e model.SearchEvent
+	err := json.Unmarshal(value, &e)
+	if err != nil {
+		return err
+	}
+
+	var x *index.Index
+	switch e.Type {
+	case model.SearchEventVideo:
+		x = c.svcCtx.VideoIndex
+	case model.SearchEventUser:
+		x = c.svcCtx.UserIndex
+	default:
+		return nil
+	}
+
+	switch e.Action {
+	case model.SearchActionUpsert:
+		x.Upsert(index.Doc{Id: e.Id, Text: e.Text, Visible: e.Visible, CreateTime: e.CreateTime})
+	case model.SearchActionDelete:
+		x.Delete(e.Id)
+	case model.SearchActionVisible:
+		x.SetVisible(e.Id, e.Visible)
+	}
+	return nil
+}
+
+// snapshot 索引有变化时写入快照，全部写入成功后提交已应用事件的 offset
+func (c *Consumer) snapshot() {
+	if len(c.uncommitted) == 0 {
+		return
+	}
+
+	videoVersion := c.svcCtx.VideoIndex.Version()
+	if videoVersion != c.videoSaved {
+		err := c.svcCtx.VideoIndex.Save(c.svcCtx.VideoSnapshotPath())
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		c.videoSaved = videoVersion
+	}
+
+	userVersion := c.svcCtx.UserIndex.Version()
+	if userVersion != c.userSaved {
+		err := c.svcCtx.UserIndex.Save(c.svcCtx.UserSnapshotPath())
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		c.userSaved = userVersion
+	}
+
+	msgs := make([]kafka.Message, 0, len(c.uncommitted))
+	for _, m := range c.uncommitted {
+		msgs = append(msgs, m)
+	}
+	// 停止时 c.ctx 已取消，使用新的 ctx 提交
+	err := c.reader.CommitMessages(context.Background(), msgs...)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	c.uncommitted = make(map[int]kafka.Message)
+}
@@ -0,0 +1,212 @@
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BM25 参数
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	phraseBoost = 2.0 // 文本完整包含搜索词时的加权
+)
+
+// Doc 被索引的文档（视频标题，或用户名与昵称）
+type Doc struct {
+	Id         uint64
+	Text       string
+	Visible    bool // 不可见的文档保留在索引中但不会被搜索到，如待审核与已下架的视频
+	CreateTime int64
+}
+
+type entry struct {
+	Doc
+	compact string         // 去除分隔符的归一化文本，用于短语匹配
+	terms   map[string]int // 词 -> 词频
+	length  int            // 词数
+}
+
+// Index 内存倒排索引，并发安全
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[uint64]*entry
+	postings map[string]map[uint64]int // 词 -> 文档 id -> 词频
+	totalLen int
+	version  uint64 // 每次修改都递增，用于判断快照之后索引是否有变化
+}
+
+func New() *Index {
+	return &Index{
+		docs:     make(map[uint64]*entry),
+		postings: make(map[string]map[uint64]int),
+	}
+}
+
+// Upsert 新增或更新文档
+func (x *Index) Upsert(doc Doc) {
+	e := &entry{
+		Doc:     doc,
+		compact: compact(doc.Text),
+		terms:   make(map[string]int),
+	}
+	for _, t := range tokenizeDoc(doc.Text) {
+		e.terms[t]++
+		e.length++
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(doc.Id)
+	x.docs[doc.Id] = e
+	x.totalLen += e.length
+	for t, tf := range e.terms {
+		p, ok := x.postings[t]
+		if !ok {
+			p = make(map[uint64]int)
+			x.postings[t] = p
+		}
+		p[doc.Id] = tf
+	}
+	x.version++
+}
+
+// Delete 删除文档，文档不存在时忽略
+func (x *Index) Delete(id uint64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.remove(id) {
+		x.version++
+	}
+}
+
+// SetVisible 修改文档是否可以被搜索到，文档不存在时忽略并返回 false
+func (x *Index) SetVisible(id uint64, visible bool) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	e, ok := x.docs[id]
+	if !ok {
+		return false
+	}
+	if e.Visible != visible {
+		e.Visible = visible
+		x.version++
+	}
+	return true
+}
+
+// remove 需持有写锁
+func (x *Index) remove(id uint64) bool {
+	e, ok := x.docs[id]
+	if !ok {
+		return false
+	}
+	for t := range e.terms {
+		p := x.postings[t]
+		delete(p, id)
+		if len(p) == 0 {
+			delete(x.postings, t)
+		}
+	}
+	x.totalLen -= e.length
+	delete(x.docs, id)
+	return true
+}
+
+type hit struct {
+	id         uint64
+	score      float64
+	createTime int64
+}
+
+// Search 按相关度搜索可见文档，返回 [offset, offset+limit) 范围内的文档 id 与匹配的文档总数
+// 相关度为 BM25 得分乘以搜索词的命中比例，文本完整包含搜索词时额外加权，相关度相同时较新的文档排在前面
+func (x *Index) Search(query string, offset, limit int) ([]uint64, int) {
+	terms := Tokenize(query)
+	if len(terms) == 0 {
+		return nil, 0
+	}
+	uniq := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		uniq[t] = true
+	}
+	phrase := compact(query)
+
+	x.mu.RLock()
+	n := float64(len(x.docs))
+	avgLen := 1.0
+	if len(x.docs) > 0 && x.totalLen > 0 {
+		avgLen = float64(x.totalLen) / n
+	}
+	scores := make(map[uint64]float64)
+	matched := make(map[uint64]int)
+	for t := range uniq {
+		p := x.postings[t]
+		if len(p) == 0 {
+			continue
+		}
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range p {
+			e := x.docs[id]
+			if !e.Visible {
+				continue
+			}
+			f := float64(tf)
+			scores[id] += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*float64(e.length)/avgLen))
+			matched[id]++
+		}
+	}
+
+	hits := make([]hit, 0, len(scores))
+	for id, s := range scores {
+		e := x.docs[id]
+		s *= float64(matched[id]) / float64(len(uniq))
+		if phrase != "" && strings.Contains(e.compact, phrase) {
+			s *= phraseBoost
+		}
+		hits = append(hits, hit{id: id, score: s, createTime: e.CreateTime})
+	}
+	x.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		if hits[i].createTime != hits[j].createTime {
+			return hits[i].createTime > hits[j].createTime
+		}
+		return hits[i].id > hits[j].id
+	})
+
+	total := len(hits)
+	if offset >= total {
+		return nil, total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	ids := make([]uint64, 0, end-offset)
+	for _, h := range hits[offset:end] {
+		ids = append(ids, h.id)
+	}
+	return ids, total
+}
+
+// Len 返回索引中的文档数量（包括不可见的文档）
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Version 返回索引的修改版本号
+func (x *Index) Version() uint64 {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.version
+}
@@ -0,0 +1,140 @@
+package index
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestIndex() *Index {
+	x := New()
+	x.Upsert(Doc{Id: 1, Text: "funny cat video", Visible: true, CreateTime: 100})
+	x.Upsert(Doc{Id: 2, Text: "cat", Visible: true, CreateTime: 200})
+	x.Upsert(Doc{Id: 3, Text: "dog video", Visible: true, CreateTime: 300})
+	x.Upsert(Doc{Id: 4, Text: "cat video pending review", Visible: false, CreateTime: 400})
+	x.Upsert(Doc{Id: 5, Text: "可爱的猫咪视频", Visible: true, CreateTime: 500})
+	return x
+}
+
+func TestSearchRanking(t *testing.T) {
+	x := newTestIndex()
+	tests := []struct {
+		query     string
+		wantIds   []uint64
+		wantTotal int
+	}{
+		// 短文档的词频权重更高
+		{"cat", []uint64{2, 1}, 2},
+		// 同时命中两个词的文档排在只命中一个词的文档前面，不可见的文档不返回
+		{"cat video", []uint64{1, 2, 3}, 3},
+		// 完整包含搜索词的文档加权
+		{"funny cat", []uint64{1, 2}, 2},
+		{"猫", []uint64{5}, 1},
+		{"猫咪", []uint64{5}, 1},
+		{"bird", nil, 0},
+		{"!!", nil, 0},
+	}
+	for _, tt := range tests {
+		ids, total := x.Search(tt.query, 0, 10)
+		if !reflect.DeepEqual(ids, tt.wantIds) || total != tt.wantTotal {
+			t.Errorf("Search(%q) = %v, %d, want %v, %d", tt.query, ids, total, tt.wantIds, tt.wantTotal)
+		}
+	}
+}
+
+func TestSearchTieBreakByCreateTime(t *testing.T) {
+	x := New()
+	x.Upsert(Doc{Id: 1, Text: "cat", Visible: true, CreateTime: 100})
+	x.Upsert(Doc{Id: 2, Text: "cat", Visible: true, CreateTime: 300})
+	x.Upsert(Doc{Id: 3, Text: "cat", Visible: true, CreateTime: 200})
+	ids, _ := x.Search("cat", 0, 10)
+	if want := []uint64{2, 3, 1}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Search = %v, want %v", ids, want)
+	}
+}
+
+func TestSearchPagination(t *testing.T) {
+	x := New()
+	for i := uint64(1); i <= 5; i++ {
+		x.Upsert(Doc{Id: i, Text: "cat", Visible: true, CreateTime: int64(i)})
+	}
+	tests := []struct {
+		offset, limit int
+		want          []uint64
+	}{
+		{0, 2, []uint64{5, 4}},
+		{2, 2, []uint64{3, 2}},
+		{4, 2, []uint64{1}},
+		{5, 2, nil},
+	}
+	for _, tt := range tests {
+		ids, total := x.Search("cat", tt.offset, tt.limit)
+		if !reflect.DeepEqual(ids, tt.want) || total != 5 {
+			t.Errorf("Search(offset %d, limit %d) = %v, %d, want %v, 5", tt.offset, tt.limit, ids, total, tt.want)
+		}
+	}
+}
+
+func TestUpsertReplacesDoc(t *testing.T) {
+	x := newTestIndex()
+	x.Upsert(Doc{Id: 2, Text: "bird", Visible: true, CreateTime: 200})
+	if ids, _ := x.Search("cat", 0, 10); !reflect.DeepEqual(ids, []uint64{1}) {
+		t.Errorf("Search(cat) after update = %v, want [1]", ids)
+	}
+	if ids, _ := x.Search("bird", 0, 10); !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Errorf("Search(bird) after update = %v, want [2]", ids)
+	}
+	if x.Len() != 5 {
+		t.Errorf("Len = %d, want 5", x.Len())
+	}
+}
+
+func TestDeleteAndSetVisible(t *testing.T) {
+	x := newTestIndex()
+	v := x.Version()
+
+	x.Delete(2)
+	x.Delete(42)
+	if x.Len() != 4 {
+		t.Errorf("Len after delete = %d, want 4", x.Len())
+	}
+	if ids, _ := x.Search("cat", 0, 10); !reflect.DeepEqual(ids, []uint64{1}) {
+		t.Errorf("Search(cat) after delete = %v, want [1]", ids)
+	}
+
+	if !x.SetVisible(4, true) {
+		t.Error("SetVisible(4) = false, want true")
+	}
+	if x.SetVisible(42, true) {
+		t.Error("SetVisible(42) = true, want false")
+	}
+	if ids, _ := x.Search("pending", 0, 10); !reflect.DeepEqual(ids, []uint64{4}) {
+		t.Errorf("Search(pending) after SetVisible = %v, want [4]", ids)
+	}
+	// 删除不存在的文档与不存在的文档修改可见状态不改变版本号
+	if got := x.Version(); got != v+2 {
+		t.Errorf("Version = %d, want %d", got, v+2)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	x := newTestIndex()
+	path := filepath.Join(t.TempDir(), "index", "video.snapshot")
+	if err := x.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	y, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y.Len() != x.Len() {
+		t.Errorf("Len after load = %d, want %d", y.Len(), x.Len())
+	}
+	for _, q := range []string{"cat video", "猫", "pending"} {
+		want, wantTotal := x.Search(q, 0, 10)
+		got, total := y.Search(q, 0, 10)
+		if !reflect.DeepEqual(got, want) || total != wantTotal {
+			t.Errorf("Search(%q) after load = %v, %d, want %v, %d", q, got, total, want, wantTotal)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package index
+
+import (
+	"bufio"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+)
+
+// snapshot 快照只保存文档，倒排表在加载时重新构建，快照文件更小且与分词方式无关
+type snapshot struct {
+	Docs []Doc
+}
+
+// Save 将索引写入快照文件，先写临时文件再重命名，避免写入中途退出损坏已有快照
+func (x *Index) Save(path string) error {
+	x.mu.RLock()
+	snap := snapshot{Docs: make([]Doc, 0, len(x.docs))}
+	for _, e := range x.docs {
+		snap.Docs = append(snap.Docs, e.Doc)
+	}
+	x.mu.RUnlock()
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = gob.NewEncoder(w).Encode(&snap)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load 从快照文件加载索引，快照不存在时返回的错误满足 os.IsNotExist
+func Load(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var snap snapshot
+	err = gob.NewDecoder(bufio.NewReader(f)).Decode(&snap)
+	if err != nil {
+		return nil, err
+	}
+	x := New()
+	for _, d := range snap.Docs {
+		x.Upsert(d)
+	}
+	return x, nil
+}
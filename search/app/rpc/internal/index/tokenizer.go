@@ -0,0 +1,91 @@
+package index
+
+import (
+	"strings"
+	"unicode"
+)
+
+// normalizeRune 将字符归一化：全角转半角，大写转小写
+func normalizeRune(r rune) rune {
+	switch {
+	case r == 0x3000: // 全角空格
+		r = ' '
+	case r >= 0xFF01 && r <= 0xFF5E: // 全角 ASCII 字符
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+// isCJK 判断是否为中日韩字符，中日韩文本没有空格分词，按二元组切分
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// isWord 判断是否为字母或数字，连续的字母与数字作为一个词
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Tokenize 搜索词分词：连续的中日韩字符按二元组（bigram）切分，只有一个字符时单独成词，
+// 如 "抖音视频" 切分为 "抖音"、"音视"、"视频"；连续的字母与数字作为一个词；其余字符视为分隔符
+func Tokenize(text string) []string {
+	return tokenize(text, false)
+}
+
+// tokenizeDoc 文档分词，中日韩字符在二元组之外每个字符也单独成词，使单字搜索词也能命中
+func tokenizeDoc(text string) []string {
+	return tokenize(text, true)
+}
+
+func tokenize(text string, unigram bool) []string {
+	var tokens []string
+	var cjk, word []rune
+	flushCJK := func() {
+		if len(cjk) == 1 || unigram {
+			for _, r := range cjk {
+				tokens = append(tokens, string(r))
+			}
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+		}
+		word = word[:0]
+	}
+
+	for _, r := range text {
+		r = normalizeRune(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case isWord(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+	return tokens
+}
+
+// compact 归一化文本并去除分隔符，用于判断文本是否完整包含搜索词
+func compact(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		r = normalizeRune(r)
+		if isCJK(r) || isWord(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
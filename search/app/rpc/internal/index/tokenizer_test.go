@@ -0,0 +1,51 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"Hello World", []string{"hello", "world"}},
+		{"go1.21 release", []string{"go1", "21", "release"}},
+		{"抖音视频", []string{"抖音", "音视", "视频"}},
+		{"猫", []string{"猫"}},
+		{"可爱的cat视频", []string{"可爱", "爱的", "cat", "视频"}},
+		{"ＨＥＬＬＯ　世界", []string{"hello", "世界"}},
+		{"#搞笑# !!", []string{"搞笑"}},
+	}
+	for _, tt := range tests {
+		if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestTokenizeDoc(t *testing.T) {
+	// 文档分词时中日韩字符额外单独成词
+	got := tokenizeDoc("抖音 go")
+	want := []string{"抖", "音", "抖音", "go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tokenizeDoc = %q, want %q", got, want)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		text, want string
+	}{
+		{"Hello, World!", "helloworld"},
+		{"抖音 视频", "抖音视频"},
+		{"ＡＢＣ－１２３", "abc123"},
+	}
+	for _, tt := range tests {
+		if got := compact(tt.text); got != tt.want {
+			t.Errorf("compact(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
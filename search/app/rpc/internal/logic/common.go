@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"Mini-Tiktok/search/app/rpc/internal/index"
+	"Mini-Tiktok/search/app/rpc/search"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	STATUS_SUCCESS        = "0"
+	STATUS_SUCCESS_MSG    = "OK"
+	STATUS_FAIL           = "1"
+	STATUS_FAIL_PARAM_MSG = "Request parameter error"
+	STATUS_KEYWORD_MSG    = "Keyword is empty or too long"
+)
+
+// searchIndex 校验参数后在索引中搜索，视频与用户搜索共用
+func searchIndex(x *index.Index, in *search.SearchReq, listLimit, keywordMaxLength int) *search.SearchResp {
+	keyword := strings.TrimSpace(in.Keyword)
+	if keyword == "" || utf8.RuneCountInString(keyword) > keywordMaxLength {
+		return &search.SearchResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_KEYWORD_MSG,
+		}
+	}
+	if in.Cursor < 0 {
+		return &search.SearchResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 || limit > listLimit {
+		limit = listLimit
+	}
+	ids, total := x.Search(keyword, int(in.Cursor), limit)
+	next := in.Cursor + int64(len(ids))
+	return &search.SearchResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		IdList:     ids,
+		NextCursor: next,
+		HasMore:    next < int64(total),
+		Total:      int64(total),
+	}
+}
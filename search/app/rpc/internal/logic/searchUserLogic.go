@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"Mini-Tiktok/search/app/rpc/internal/svc"
+	"Mini-Tiktok/search/app/rpc/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSearchUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchUserLogic {
+	return &SearchUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SearchUser 按用户名与昵称搜索用户，用户信息由调用方向用户服务获取
+func (l *SearchUserLogic) SearchUser(in *search.SearchReq) (*search.SearchResp, error) {
+	c := l.svcCtx.Config.SearchConfig
+	return searchIndex(l.svcCtx.UserIndex, in, c.ListLimit, c.KeywordMaxLength), nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"Mini-Tiktok/search/app/rpc/internal/svc"
+	"Mini-Tiktok/search/app/rpc/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchVideoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSearchVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchVideoLogic {
+	return &SearchVideoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SearchVideo 按标题搜索视频，只返回审核通过的视频 id，视频信息由调用方向视频服务获取
+func (l *SearchVideoLogic) SearchVideo(in *search.SearchReq) (*search.SearchResp, error) {
+	c := l.svcCtx.Config.SearchConfig
+	return searchIndex(l.svcCtx.VideoIndex, in, c.ListLimit, c.KeywordMaxLength), nil
+}
@@ -0,0 +1,104 @@
+package svc
+
+import (
+	"Mini-Tiktok/search/app/rpc/internal/config"
+	"Mini-Tiktok/search/app/rpc/internal/index"
+	"Mini-Tiktok/search/app/rpc/model"
+	"gorm.io/gorm"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const (
+	VIDEO_SNAPSHOT = "video.snapshot"
+	USER_SNAPSHOT  = "user.snapshot"
+)
+
+type ServiceContext struct {
+	Config     config.Config
+	Db         *gorm.DB
+	VideoIndex *index.Index
+	UserIndex  *index.Index
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	db, err := model.InitGorm(c.DbConfig)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	svcCtx := &ServiceContext{
+		Config: c,
+		Db:     db,
+	}
+
+	// 优先从快照加载索引，快照不存在时（首次启动）从 DB 重建
+	svcCtx.VideoIndex, err = loadIndex(svcCtx.VideoSnapshotPath(), svcCtx.rebuildVideoIndex)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+	svcCtx.UserIndex, err = loadIndex(svcCtx.UserSnapshotPath(), svcCtx.rebuildUserIndex)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+	return svcCtx
+}
+
+func (s *ServiceContext) VideoSnapshotPath() string {
+	return filepath.Join(s.Config.SnapshotConfig.Dir, VIDEO_SNAPSHOT)
+}
+
+func (s *ServiceContext) UserSnapshotPath() string {
+	return filepath.Join(s.Config.SnapshotConfig.Dir, USER_SNAPSHOT)
+}
+
+func loadIndex(path string, rebuild func(x *index.Index) error) (*index.Index, error) {
+	x, err := index.Load(path)
+	if err == nil {
+		log.Printf("load search index from %s, %d docs\n", path, x.Len())
+		return x, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	x = index.New()
+	err = rebuild(x)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("rebuild search index for %s from db, %d docs\n", path, x.Len())
+	return x, nil
+}
+
+// rebuildVideoIndex 从 DB 重建视频索引，分批读取避免一次性加载全部视频
+func (s *ServiceContext) rebuildVideoIndex(x *index.Index) error {
+	var videos []model.Video
+	return s.Db.Select("id", "title", "status", "publish_state", "visibility", "create_time").FindInBatches(&videos, 1000, func(tx *gorm.DB, batch int) error {
+		for i := range videos {
+			v := &videos[i]
+			x.Upsert(index.Doc{Id: v.Id, Text: v.Title, Visible: v.IsSearchable(), CreateTime: v.CreateTime})
+		}
+		return nil
+	}).Error
+}
+
+// rebuildUserIndex 从 DB 重建用户索引，已封禁与因被举报而自动隐藏的账号不可见
+func (s *ServiceContext) rebuildUserIndex(x *index.Index) error {
+	hidden, err := model.ReportHiddenUserIds(s.Db)
+	if err != nil {
+		return err
+	}
+	var users []model.User
+	return s.Db.Select("id", "username", "nickname", "status").FindInBatches(&users, 1000, func(tx *gorm.DB, batch int) error {
+		for i := range users {
+			u := &users[i]
+			x.Upsert(index.Doc{Id: u.Id, Text: u.SearchText(), Visible: u.IsSearchable(hidden)})
+		}
+		return nil
+	}).Error
+}
@@ -0,0 +1,47 @@
+package model
+
+import "gorm.io/gorm"
+
+// User 表结构，搜索服务只需要用户名、昵称与封禁状态，用于重建索引
+type User struct {
+	Id       uint64 `gorm:"column:id"`
+	Username string `gorm:"column:username"`
+	Nickname string `gorm:"column:nickname"`
+	Status   string `gorm:"column:status"` // 0-正常，1-已封禁
+}
+
+const (
+	UserStatusBanned = "1"
+
+	ReportTargetUser   = "3"
+	ReportStatusHidden = "1"
+)
+
+func (User) TableName() string {
+	return "user"
+}
+
+// SearchText 用户的索引文本，用户名与昵称都可以被搜索到
+func (u *User) SearchText() string {
+	return u.Username + " " + u.Nickname
+}
+
+// IsSearchable 是否可以被搜索到，已封禁与因被举报而自动隐藏的账号不进入搜索结果，与用户、视频与管理服务写入的搜索事件一致
+func (u *User) IsSearchable(reportHidden map[uint64]bool) bool {
+	return u.Status != UserStatusBanned && !reportHidden[u.Id]
+}
+
+// ReportHiddenUserIds 从 DB 查询因被举报而自动隐藏的账号 id
+func ReportHiddenUserIds(db *gorm.DB) (map[uint64]bool, error) {
+	var ids []uint64
+	err := db.Table("report_target").Where("target_type = ? and status = ?", ReportTargetUser, ReportStatusHidden).
+		Pluck("target_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		hidden[id] = true
+	}
+	return hidden, nil
+}
@@ -0,0 +1,31 @@
+package model
+
+// Video 表结构，搜索服务只需要视频标题、审核状态、发布状态与可见范围，用于重建索引
+type Video struct {
+	Id           uint64 `gorm:"column:id"`
+	Title        string `gorm:"column:title"`
+	Status       string `gorm:"column:status"`
+	PublishState string `gorm:"column:publish_state"`
+	Visibility   string `gorm:"column:visibility"`
+	CreateTime   int64  `gorm:"column:create_time"`
+}
+
+const (
+	VideoStatusApproved        = "1"
+	VideoPublishStatePublished = "0"
+	VideoVisibilityPublic      = "0"
+)
+
+func (Video) TableName() string {
+	return "video"
+}
+
+// IsPublic 是否为公开视频（审核通过并且已发布，或新增审核状态之前发布的视频）
+func (v *Video) IsPublic() bool {
+	return (v.Status == VideoStatusApproved || v.Status == "") && (v.PublishState == VideoPublishStatePublished || v.PublishState == "")
+}
+
+// IsSearchable 是否可以被搜索到，只有公开并且未设置可见范围的视频进入搜索结果，与视频服务写入的搜索事件一致
+func (v *Video) IsSearchable() bool {
+	return v.IsPublic() && (v.Visibility == VideoVisibilityPublic || v.Visibility == "")
+}
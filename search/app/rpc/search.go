@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"Mini-Tiktok/search/app/rpc/internal/config"
+	"Mini-Tiktok/search/app/rpc/internal/consumer"
+	"Mini-Tiktok/search/app/rpc/internal/server"
+	"Mini-Tiktok/search/app/rpc/internal/svc"
+	"Mini-Tiktok/search/app/rpc/search"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/search.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		search.RegisterSearchRpcServer(grpcServer, server.NewSearchRpcServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+
+	// 索引在进程内，rpc 服务与索引事件消费者一起启动，退出时消费者会写入最后一次快照
+	group := service.NewServiceGroup()
+	group.Add(s)
+	group.Add(consumer.NewConsumer(ctx))
+	defer group.Stop()
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	group.Start()
+}
@@ -0,0 +1,11 @@
+package logic
+
+const (
+	EVENT_MESSAGE  = "message"  // 收到新私信，已有会话列表，不写入通知
+	EVENT_COMMENT  = "comment"  // 自己的视频收到新评论
+	EVENT_FOLLOW   = "follow"   // 新增粉丝
+	EVENT_FAVORITE = "favorite" // 自己的视频被点赞
+	EVENT_PUBLISH  = "publish"  // 投稿处理完成
+
+	EVENT_UNKNOWN_ERROR = "unknown event type"
+)
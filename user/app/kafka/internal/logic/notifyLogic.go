@@ -0,0 +1,144 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/kafka/internal/svc"
+	"Mini-Tiktok/user/app/kafka/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/ncghost1/snowflake-go"
+	"gorm.io/gorm"
+)
+
+type NotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyLogic {
+	return &NotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Event 各服务写入 Kafka 推送主题的事件
+type Event struct {
+	Type       string          `json:"type"`        // 事件类型
+	UserId     uint64          `json:"user_id"`     // 接收事件的用户 id
+	Data       json.RawMessage `json:"data"`        // 事件内容
+	CreateTime int64           `json:"create_time"` // 事件产生时间（毫秒时间戳）
+}
+
+// eventData 通知需要的事件内容字段，不同类型的事件只会填充其中一部分
+type eventData struct {
+	UserId     uint64 `json:"user_id"`     // 点赞、评论的用户 id
+	VideoId    uint64 `json:"video_id"`    // 点赞、评论、投稿的视频 id
+	FollowerId uint64 `json:"follower_id"` // 新粉丝的用户 id
+}
+
+// Notify 解析事件并写入接收用户的通知列表
+func (l *NotifyLogic) Notify(msg []byte) error {
+	var event Event
+	err := json.Unmarshal(msg, &event)
+	if err != nil {
+		return err
+	}
+
+	var data eventData
+	err = json.Unmarshal(event.Data, &data)
+	if err != nil {
+		return err
+	}
+
+	notification := &model.Notification{
+		UserId:     event.UserId,
+		Type:       event.Type,
+		ActorCount: 1,
+		Content:    string(event.Data),
+		IsRead:     model.NotifyUnread,
+		CreateTime: event.CreateTime,
+		UpdateTime: event.CreateTime,
+	}
+
+	switch event.Type {
+	case EVENT_FAVORITE, EVENT_COMMENT:
+		notification.TargetId = data.VideoId
+		notification.ActorId = data.UserId
+		return l.aggregate(notification)
+	case EVENT_FOLLOW:
+		notification.ActorId = data.FollowerId
+		return l.aggregate(notification)
+	case EVENT_PUBLISH: // 投稿通知不聚合
+		notification.TargetId = data.VideoId
+		notification.ActorCount = 0
+		return l.create(notification)
+	case EVENT_MESSAGE:
+		return nil
+	default:
+		return errors.New(EVENT_UNKNOWN_ERROR)
+	}
+}
+
+// aggregate 将通知聚合到同一用户、同一类型、同一对象的未读通知中，不存在未读通知时新建
+// 同一用户的事件以用户 id 为 key 写入同一分区，按顺序消费，所以不会并发聚合同一条通知
+func (l *NotifyLogic) aggregate(notification *model.Notification) error {
+	var old model.Notification
+	err := l.svcCtx.Db.Where(&model.Notification{
+		UserId: notification.UserId,
+		Type:   notification.Type,
+		IsRead: model.NotifyUnread,
+	}).Where("target_id = ?", notification.TargetId).Take(&old).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return l.create(notification)
+		}
+		return err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	added, err := l.svcCtx.Redis.AddNotifyActor(conn, old.Id, notification.ActorId, l.svcCtx.Config.CacheConfig.NOTIFY_ACTORS_CACHE_TTL)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"actor_id":    notification.ActorId,
+		"content":     notification.Content,
+		"update_time": notification.UpdateTime,
+	}
+	if added {
+		updates["actor_count"] = gorm.Expr("actor_count + 1")
+	}
+	return l.svcCtx.Db.Model(&model.Notification{}).Where(&model.Notification{Id: old.Id}).Updates(updates).Error
+}
+
+// create 新建一条未读通知，并更新未读通知数缓存
+func (l *NotifyLogic) create(notification *model.Notification) error {
+	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+	if err != nil {
+		return err
+	}
+	notification.Id, err = sf.Generate()
+	if err != nil {
+		return err
+	}
+
+	err = l.svcCtx.Db.Create(notification).Error
+	if err != nil {
+		return err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	if notification.ActorId != 0 {
+		_, err = l.svcCtx.Redis.AddNotifyActor(conn, notification.Id, notification.ActorId, l.svcCtx.Config.CacheConfig.NOTIFY_ACTORS_CACHE_TTL)
+		if err != nil {
+			return err
+		}
+	}
+	return l.svcCtx.Redis.IncrUnreadCount(conn, notification.UserId, notification.Type, l.svcCtx.Config.CacheConfig.NOTIFY_UNREAD_CACHE_TTL)
+}
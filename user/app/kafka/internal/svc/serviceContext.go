@@ -0,0 +1,54 @@
+package svc
+
+import (
+	"Mini-Tiktok/user/app/kafka/internal/config"
+	"Mini-Tiktok/user/app/kafka/model"
+	"Mini-Tiktok/user/app/kafka/model/redisCache"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"log"
+	"strings"
+)
+
+type ServiceContext struct {
+	Config      config.Config
+	Redis       *redisCache.RedisPool
+	Db          *gorm.DB
+	KafkaReader *kafka.Reader
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+
+	db, err := model.InitGorm(c.DbConfig)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	pool := redisCache.NewRedisPool(c)
+	conn := pool.NewRedisConn()
+	_, err = conn.Do("PING")
+	defer conn.Close()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// 使用消费者组，服务重启后从上次提交的位置继续消费，不丢失通知
+	reader := getKafkaReader(c.KafkaConfig.Host, c.KafkaConfig.Topic, c.KafkaConfig.GroupId, c.KafkaConfig.MinBytes, c.KafkaConfig.MaxBytes)
+	return &ServiceContext{
+		Config:      c,
+		Db:          db,
+		Redis:       pool,
+		KafkaReader: reader,
+	}
+}
+
+func getKafkaReader(kafkaURL, topic, groupId string, minBytes, maxBytes int) *kafka.Reader {
+	brokers := strings.Split(kafkaURL, ",")
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		GroupID:  groupId,
+		Topic:    topic,
+		MinBytes: minBytes,
+		MaxBytes: maxBytes,
+	})
+}
@@ -0,0 +1,50 @@
+package model
+
+import "strconv"
+
+// Notification 表结构
+// 同一用户、同一类型、同一对象（视频）的未读通知会聚合为一条，如 "X 等 12 人赞了你的视频"，
+// 关注通知的 TargetId 为 0，即所有未读的新粉丝聚合为一条；投稿通知不聚合
+type Notification struct {
+	Id         uint64 `json:"id" gorm:"column:id"`
+	UserId     uint64 `json:"user_id" gorm:"column:user_id"`         // 接收通知的用户 id
+	Type       string `json:"type" gorm:"column:type"`               // 通知类型，与推送事件类型一致：favorite、comment、follow、publish
+	TargetId   uint64 `json:"target_id" gorm:"column:target_id"`     // 通知对象 id（视频 id），关注通知为 0
+	ActorId    uint64 `json:"actor_id" gorm:"column:actor_id"`       // 最近一次触发通知的用户 id，投稿通知为 0
+	ActorCount int64  `json:"actor_count" gorm:"column:actor_count"` // 聚合的不同用户数
+	Content    string `json:"content" gorm:"column:content"`         // 最近一次事件的内容 json，与推送事件的 data 相同
+	IsRead     string `json:"is_read" gorm:"column:is_read"`         // 0-未读，1-已读
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"` // 毫秒时间戳
+	UpdateTime int64  `json:"update_time" gorm:"column:update_time"` // 最近一次聚合的时间（毫秒），用于列表排序与分页游标
+}
+
+const (
+	NotifyTypeFavorite = "favorite" // 视频被点赞
+	NotifyTypeComment  = "comment"  // 视频收到新评论
+	NotifyTypeFollow   = "follow"   // 新增粉丝
+	NotifyTypePublish  = "publish"  // 投稿处理完成
+
+	NotifyUnread = "0"
+	NotifyRead   = "1"
+
+	NotifyUnreadCountCacheKeyPrefix = "Notify:UserId:UnreadCount:HASH:"
+	NotifyActorsCacheKeyPrefix      = "Notify:NotifyId:Actors:SET:"
+)
+
+func (Notification) TableName() string {
+	return "notification"
+}
+
+// UnreadCountCacheKey 返回用户各类型未读通知数的缓存 key 名称，
+// 缓存类型为 hash 类型，key: UserId:UnreadCount:HASH:{用户id}, field: 通知类型, value: 未读数
+// 缓存存在时包含所有通知类型的 field，由通知服务在新增通知时累加，标记已读时删除
+func (Notification) UnreadCountCacheKey(userid uint64) string {
+	return NotifyUnreadCountCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
+
+// ActorsCacheKey 返回聚合通知已包含的用户 id 集合的缓存 key 名称，
+// 缓存类型为 set 类型，key: NotifyId:Actors:SET:{通知id}, member: 用户id
+// 用于同一用户重复点赞（取消后再点赞）时不重复计数
+func (Notification) ActorsCacheKey(notifyId uint64) string {
+	return NotifyActorsCacheKeyPrefix + strconv.FormatUint(notifyId, 10)
+}
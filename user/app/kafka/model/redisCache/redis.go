@@ -0,0 +1,72 @@
+package redisCache
+
+import (
+	"Mini-Tiktok/user/app/kafka/internal/config"
+	"Mini-Tiktok/user/app/kafka/model"
+	"fmt"
+	"github.com/gomodule/redigo/redis"
+	"time"
+)
+
+type RedisPool struct {
+	pool *redis.Pool
+}
+
+// NewRedisPool 新建一个 redis 连接池
+func NewRedisPool(config config.Config) *RedisPool {
+	return &RedisPool{&redis.Pool{
+		MaxIdle:     config.RedisConfig.MaxIdle, //最大空闲连接数
+		MaxActive:   config.RedisConfig.Active,  //最大连接数
+		IdleTimeout: time.Duration(config.RedisConfig.IdleTimeout) * time.Second,
+		Wait:        true, //超过连接数后是否等待
+		Dial: func() (redis.Conn, error) {
+			redisUri := fmt.Sprintf("%s:%d", config.RedisConfig.Host, config.RedisConfig.Port)
+			if config.RedisConfig.Auth {
+				redisConn, err := redis.Dial("tcp", redisUri,
+					redis.DialUsername(config.RedisConfig.Username),
+					redis.DialPassword(config.RedisConfig.Password))
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			} else {
+				redisConn, err := redis.Dial("tcp", redisUri)
+				if err != nil {
+					return nil, err
+				}
+				return redisConn, nil
+			}
+		},
+	}}
+}
+
+// NewRedisConn 从连接池中获取一个连接
+func (p *RedisPool) NewRedisConn() redis.Conn {
+	return p.pool.Get()
+}
+
+// AddNotifyActor 将用户 id 加入聚合通知已包含的用户集合并刷新过期时间，返回是否为新加入的用户
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) AddNotifyActor(conn redis.Conn, notifyId, actorId uint64, ttl int) (bool, error) {
+	added, err := redis.Int(conn.Do("EVAL", "local added = redis.call('SADD', KEYS[1], ARGV[1]); "+
+		"redis.call('EXPIRE', KEYS[1], ARGV[2]); "+
+		"return added; ", 1, model.Notification{}.ActorsCacheKey(notifyId), actorId, ttl))
+	if err != nil {
+		return false, err
+	}
+	return added == 1, nil
+}
+
+// IncrUnreadCount 新增通知后将用户对应类型的未读通知数 + 1
+// 只在缓存存在时更新，不存在时由用户服务下次查询时从 DB 加载
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrUnreadCount(conn redis.Conn, userid uint64, notifyType string, ttl int) error {
+	_, err := conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) == 1) then "+
+		"redis.call('HINCRBY', KEYS[1], ARGV[1], 1); "+
+		"redis.call('EXPIRE', KEYS[1], ARGV[2]); end; "+
+		"return nil; ", 1, model.Notification{}.UnreadCountCacheKey(userid), notifyType, ttl)
+	if err != nil {
+		return err
+	}
+	return nil
+}
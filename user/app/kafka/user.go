@@ -0,0 +1,36 @@
+package main
+
+import (
+	"Mini-Tiktok/user/app/kafka/internal/config"
+	"Mini-Tiktok/user/app/kafka/internal/logic"
+	"Mini-Tiktok/user/app/kafka/internal/svc"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+)
+
+var configFile = flag.String("f", "etc/user.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+	var c config.Config
+	config.MustLoad(*configFile, &c)
+	svcctx := svc.NewServiceContext(c)
+	l := logic.NewNotifyLogic(context.Background(), svcctx)
+	fmt.Println("Notification Service Start...")
+	fmt.Println("start consuming ...")
+	for {
+		m, err := svcctx.KafkaReader.ReadMessage(context.Background())
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		// 消息格式：key: 接收用户 id, value: logic.Event , json 结构
+		fmt.Printf("message at topic:%v partition:%v offset:%v	%s = %s\n", m.Topic, m.Partition, m.Offset, string(m.Key), string(m.Value))
+		err = l.Notify(m.Value)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"Mini-Tiktok/common/moderation"
 	"github.com/zeromicro/go-zero/zrpc"
 	"strconv"
 )
@@ -18,8 +19,27 @@ type Config struct {
 		Active      int
 		IdleTimeout int
 	}
-	CacheConfig CacheConfig
-	WorkerId    uint32
+	CacheConfig    CacheConfig
+	PasswordPolicy PasswordPolicy
+	BcryptCost     int `json:",default=10"`
+	MessageConfig  MessageConfig
+	NotifyConfig   NotifyConfig
+	PushConfig     struct {
+		Host         string
+		Topic        string
+		BatchTimeout int
+		BatchSize    int
+		BatchBytes   int64
+	}
+	SearchConfig struct {
+		Host         string
+		Topic        string
+		BatchTimeout int
+		BatchSize    int
+		BatchBytes   int64
+	}
+	Moderation moderation.Config
+	WorkerId   uint32
 }
 
 type DbConfig struct {
@@ -50,4 +70,26 @@ type CacheConfig struct {
 	USER_CACHE_INIT_SIZE        int
 	FOLLOWLIST_MAX_CACHE_SIZE   int
 	FOLLOWERLIST_MAX_CACHE_SIZE int
+	MESSAGE_CACHE_TTL           int `json:",default=43200"`
+	NOTIFY_UNREAD_CACHE_TTL     int `json:",default=86400"`
+	BLOCK_CACHE_TTL             int `json:",default=3600"`
+}
+
+type PasswordPolicy struct {
+	MinLength        int    `json:",default=6"`  // 密码最小长度
+	MaxLength        int    `json:",default=32"` // 密码最大长度（bcrypt 最多使用 72 字节）
+	RequireUpper     bool   `json:",optional"`   // 是否必须包含大写字母
+	RequireLower     bool   `json:",optional"`   // 是否必须包含小写字母
+	RequireDigit     bool   `json:",optional"`   // 是否必须包含数字
+	RequireSymbol    bool   `json:",optional"`   // 是否必须包含特殊字符
+	BreachedListPath string `json:",optional"`   // 已泄露密码列表文件路径，每行一个密码，为空则不检查
+}
+
+type MessageConfig struct {
+	MaxLength int `json:",default=500"` // 单条消息最大字符数
+	ListLimit int `json:",default=100"` // 一次拉取聊天记录的最大条数
+}
+
+type NotifyConfig struct {
+	ListLimit int `json:",default=20"` // 一次拉取通知的最大条数
 }
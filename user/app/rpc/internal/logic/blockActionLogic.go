@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockActionLogic {
+	return &BlockActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockAction 拉黑或取消拉黑用户，拉黑时同时解除双方之间的关注关系
+func (l *BlockActionLogic) BlockAction(in *user.BlockActionReq) (*user.BlockActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.BlockActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	toUserId, err := strconv.ParseUint(in.ToUserId, 10, 64)
+	if err != nil {
+		return &user.BlockActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if userid == toUserId {
+		return &user.BlockActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_BLOCK_SELF_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	switch in.ActionType {
+	case OP_BLOCK:
+		// 查找用户是否存在
+		err = l.svcCtx.Db.Model(model.User{}).Where(&model.User{Id: toUserId}).Take(&model.User{}).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound { // 用户不存在
+				return &user.BlockActionResp{
+					StatusCode: STATUS_FAIL,
+					StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+				}, nil
+			}
+			return nil, err
+		}
+
+		// 1. 写入拉黑记录并删除双方的关注记录，重复拉黑不做修改
+		err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Block{
+				UserId:     userid,
+				TargetId:   toUserId,
+				Type:       model.BlockTypeBlock,
+				CreateTime: time.Now().Unix(),
+			}).Error
+			if err != nil {
+				return err
+			}
+
+			return tx.Where(&model.Follow{Follower: userid, Following: toUserId}).
+				Or(&model.Follow{Follower: toUserId, Following: userid}).
+				Delete(&model.Follow{}).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. 更新双方用户的最新关注列表以及最新粉丝列表
+		err = l.svcCtx.Redis.RemFollowUserList(conn, userid, toUserId)
+		if err != nil {
+			return nil, err
+		}
+		err = l.svcCtx.Redis.RemFollowUserList(conn, toUserId, userid)
+		if err != nil {
+			return nil, err
+		}
+
+	case OP_CANCEL_BLOCK:
+		err = l.svcCtx.Db.Delete(&model.Block{}, &model.Block{
+			UserId:   userid,
+			TargetId: toUserId,
+			Type:     model.BlockTypeBlock,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return &user.BlockActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	// 3. 删除双方的拉黑缓存，由下次查询时从 DB 重新加载
+	err = l.svcCtx.Redis.DelBlockCache(conn, userid, toUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.BlockActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
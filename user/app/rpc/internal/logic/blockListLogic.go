@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockListLogic {
+	return &BlockListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockList 获取用户拉黑的用户列表，按拉黑时间倒序返回
+func (l *BlockListLogic) BlockList(in *user.BlockListReq) (*user.BlockListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.BlockListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	userList, err := blockUserList(l.ctx, l.svcCtx, userid, model.BlockTypeBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.BlockListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		UserList:   userList,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/logic/utils"
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ChangePasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ChangePassword 修改密码，需要校验旧密码，新密码需满足密码策略
+// 已签发 token 的吊销由 api 层调用鉴权服务完成
+func (l *ChangePasswordLogic) ChangePassword(in *user.ChangePasswordReq) (*user.ChangePasswordResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.ChangePasswordResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var userInfo *model.User
+	err = l.svcCtx.Db.Where(&model.User{Id: userid}).Take(&userInfo).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &user.ChangePasswordResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	// 1. 校验旧密码
+	if ok := utils.BcryptCheck(in.OldPassword, userInfo.Password); !ok {
+		return &user.ChangePasswordResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_WRONG_PASSWORD_MSG,
+		}, nil
+	}
+
+	if in.OldPassword == in.NewPassword {
+		return &user.ChangePasswordResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_SAME_PASSWORD_MSG,
+		}, nil
+	}
+
+	// 2. 检查新密码是否满足密码策略
+	if err = l.svcCtx.PasswordPolicy.Check(in.NewPassword); err != nil {
+		return &user.ChangePasswordResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	// 3. 写入新密码哈希
+	hash, err := utils.BcryptHash(in.NewPassword, l.svcCtx.Config.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userid}).Update("password", hash).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.ChangePasswordResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
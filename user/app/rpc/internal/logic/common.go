@@ -1,14 +1,35 @@
 package logic
 
 const (
-	STATUS_SUCCESS            = "0"
-	STATUS_SUCCESS_MSG        = "OK"
-	STATUS_FAIL               = "1"
-	STATUS_FAIL_PARAM_MSG     = "Param incorrect"
-	STATUS_USER_EXISTS_MSG    = "Username already exists"
-	STATUS_USER_NOTEXIST_MSG  = "User not exist"
-	STATUS_WRONG_PASSWORD_MSG = "Wrong Password"
-	COUNT_NOT_FOUND           = int64(-1)
-	OP_FOLLOW                 = "1"
-	OP_CANCEL_FOLLOW          = "2"
+	STATUS_SUCCESS             = "0"
+	STATUS_SUCCESS_MSG         = "OK"
+	STATUS_FAIL                = "1"
+	STATUS_FAIL_PARAM_MSG      = "Param incorrect"
+	STATUS_USER_EXISTS_MSG     = "Username already exists"
+	STATUS_USER_NOTEXIST_MSG   = "User not exist"
+	STATUS_WRONG_PASSWORD_MSG  = "Wrong Password"
+	STATUS_USER_BANNED_MSG     = "User has been banned"
+	STATUS_SAME_PASSWORD_MSG   = "New password must be different from the old one"
+	STATUS_NICKNAME_LONG_MSG   = "Nickname must less than 32 characters"
+	STATUS_SIGNATURE_LONG_MSG  = "Signature must less than 128 characters"
+	NICKNAME_MAX_LENGTH        = 32
+	SIGNATURE_MAX_LENGTH       = 128
+	STATUS_MESSAGE_EMPTY_MSG   = "Message content is empty"
+	STATUS_MESSAGE_TOOLONG_MSG = "Message content is too long"
+	STATUS_NOT_FRIEND_MSG      = "Messages can only be sent between friends"
+	STATUS_FRIEND_SELF_MSG     = "Friend list is only visible to yourself"
+	STATUS_NOTIFY_TYPE_MSG     = "Unknown notification type"
+	STATUS_BLOCK_SELF_MSG      = "Block or mute yourself is not allowed"
+	STATUS_BLOCKED_MSG         = "Not allowed between blocked users"
+	STATUS_USERNAME_SENSITIVE  = "Username contains sensitive words"
+	COUNT_NOT_FOUND            = int64(-1)
+	OP_FOLLOW                  = "1"
+	OP_CANCEL_FOLLOW           = "2"
+	OP_BLOCK                   = "1"
+	OP_CANCEL_BLOCK            = "2"
+	OP_MUTE                    = "1"
+	OP_CANCEL_MUTE             = "2"
+	MSG_TYPE_NONE              = int64(-1) // 好友之间还没有消息
+	MSG_TYPE_RECEIVED          = int64(0)  // 最新消息为当前用户接收的消息
+	MSG_TYPE_SENT              = int64(1)  // 最新消息为当前用户发送的消息
 )
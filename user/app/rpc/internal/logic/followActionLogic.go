@@ -69,10 +69,22 @@ func (l *FollowActionLogic) FollowAction(in *user.FollowActionReq) (*user.Follow
 			return nil, err
 		}
 
+		// 存在拉黑关系的双方不能关注
+		blocked, err := isBlocked(l.svcCtx, conn, userid, toUserId)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return &user.FollowActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_BLOCKED_MSG,
+			}, nil
+		}
+
 		// 先更新 DB
 		// 我们不用检查是否用户是否已关注该对象，
 		// 因为关注关系双方的 id 做联合主键，不会插入相同的关注记录
-		err := l.svcCtx.Db.Create(&model.Follow{
+		err = l.svcCtx.Db.Create(&model.Follow{
 			Follower:   userid,
 			Following:  toUserId,
 			CreateTime: createTime,
@@ -87,6 +99,12 @@ func (l *FollowActionLogic) FollowAction(in *user.FollowActionReq) (*user.Follow
 			return nil, err
 		}
 
+		// 通知被关注的用户，推送失败不影响关注结果
+		err = pushEvent(l.ctx, l.svcCtx, model.PushEventFollow, toUserId, &model.FollowEventData{FollowerId: userid})
+		if err != nil {
+			l.Errorf("push follow event: %v", err)
+		}
+
 	case OP_CANCEL_FOLLOW:
 		err := l.svcCtx.Db.Delete(&model.Follow{}, &model.Follow{
 			Follower:  userid,
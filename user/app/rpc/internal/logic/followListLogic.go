@@ -117,15 +117,7 @@ func (l *FollowListLogic) FollowList(in *user.FollowListReq) (*user.FollowListRe
 			return nil, err
 		}
 
-		userInfo := &user.User{
-			FollowCount:   u.User.FollowCount,
-			FollowerCount: u.User.FollowerCount,
-			ID:            u.User.ID,
-			IsFollow:      u.User.IsFollow,
-			Name:          u.User.Name,
-		}
-
-		followList = append(followList, userInfo)
+		followList = append(followList, u.User)
 	}
 
 	return &user.FollowListResp{
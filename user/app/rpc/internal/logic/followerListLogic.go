@@ -117,15 +117,7 @@ func (l *FollowerListLogic) FollowerList(in *user.FollowerListReq) (*user.Follow
 			return nil, err
 		}
 
-		userInfo := &user.User{
-			FollowCount:   u.User.FollowCount,
-			FollowerCount: u.User.FollowerCount,
-			ID:            u.User.ID,
-			IsFollow:      u.User.IsFollow,
-			Name:          u.User.Name,
-		}
-
-		followerList = append(followerList, userInfo)
+		followerList = append(followerList, u.User)
 	}
 
 	return &user.FollowerListResp{
@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FriendListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFriendListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FriendListLogic {
+	return &FriendListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FriendList 获取当前用户的好友（互相关注）列表，每个好友附带双方最新的一条消息。
+// 消息预览属于私信内容，因此 ToUserId 必须与当前用户一致，不允许查看他人的好友列表
+func (l *FriendListLogic) FriendList(in *user.FriendListReq) (*user.FriendListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.FriendListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	toUserId, err := strconv.ParseUint(in.ToUserId, 10, 64)
+	if err != nil {
+		return &user.FriendListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	if toUserId != userid {
+		return &user.FriendListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FRIEND_SELF_MSG,
+		}, nil
+	}
+
+	// 1. 查询好友 id 列表
+	ids, err := friendIdList(l.svcCtx, userid)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	// 2. 一次性获取与所有好友的最新消息缓存
+	latest, err := l.svcCtx.Redis.GetLatestMessages(conn, userid, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	friendList := make([]*user.FriendUser, 0, len(ids))
+	for i, id := range ids {
+		getUserLogic := NewGetUserLogic(l.ctx, l.svcCtx)
+		u, err := getUserLogic.GetUser(&user.GetUserReq{
+			UserID:  strconv.FormatUint(userid, 10),
+			QueryID: strconv.FormatUint(id, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if u.User == nil { // 用户已不存在
+			continue
+		}
+
+		// 3. 缓存未查到最新消息，则到 DB 中查询并写入缓存
+		var msg *model.Message
+		if latest[i] != nil {
+			err = json.Unmarshal(latest[i], &msg)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			msg, err = l.latestMessage(userid, id)
+			if err != nil {
+				return nil, err
+			}
+			if msg != nil {
+				msgJson, err := json.Marshal(msg)
+				if err != nil {
+					return nil, err
+				}
+				err = l.svcCtx.Redis.SetLatestMessage(conn, msg, msgJson)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		friend := &user.FriendUser{
+			User:    u.User,
+			MsgType: MSG_TYPE_NONE,
+		}
+		if msg != nil {
+			friend.Message = msg.Content
+			friend.MsgType = MSG_TYPE_RECEIVED
+			if msg.FromUserId == userid {
+				friend.MsgType = MSG_TYPE_SENT
+			}
+		}
+		friendList = append(friendList, friend)
+	}
+
+	return &user.FriendListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		UserList:   friendList,
+	}, nil
+}
+
+// latestMessage 从 DB 查询两个用户之间最新的一条消息，没有消息时返回 nil
+func (l *FriendListLogic) latestMessage(userid, toUserId uint64) (*model.Message, error) {
+	var msg model.Message
+	err := l.svcCtx.Db.
+		Where(&model.Message{FromUserId: userid, ToUserId: toUserId}).
+		Or(&model.Message{FromUserId: toUserId, ToUserId: userid}).
+		Order("create_time DESC").Take(&msg).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
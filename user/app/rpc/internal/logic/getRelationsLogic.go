@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRelationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRelationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRelationsLogic {
+	return &GetRelationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetRelations 批量获取当前用户与其他用户的关注关系（如视频服务判断仅粉丝可见与仅好友可见的视频）
+// 关注关系直接查 DB，最近关注列表缓存只保存部分关注用户，无法判断不在缓存中的用户
+func (l *GetRelationsLogic) GetRelations(in *user.RelationsReq) (*user.RelationsResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.RelationsResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if len(in.QueryIdList) == 0 {
+		return &user.RelationsResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+		}, nil
+	}
+
+	var followIds, followerIds []uint64
+	err = l.svcCtx.Db.Model(&model.Follow{}).Where("follower_id = ? and following_id in ?", userid, in.QueryIdList).
+		Pluck("following_id", &followIds).Error
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Db.Model(&model.Follow{}).Where("following_id = ? and follower_id in ?", userid, in.QueryIdList).
+		Pluck("follower_id", &followerIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	follow := make(map[uint64]bool, len(followIds))
+	for _, id := range followIds {
+		follow[id] = true
+	}
+	follower := make(map[uint64]bool, len(followerIds))
+	for _, id := range followerIds {
+		follower[id] = true
+	}
+	relationList := make([]*user.Relation, len(in.QueryIdList))
+	for i, id := range in.QueryIdList {
+		relationList[i] = &user.Relation{
+			UserId:     id,
+			IsFollow:   follow[id],
+			IsFollower: follower[id],
+		}
+	}
+
+	return &user.RelationsResp{
+		StatusCode:   STATUS_SUCCESS,
+		StatusMsg:    STATUS_SUCCESS_MSG,
+		RelationList: relationList,
+	}, nil
+}
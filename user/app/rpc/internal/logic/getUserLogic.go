@@ -60,8 +60,8 @@ func (l *GetUserLogic) GetUser(in *user.GetUserReq) (*user.GetUserResp, error) {
 		}
 	}
 
-	// 2. 从缓存中获取查询对象用户名，关注数，粉丝数
-	username, followCnt, followerCnt, err := l.svcCtx.Redis.GetUserInfo(conn, queryid)
+	// 2. 从缓存中获取查询对象用户资料及各项计数
+	info, err := l.svcCtx.Redis.GetUserInfo(conn, queryid)
 	needUpdateCache := false // 是否需要更新缓存
 
 	if err != nil {
@@ -71,9 +71,10 @@ func (l *GetUserLogic) GetUser(in *user.GetUserReq) (*user.GetUserResp, error) {
 		needUpdateCache = true
 	}
 
-	// 用户名不存在则到 DB 查询
-	if username == "" {
-		err = l.svcCtx.Db.Model(model.User{}).Select("username").Where(&model.User{Id: queryid}).Take(&username).Error
+	// 用户资料不存在则到 DB 查询
+	if info.Username == "" {
+		var u model.User
+		err = l.svcCtx.Db.Where(&model.User{Id: queryid}).Take(&u).Error
 		if err != nil {
 			if err == gorm.ErrRecordNotFound { // 用户不存在
 				return &user.GetUserResp{
@@ -84,46 +85,71 @@ func (l *GetUserLogic) GetUser(in *user.GetUserReq) (*user.GetUserResp, error) {
 			}
 			return nil, err
 		}
+		info.Username = u.Username
+		info.Nickname = u.Nickname
+		info.Avatar = u.Avatar
+		info.BackgroundImage = u.BackgroundImage
+		info.Signature = u.Signature
+		needUpdateCache = true
 	}
 
 	// 关注数不存在则到 DB 查询
-	if followCnt == COUNT_NOT_FOUND {
-		followCnt = 0
-		err = l.svcCtx.Db.Model(&model.Follow{}).Where(&model.Follow{Follower: queryid}).Count(&followCnt).Error
+	if info.FollowCount == COUNT_NOT_FOUND {
+		info.FollowCount = 0
+		err = l.svcCtx.Db.Model(&model.Follow{}).Where(&model.Follow{Follower: queryid}).Count(&info.FollowCount).Error
 		if err != nil {
 			if err != gorm.ErrRecordNotFound {
 				return nil, err
 			}
 		}
+		needUpdateCache = true
 	}
 
 	// 粉丝数不存在则到 DB 查询
-	if followerCnt == COUNT_NOT_FOUND {
-		followerCnt = 0
-		err = l.svcCtx.Db.Model(&model.Follow{}).Where(&model.Follow{Following: queryid}).Count(&followerCnt).Error
+	if info.FollowerCount == COUNT_NOT_FOUND {
+		info.FollowerCount = 0
+		err = l.svcCtx.Db.Model(&model.Follow{}).Where(&model.Follow{Following: queryid}).Count(&info.FollowerCount).Error
 		if err != nil {
 			if err != gorm.ErrRecordNotFound {
 				return nil, err
 			}
 		}
+		needUpdateCache = true
+	}
 
+	// 获赞数不存在则到 DB 查询
+	if info.TotalFavorited == COUNT_NOT_FOUND {
+		if info.TotalFavorited, err = model.CountTotalFavorited(l.svcCtx.Db, queryid); err != nil {
+			return nil, err
+		}
+		needUpdateCache = true
+	}
+
+	// 作品数不存在则到 DB 查询
+	if info.WorkCount == COUNT_NOT_FOUND {
+		if info.WorkCount, err = model.CountWork(l.svcCtx.Db, queryid); err != nil {
+			return nil, err
+		}
+		needUpdateCache = true
+	}
+
+	// 点赞数不存在则到 DB 查询
+	if info.FavoriteCount == COUNT_NOT_FOUND {
+		if info.FavoriteCount, err = model.CountFavorite(l.svcCtx.Db, queryid); err != nil {
+			return nil, err
+		}
+		needUpdateCache = true
 	}
 
 	// 将从 DB 获取到的数据写入缓存
 	if needUpdateCache {
-		err = l.svcCtx.Redis.SetUserInfo(conn, queryid, username, followCnt, followerCnt)
+		err = l.svcCtx.Redis.SetUserInfo(conn, queryid, info)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	UserInfo := &user.User{
-		FollowCount:   followCnt,
-		FollowerCount: followerCnt,
-		ID:            queryid,
-		IsFollow:      isfollow,
-		Name:          username,
-	}
+	UserInfo := toUser(queryid, info, isfollow)
 
 	return &user.GetUserResp{
 		StatusCode: STATUS_SUCCESS,
@@ -131,3 +157,21 @@ func (l *GetUserLogic) GetUser(in *user.GetUserReq) (*user.GetUserResp, error) {
 		User:       UserInfo,
 	}, nil
 }
+
+// toUser 将缓存中的用户信息转换为 rpc 返回的 User 结构
+func toUser(userid uint64, info *model.UserInfo, isFollow bool) *user.User {
+	return &user.User{
+		ID:              userid,
+		Name:            info.Username,
+		FollowCount:     info.FollowCount,
+		FollowerCount:   info.FollowerCount,
+		IsFollow:        isFollow,
+		Nickname:        info.Nickname,
+		Avatar:          info.Avatar,
+		BackgroundImage: info.BackgroundImage,
+		Signature:       info.Signature,
+		TotalFavorited:  info.TotalFavorited,
+		WorkCount:       info.WorkCount,
+		FavoriteCount:   info.FavoriteCount,
+	}
+}
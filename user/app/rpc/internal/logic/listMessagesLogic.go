@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMessagesLogic {
+	return &ListMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListMessages 获取双方在 PreMsgTime 之后的聊天记录，按时间正序返回
+// 客户端轮询时将上次拉取到的最新消息时间作为 PreMsgTime 传入，即可只拉取新消息
+func (l *ListMessagesLogic) ListMessages(in *user.ListMessagesReq) (*user.ListMessagesResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.ListMessagesResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	toUserId, err := strconv.ParseUint(in.ToUserId, 10, 64)
+	if err != nil {
+		return &user.ListMessagesResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var msgList []model.Message
+	err = l.svcCtx.Db.
+		Where("((from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)) AND create_time > ?",
+			userid, toUserId, toUserId, userid, in.PreMsgTime).
+		Order("create_time ASC").
+		Limit(l.svcCtx.Config.MessageConfig.ListLimit).
+		Find(&msgList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	messageList := make([]*user.Message, len(msgList))
+	for i, v := range msgList {
+		messageList[i] = &user.Message{
+			ID:         v.Id,
+			FromUserID: v.FromUserId,
+			ToUserID:   v.ToUserId,
+			Content:    v.Content,
+			CreateTime: v.CreateTime,
+		}
+	}
+
+	return &user.ListMessagesResp{
+		StatusCode:  STATUS_SUCCESS,
+		StatusMsg:   STATUS_SUCCESS_MSG,
+		MessageList: messageList,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationsLogic {
+	return &ListNotificationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListNotifications 获取用户的通知列表，按最近一次聚合时间倒序返回
+// 客户端翻页时将上一页返回的 NextCursor 作为 Cursor 传入
+func (l *ListNotificationsLogic) ListNotifications(in *user.ListNotificationsReq) (*user.ListNotificationsResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.ListNotificationsResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	if in.Type != "" && !isNotifyType(in.Type) {
+		return &user.ListNotificationsResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_NOTIFY_TYPE_MSG,
+		}, nil
+	}
+
+	// 1. 查询一页通知
+	limit := l.svcCtx.Config.NotifyConfig.ListLimit
+	tx := l.svcCtx.Db.Where(&model.Notification{UserId: userid, Type: in.Type})
+	if in.Cursor > 0 {
+		tx = tx.Where("update_time < ?", in.Cursor)
+	}
+	var notifyList []model.Notification
+	err = tx.Order("update_time DESC").Limit(limit).Find(&notifyList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 填充最近一次触发通知的用户信息
+	notificationList := make([]*user.Notification, len(notifyList))
+	for i, v := range notifyList {
+		notificationList[i] = &user.Notification{
+			ID:         v.Id,
+			Type:       v.Type,
+			TargetID:   v.TargetId,
+			ActorCount: v.ActorCount,
+			Content:    v.Content,
+			IsRead:     v.IsRead == model.NotifyRead,
+			CreateTime: v.CreateTime,
+			UpdateTime: v.UpdateTime,
+		}
+		if v.ActorId == 0 {
+			continue
+		}
+
+		getUserLogic := NewGetUserLogic(l.ctx, l.svcCtx)
+		u, err := getUserLogic.GetUser(&user.GetUserReq{
+			UserID:  in.UserId,
+			QueryID: strconv.FormatUint(v.ActorId, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+		notificationList[i].Actor = u.User
+	}
+
+	// 3. 不足一页说明没有更多通知
+	var nextCursor int64
+	if len(notifyList) == limit {
+		nextCursor = notifyList[len(notifyList)-1].UpdateTime
+	}
+
+	return &user.ListNotificationsResp{
+		StatusCode:       STATUS_SUCCESS,
+		StatusMsg:        STATUS_SUCCESS_MSG,
+		NotificationList: notificationList,
+		NextCursor:       nextCursor,
+	}, nil
+}
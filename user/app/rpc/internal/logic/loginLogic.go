@@ -48,9 +48,30 @@ func (l *LoginLogic) Login(in *user.LoginReq) (*user.LoginResp, error) {
 			UserID:     0,
 		}, nil
 	}
+
+	if userInfo.Status == model.UserStatusBanned {
+		return &user.LoginResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_USER_BANNED_MSG,
+			UserID:     0,
+		}, nil
+	}
+
+	// 存储的哈希强度低于当前配置时，借助本次登录的明文密码重新哈希（失败不影响登录）
+	if utils.BcryptNeedRehash(userInfo.Password, l.svcCtx.Config.BcryptCost) {
+		hash, err := utils.BcryptHash(in.Password, l.svcCtx.Config.BcryptCost)
+		if err == nil {
+			err = l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userInfo.Id}).Update("password", hash).Error
+		}
+		if err != nil {
+			l.Errorf("rehash password of user %d failed: %v", userInfo.Id, err)
+		}
+	}
+
 	return &user.LoginResp{
 		StatusCode: STATUS_SUCCESS,
 		StatusMsg:  STATUS_SUCCESS_MSG,
 		UserID:     userInfo.Id,
+		Role:       userInfo.Role,
 	}, nil
 }
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MarkNotificationsReadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMarkNotificationsReadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MarkNotificationsReadLogic {
+	return &MarkNotificationsReadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MarkNotificationsRead 将用户指定的通知标记为已读，Ids 为空时标记所有（或 Type 类型的）未读通知
+// 已读的通知不再参与聚合，之后的同类事件会新建一条通知
+func (l *MarkNotificationsReadLogic) MarkNotificationsRead(in *user.MarkNotificationsReadReq) (*user.MarkNotificationsReadResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.MarkNotificationsReadResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	if in.Type != "" && !isNotifyType(in.Type) {
+		return &user.MarkNotificationsReadResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_NOTIFY_TYPE_MSG,
+		}, nil
+	}
+
+	// 1. 更新 DB
+	tx := l.svcCtx.Db.Model(&model.Notification{}).
+		Where(&model.Notification{UserId: userid, Type: in.Type, IsRead: model.NotifyUnread})
+	if len(in.Ids) > 0 {
+		tx = tx.Where("id IN ?", in.Ids)
+	}
+	err = tx.Update("is_read", model.NotifyRead).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 删除未读数缓存，下次查询时从 DB 重新加载
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	err = l.svcCtx.Redis.DelUnreadCount(conn, userid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.MarkNotificationsReadResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
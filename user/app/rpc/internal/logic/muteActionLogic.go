@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MuteActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMuteActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MuteActionLogic {
+	return &MuteActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MuteAction 屏蔽或取消屏蔽用户，屏蔽只隐藏对方的视频与评论，不影响关注关系
+func (l *MuteActionLogic) MuteAction(in *user.MuteActionReq) (*user.MuteActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.MuteActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	toUserId, err := strconv.ParseUint(in.ToUserId, 10, 64)
+	if err != nil {
+		return &user.MuteActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if userid == toUserId {
+		return &user.MuteActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_BLOCK_SELF_MSG,
+		}, nil
+	}
+
+	switch in.ActionType {
+	case OP_MUTE:
+		// 查找用户是否存在
+		err = l.svcCtx.Db.Model(model.User{}).Where(&model.User{Id: toUserId}).Take(&model.User{}).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound { // 用户不存在
+				return &user.MuteActionResp{
+					StatusCode: STATUS_FAIL,
+					StatusMsg:  STATUS_USER_NOTEXIST_MSG,
+				}, nil
+			}
+			return nil, err
+		}
+
+		// 重复屏蔽不做修改
+		err = l.svcCtx.Db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Block{
+			UserId:     userid,
+			TargetId:   toUserId,
+			Type:       model.BlockTypeMute,
+			CreateTime: time.Now().Unix(),
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+
+	case OP_CANCEL_MUTE:
+		err = l.svcCtx.Db.Delete(&model.Block{}, &model.Block{
+			UserId:   userid,
+			TargetId: toUserId,
+			Type:     model.BlockTypeMute,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return &user.MuteActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	// 删除屏蔽缓存，由下次查询时从 DB 重新加载
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	err = l.svcCtx.Redis.DelBlockCache(conn, userid, toUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.MuteActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MuteListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMuteListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MuteListLogic {
+	return &MuteListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MuteList 获取用户屏蔽的用户列表，按屏蔽时间倒序返回
+func (l *MuteListLogic) MuteList(in *user.MuteListReq) (*user.MuteListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.MuteListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	userList, err := blockUserList(l.ctx, l.svcCtx, userid, model.BlockTypeMute)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.MuteListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		UserList:   userList,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package logic
+
+import "Mini-Tiktok/user/app/rpc/model"
+
+// isNotifyType 判断 t 是否为合法的通知类型
+func isNotifyType(t string) bool {
+	for _, v := range model.NotifyTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+	"time"
+)
+
+// pushEvent 将事件写入 Kafka 推送主题，以接收用户 id 作为消息 key
+func pushEvent(ctx context.Context, svcCtx *svc.ServiceContext, eventType string, userid uint64, data interface{}) error {
+	marshal, err := json.Marshal(&model.PushEvent{
+		Type:       eventType,
+		UserId:     userid,
+		Data:       data,
+		CreateTime: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	return svcCtx.PushWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(userid, 10)),
+		Value: marshal,
+	})
+}
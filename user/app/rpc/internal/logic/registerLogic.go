@@ -1,6 +1,8 @@
 package logic
 
 import (
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/common/rbac"
 	"Mini-Tiktok/user/app/rpc/internal/logic/utils"
 	"Mini-Tiktok/user/app/rpc/internal/svc"
 	"Mini-Tiktok/user/app/rpc/model"
@@ -41,6 +43,26 @@ func (l *RegisterLogic) Register(in *user.RegisterReq) (*user.RegisterResp, erro
 			UserID:     0,
 		}, nil
 	}
+
+	// 敏感词检查：用户名用于登录，不能替换为 *，所以命中拒绝类与屏蔽类敏感词都拒绝注册
+	check := l.svcCtx.Moderation.Check(username)
+	if check.Action == moderation.ACTION_REJECT || check.Action == moderation.ACTION_MASK {
+		return &user.RegisterResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_USERNAME_SENSITIVE,
+			UserID:     0,
+		}, nil
+	}
+
+	// 检查密码是否满足密码策略
+	if err = l.svcCtx.PasswordPolicy.Check(password); err != nil {
+		return &user.RegisterResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  err.Error(),
+			UserID:     0,
+		}, nil
+	}
+
 	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
 	if err != nil {
 		return nil, err
@@ -50,10 +72,17 @@ func (l *RegisterLogic) Register(in *user.RegisterReq) (*user.RegisterResp, erro
 		return nil, err
 	}
 
+	hash, err := utils.BcryptHash(password, l.svcCtx.Config.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+
 	userInfo := &model.User{
 		Id:       uuid,
 		Username: username,
-		Password: utils.BcryptHash(password),
+		Password: hash,
+		Role:     rbac.ROLE_USER,
+		Status:   model.UserStatusNormal,
 	}
 
 	// 插入注册用户记录
@@ -62,6 +91,20 @@ func (l *RegisterLogic) Register(in *user.RegisterReq) (*user.RegisterResp, erro
 		return nil, err
 	}
 
+	// 命中审核类敏感词，记录到待审核列表，记录失败不影响注册结果
+	if check.Action == moderation.ACTION_REVIEW {
+		err = moderation.FlagForReview(l.svcCtx.Db, l.svcCtx.Config.WorkerId, moderation.FLAG_USERNAME, userInfo.Id, userInfo.Id, check.Text, check.Words)
+		if err != nil {
+			l.Errorf("flag username for review: %v", err)
+		}
+	}
+
+	// 写入搜索索引，失败只记录日志
+	err = searchUserEvent(l.ctx, l.svcCtx, userInfo.Id, userInfo.Username, userInfo.Nickname)
+	if err != nil {
+		l.Errorf("write search event: %v", err)
+	}
+
 	return &user.RegisterResp{
 		StatusCode: STATUS_SUCCESS,
 		StatusMsg:  STATUS_SUCCESS_MSG,
@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"github.com/gomodule/redigo/redis"
+	"strconv"
+)
+
+// isFriend 判断两个用户是否互相关注，先查缓存，缓存未查到再查 DB
+func isFriend(svcCtx *svc.ServiceContext, conn redis.Conn, userid, toUserId uint64) (bool, error) {
+	ok, err := svcCtx.Redis.IsFriend(conn, userid, toUserId)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		return true, nil
+	}
+
+	count := int64(0)
+	err = svcCtx.Db.Model(&model.Follow{}).
+		Where(&model.Follow{Follower: userid, Following: toUserId}).
+		Or(&model.Follow{Follower: toUserId, Following: userid}).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count == 2, nil
+}
+
+// friendIdList 从 DB 查询与用户互相关注的好友 id 列表，按用户关注对方的时间倒序排列
+func friendIdList(svcCtx *svc.ServiceContext, userid uint64) ([]uint64, error) {
+	var ids []uint64
+	err := svcCtx.Db.Model(&model.Follow{}).Select("follow.following_id").
+		Joins("JOIN follow AS f ON f.follower_id = follow.following_id AND f.following_id = follow.follower_id").
+		Where("follow.follower_id = ?", userid).
+		Order("follow.create_time DESC").
+		Find(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// isBlocked 判断两个用户之间是否存在拉黑关系，先查缓存，缓存不存在则从 DB 加载并写入缓存
+func isBlocked(svcCtx *svc.ServiceContext, conn redis.Conn, userid, toUserId uint64) (bool, error) {
+	blocked, exists, err := svcCtx.Redis.IsBlocked(conn, userid, toUserId)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return blocked, nil
+	}
+
+	blockIds, err := model.BlockIdList(svcCtx.Db, userid)
+	if err != nil {
+		return false, err
+	}
+	muteIds, err := model.MuteIdList(svcCtx.Db, userid)
+	if err != nil {
+		return false, err
+	}
+	err = svcCtx.Redis.SetBlockCache(conn, userid, blockIds, muteIds, svcCtx.Config.CacheConfig.BLOCK_CACHE_TTL)
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range blockIds {
+		if id == toUserId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// blockUserList 查询用户拉黑或屏蔽的用户列表，按拉黑或屏蔽时间倒序排列
+func blockUserList(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, blockType string) ([]*user.User, error) {
+	var ids []uint64
+	err := svcCtx.Db.Model(&model.Block{}).Select("target_id").
+		Where(&model.Block{UserId: userid, Type: blockType}).
+		Order("create_time DESC").
+		Find(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	userList := make([]*user.User, 0, len(ids))
+	for _, id := range ids {
+		getUserLogic := NewGetUserLogic(ctx, svcCtx)
+		u, err := getUserLogic.GetUser(&user.GetUserReq{
+			UserID:  strconv.FormatUint(userid, 10),
+			QueryID: strconv.FormatUint(id, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if u.User == nil { // 用户已不存在
+			continue
+		}
+		userList = append(userList, u.User)
+	}
+	return userList, nil
+}
@@ -0,0 +1,33 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+)
+
+// searchUserEvent 用户注册或修改昵称后将用户名与昵称写入 Kafka 搜索主题，由搜索服务更新索引
+// 因被举报而自动隐藏的账号修改昵称后仍不可见；已封禁的账号无法登录，不会修改昵称
+func searchUserEvent(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, username, nickname string) error {
+	hidden, err := model.IsReportHidden(svcCtx.Db, userid)
+	if err != nil {
+		return err
+	}
+	marshal, err := json.Marshal(&model.SearchEvent{
+		Type:    model.SearchEventUser,
+		Action:  model.SearchActionUpsert,
+		Id:      userid,
+		Text:    username + " " + nickname,
+		Visible: !hidden,
+	})
+	if err != nil {
+		return err
+	}
+	return svcCtx.SearchWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(userid, 10)),
+		Value: marshal,
+	})
+}
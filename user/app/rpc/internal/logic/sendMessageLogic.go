@@ -0,0 +1,132 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"encoding/json"
+	"github.com/ncghost1/snowflake-go"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendMessageLogic {
+	return &SendMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendMessage 发送私信，只允许在好友（互相关注）之间发送
+func (l *SendMessageLogic) SendMessage(in *user.SendMessageReq) (*user.SendMessageResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	toUserId, err := strconv.ParseUint(in.ToUserId, 10, 64)
+	if err != nil || userid == toUserId {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	// 1. 检查消息内容
+	if strings.TrimSpace(in.Content) == "" {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_MESSAGE_EMPTY_MSG,
+		}, nil
+	}
+	if utf8.RuneCountInString(in.Content) > l.svcCtx.Config.MessageConfig.MaxLength {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_MESSAGE_TOOLONG_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	// 2. 检查双方是否存在拉黑关系，以及是否为好友
+	blocked, err := isBlocked(l.svcCtx, conn, userid, toUserId)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_BLOCKED_MSG,
+		}, nil
+	}
+
+	ok, err := isFriend(l.svcCtx, conn, userid, toUserId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &user.SendMessageResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_NOT_FRIEND_MSG,
+		}, nil
+	}
+
+	// 3. 写入 DB
+	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+	if err != nil {
+		return nil, err
+	}
+	msgId, err := sf.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &model.Message{
+		Id:         msgId,
+		FromUserId: userid,
+		ToUserId:   toUserId,
+		Content:    in.Content,
+		CreateTime: time.Now().UnixMilli(),
+	}
+	err = l.svcCtx.Db.Create(msg).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 更新双方最新消息缓存
+	msgJson, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Redis.SetLatestMessage(conn, msg, msgJson)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. 推送给接收方，推送失败不影响发送结果
+	err = pushEvent(l.ctx, l.svcCtx, model.PushEventMessage, toUserId, msg)
+	if err != nil {
+		l.Errorf("push message event: %v", err)
+	}
+
+	return &user.SendMessageResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
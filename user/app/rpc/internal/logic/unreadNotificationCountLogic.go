@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnreadNotificationCountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnreadNotificationCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnreadNotificationCountLogic {
+	return &UnreadNotificationCountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnreadNotificationCount 获取用户各类型的未读通知数，聚合的通知只计为一条
+func (l *UnreadNotificationCountLogic) UnreadNotificationCount(in *user.UnreadNotificationCountReq) (*user.UnreadNotificationCountResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.UnreadNotificationCountResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	// 1. 查询缓存
+	counts, err := l.svcCtx.Redis.GetUnreadCount(conn, userid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 缓存未命中则到 DB 中分组统计并写入缓存
+	if counts == nil {
+		var rows []struct {
+			Type string
+			Cnt  int64
+		}
+		err = l.svcCtx.Db.Model(&model.Notification{}).Select("type, count(1) as cnt").
+			Where(&model.Notification{UserId: userid, IsRead: model.NotifyUnread}).
+			Group("type").Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		counts = make(map[string]int64, len(model.NotifyTypes))
+		for _, t := range model.NotifyTypes {
+			counts[t] = 0
+		}
+		for _, row := range rows {
+			counts[row.Type] = row.Cnt
+		}
+
+		err = l.svcCtx.Redis.SetUnreadCount(conn, userid, counts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var total int64
+	for _, v := range counts {
+		total += v
+	}
+
+	return &user.UnreadNotificationCountResp{
+		StatusCode:  STATUS_SUCCESS,
+		StatusMsg:   STATUS_SUCCESS_MSG,
+		Total:       total,
+		UnreadCount: counts,
+	}, nil
+}
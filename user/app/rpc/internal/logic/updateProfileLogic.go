@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/internal/svc"
+	"Mini-Tiktok/user/app/rpc/model"
+	"Mini-Tiktok/user/app/rpc/user"
+	"context"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateProfile 更新用户资料（昵称，头像，背景图，个性签名），未传入的字段保持不变
+// 头像与背景图为 api 层上传到对象存储后的地址
+func (l *UpdateProfileLogic) UpdateProfile(in *user.UpdateProfileReq) (*user.UpdateProfileResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &user.UpdateProfileResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	// 1. 校验参数并收集需要更新的列
+	columns := make(map[string]interface{})
+	fields := make(map[string]string)
+	if in.Nickname != nil {
+		if utf8.RuneCountInString(*in.Nickname) > NICKNAME_MAX_LENGTH {
+			return &user.UpdateProfileResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_NICKNAME_LONG_MSG,
+			}, nil
+		}
+		columns["nickname"] = *in.Nickname
+		fields[model.NicknameField] = *in.Nickname
+	}
+	if in.Signature != nil {
+		if utf8.RuneCountInString(*in.Signature) > SIGNATURE_MAX_LENGTH {
+			return &user.UpdateProfileResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_SIGNATURE_LONG_MSG,
+			}, nil
+		}
+		columns["signature"] = *in.Signature
+		fields[model.SignatureField] = *in.Signature
+	}
+	if in.Avatar != nil {
+		columns["avatar"] = *in.Avatar
+		fields[model.AvatarField] = *in.Avatar
+	}
+	if in.BackgroundImage != nil {
+		columns["background_image"] = *in.BackgroundImage
+		fields[model.BackgroundImageField] = *in.BackgroundImage
+	}
+
+	// 2. 更新 DB
+	if len(columns) > 0 {
+		db := l.svcCtx.Db.Model(&model.User{}).Where(&model.User{Id: userid}).Updates(columns)
+		if db.Error != nil {
+			return nil, db.Error
+		}
+	}
+
+	// 3. 更新缓存（缓存不存在时不写入，由下次查询时从 DB 加载）
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	err = l.svcCtx.Redis.UpdateProfile(conn, userid, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 返回更新后的用户信息
+	getUserLogic := NewGetUserLogic(l.ctx, l.svcCtx)
+	u, err := getUserLogic.GetUser(&user.GetUserReq{
+		UserID:  in.UserId,
+		QueryID: in.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 昵称修改后更新搜索索引，失败只记录日志
+	if in.Nickname != nil && u.User != nil {
+		err = searchUserEvent(l.ctx, l.svcCtx, userid, u.User.Name, u.User.Nickname)
+		if err != nil {
+			l.Errorf("write search event: %v", err)
+		}
+	}
+
+	return &user.UpdateProfileResp{
+		StatusCode: u.StatusCode,
+		StatusMsg:  u.StatusMsg,
+		User:       u.User,
+	}, nil
+}
@@ -4,10 +4,16 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-// BcryptHash 使用 bcrypt 对密码进行加密
-func BcryptHash(password string) string {
-	bytes, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	return string(bytes)
+// BcryptHash 使用 bcrypt 对密码进行加密，cost 不在合法范围内时使用默认值
+func BcryptHash(password string, cost int) (string, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
 }
 
 // BcryptCheck 对比明文密码与哈希值是否相等
@@ -15,3 +21,15 @@ func BcryptCheck(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// BcryptNeedRehash 判断存储的哈希值 cost 是否低于当前配置的 cost，低于则需要重新哈希
+func BcryptNeedRehash(hash string, cost int) bool {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	hashCost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return false
+	}
+	return hashCost < cost
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const (
+	PASSWORD_TOO_SHORT_MSG     = "Password is too short"
+	PASSWORD_TOO_LONG_MSG      = "Password is too long"
+	PASSWORD_NEED_UPPER_MSG    = "Password must contain an uppercase letter"
+	PASSWORD_NEED_LOWER_MSG    = "Password must contain a lowercase letter"
+	PASSWORD_NEED_DIGIT_MSG    = "Password must contain a digit"
+	PASSWORD_NEED_SYMBOL_MSG   = "Password must contain a special character"
+	PASSWORD_BREACHED_MSG      = "Password has appeared in a data breach, please choose another one"
+	BCRYPT_MAX_PASSWORD_LENGTH = 72 // bcrypt 只会使用密码的前 72 字节
+)
+
+// PasswordPolicy 密码策略
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	breached      map[string]struct{} // 已泄露密码集合
+}
+
+// NewPasswordPolicy 新建密码策略，breachedListPath 不为空时从本地文件加载已泄露密码列表（每行一个密码）
+func NewPasswordPolicy(minLength, maxLength int, upper, lower, digit, symbol bool, breachedListPath string) (*PasswordPolicy, error) {
+	if maxLength <= 0 || maxLength > BCRYPT_MAX_PASSWORD_LENGTH {
+		maxLength = BCRYPT_MAX_PASSWORD_LENGTH
+	}
+	p := &PasswordPolicy{
+		MinLength:     minLength,
+		MaxLength:     maxLength,
+		RequireUpper:  upper,
+		RequireLower:  lower,
+		RequireDigit:  digit,
+		RequireSymbol: symbol,
+		breached:      make(map[string]struct{}),
+	}
+	if breachedListPath == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(breachedListPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.breached[line] = struct{}{}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Check 检查密码是否满足策略，不满足时返回的 error 信息可直接响应给客户端
+func (p *PasswordPolicy) Check(password string) error {
+	if len(password) < p.MinLength {
+		return errors.New(PASSWORD_TOO_SHORT_MSG)
+	}
+	if len(password) > p.MaxLength {
+		return errors.New(PASSWORD_TOO_LONG_MSG)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		return errors.New(PASSWORD_NEED_UPPER_MSG)
+	}
+	if p.RequireLower && !hasLower {
+		return errors.New(PASSWORD_NEED_LOWER_MSG)
+	}
+	if p.RequireDigit && !hasDigit {
+		return errors.New(PASSWORD_NEED_DIGIT_MSG)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		return errors.New(PASSWORD_NEED_SYMBOL_MSG)
+	}
+
+	if _, ok := p.breached[password]; ok {
+		return errors.New(PASSWORD_BREACHED_MSG)
+	}
+	return nil
+}
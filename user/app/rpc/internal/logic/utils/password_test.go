@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	breached := filepath.Join(t.TempDir(), "breached")
+	err := os.WriteFile(breached, []byte("# Leaked1\n\nPassw0rd!\n  Qwerty123$  \n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strict, err := NewPasswordPolicy(8, 16, true, true, true, true, breached)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loose, err := NewPasswordPolicy(6, 0, false, false, false, false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		policy   *PasswordPolicy
+		password string
+		msg      string // 为空表示检查通过
+	}{
+		{"strict ok", strict, "Abcdef1!", ""},
+		{"too short", strict, "Ab1!", PASSWORD_TOO_SHORT_MSG},
+		{"too long", strict, "Abcdefgh12345678!", PASSWORD_TOO_LONG_MSG},
+		{"max length", strict, "Abcdefgh1234567!", ""},
+		{"no upper", strict, "abcdef1!", PASSWORD_NEED_UPPER_MSG},
+		{"no lower", strict, "ABCDEF1!", PASSWORD_NEED_LOWER_MSG},
+		{"no digit", strict, "Abcdefg!", PASSWORD_NEED_DIGIT_MSG},
+		{"no symbol", strict, "Abcdefg1", PASSWORD_NEED_SYMBOL_MSG},
+		{"symbol category", strict, "Abcdef1+", ""},
+		{"breached", strict, "Passw0rd!", PASSWORD_BREACHED_MSG},
+		{"breached line is trimmed", strict, "Qwerty123$", PASSWORD_BREACHED_MSG},
+		{"comment line is not a password", strict, "# Leaked1", ""},
+		{"loose ok", loose, "abcdef", ""},
+		{"loose too short", loose, "abcde", PASSWORD_TOO_SHORT_MSG},
+		{"max length defaults to bcrypt limit", loose, strings.Repeat("a", BCRYPT_MAX_PASSWORD_LENGTH), ""},
+		{"longer than bcrypt limit", loose, strings.Repeat("a", BCRYPT_MAX_PASSWORD_LENGTH+1), PASSWORD_TOO_LONG_MSG},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.policy.Check(c.password)
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if msg != c.msg {
+				t.Errorf("Check(%q) = %q, want %q", c.password, msg, c.msg)
+			}
+		})
+	}
+}
+
+func TestNewPasswordPolicyMissingList(t *testing.T) {
+	_, err := NewPasswordPolicy(8, 16, false, false, false, false, filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Errorf("missing breached list should fail")
+	}
+}
+
+func TestBcryptNeedRehash(t *testing.T) {
+	hash := func(cost int) string {
+		b, err := bcrypt.GenerateFromPassword([]byte("Abcdef1!"), cost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+	minHash := hash(bcrypt.MinCost)
+	defaultHash := hash(bcrypt.DefaultCost)
+
+	cases := []struct {
+		name   string
+		hash   string
+		cost   int
+		rehash bool
+	}{
+		{"lower cost", minHash, bcrypt.MinCost + 1, true},
+		{"same cost", minHash, bcrypt.MinCost, false},
+		{"higher cost", defaultHash, bcrypt.MinCost, false},
+		{"invalid cost uses default", minHash, 0, true},
+		{"invalid cost with default hash", defaultHash, bcrypt.MaxCost + 1, false},
+		{"not a bcrypt hash", "plaintext", bcrypt.DefaultCost, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if rehash := BcryptNeedRehash(c.hash, c.cost); rehash != c.rehash {
+				t.Errorf("BcryptNeedRehash(cost %d) = %v, want %v", c.cost, rehash, c.rehash)
+			}
+		})
+	}
+}
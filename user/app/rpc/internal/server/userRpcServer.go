@@ -51,3 +51,68 @@ func (s *UserRpcServer) FollowerList(ctx context.Context, in *user.FollowerListR
 	l := logic.NewFollowerListLogic(ctx, s.svcCtx)
 	return l.FollowerList(in)
 }
+
+func (s *UserRpcServer) ChangePassword(ctx context.Context, in *user.ChangePasswordReq) (*user.ChangePasswordResp, error) {
+	l := logic.NewChangePasswordLogic(ctx, s.svcCtx)
+	return l.ChangePassword(in)
+}
+
+func (s *UserRpcServer) UpdateProfile(ctx context.Context, in *user.UpdateProfileReq) (*user.UpdateProfileResp, error) {
+	l := logic.NewUpdateProfileLogic(ctx, s.svcCtx)
+	return l.UpdateProfile(in)
+}
+
+func (s *UserRpcServer) FriendList(ctx context.Context, in *user.FriendListReq) (*user.FriendListResp, error) {
+	l := logic.NewFriendListLogic(ctx, s.svcCtx)
+	return l.FriendList(in)
+}
+
+func (s *UserRpcServer) SendMessage(ctx context.Context, in *user.SendMessageReq) (*user.SendMessageResp, error) {
+	l := logic.NewSendMessageLogic(ctx, s.svcCtx)
+	return l.SendMessage(in)
+}
+
+func (s *UserRpcServer) ListMessages(ctx context.Context, in *user.ListMessagesReq) (*user.ListMessagesResp, error) {
+	l := logic.NewListMessagesLogic(ctx, s.svcCtx)
+	return l.ListMessages(in)
+}
+
+func (s *UserRpcServer) ListNotifications(ctx context.Context, in *user.ListNotificationsReq) (*user.ListNotificationsResp, error) {
+	l := logic.NewListNotificationsLogic(ctx, s.svcCtx)
+	return l.ListNotifications(in)
+}
+
+func (s *UserRpcServer) MarkNotificationsRead(ctx context.Context, in *user.MarkNotificationsReadReq) (*user.MarkNotificationsReadResp, error) {
+	l := logic.NewMarkNotificationsReadLogic(ctx, s.svcCtx)
+	return l.MarkNotificationsRead(in)
+}
+
+func (s *UserRpcServer) UnreadNotificationCount(ctx context.Context, in *user.UnreadNotificationCountReq) (*user.UnreadNotificationCountResp, error) {
+	l := logic.NewUnreadNotificationCountLogic(ctx, s.svcCtx)
+	return l.UnreadNotificationCount(in)
+}
+
+func (s *UserRpcServer) BlockAction(ctx context.Context, in *user.BlockActionReq) (*user.BlockActionResp, error) {
+	l := logic.NewBlockActionLogic(ctx, s.svcCtx)
+	return l.BlockAction(in)
+}
+
+func (s *UserRpcServer) BlockList(ctx context.Context, in *user.BlockListReq) (*user.BlockListResp, error) {
+	l := logic.NewBlockListLogic(ctx, s.svcCtx)
+	return l.BlockList(in)
+}
+
+func (s *UserRpcServer) MuteAction(ctx context.Context, in *user.MuteActionReq) (*user.MuteActionResp, error) {
+	l := logic.NewMuteActionLogic(ctx, s.svcCtx)
+	return l.MuteAction(in)
+}
+
+func (s *UserRpcServer) MuteList(ctx context.Context, in *user.MuteListReq) (*user.MuteListResp, error) {
+	l := logic.NewMuteListLogic(ctx, s.svcCtx)
+	return l.MuteList(in)
+}
+
+func (s *UserRpcServer) GetRelations(ctx context.Context, in *user.RelationsReq) (*user.RelationsResp, error) {
+	l := logic.NewGetRelationsLogic(ctx, s.svcCtx)
+	return l.GetRelations(in)
+}
@@ -1,17 +1,25 @@
 package svc
 
 import (
+	"Mini-Tiktok/common/moderation"
 	"Mini-Tiktok/user/app/rpc/internal/config"
+	"Mini-Tiktok/user/app/rpc/internal/logic/utils"
 	"Mini-Tiktok/user/app/rpc/model"
 	"Mini-Tiktok/user/app/rpc/model/redisCache"
+	"github.com/segmentio/kafka-go"
 	"gorm.io/gorm"
 	"log"
+	"time"
 )
 
 type ServiceContext struct {
-	Config config.Config
-	Redis  *redisCache.RedisPool
-	Db     *gorm.DB
+	Config         config.Config
+	Redis          *redisCache.RedisPool
+	Db             *gorm.DB
+	PasswordPolicy *utils.PasswordPolicy
+	PushWriter     *kafka.Writer
+	SearchWriter   *kafka.Writer
+	Moderation     *moderation.Filter
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -30,9 +38,51 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		return nil
 	}
 
+	pc := c.PasswordPolicy
+	policy, err := utils.NewPasswordPolicy(pc.MinLength, pc.MaxLength,
+		pc.RequireUpper, pc.RequireLower, pc.RequireDigit, pc.RequireSymbol, pc.BreachedListPath)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	filter, err := moderation.NewFilter(c.Moderation)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	searchWriter := getKafkaWriter(c.SearchConfig.Host,
+		c.SearchConfig.Topic,
+		c.SearchConfig.BatchTimeout,
+		c.SearchConfig.BatchSize,
+		c.SearchConfig.BatchBytes,
+	)
+	searchWriter.Balancer = &kafka.Hash{} // 按文档 id 分区，保证同一文档的事件有序
+
 	return &ServiceContext{
-		Config: c,
-		Redis:  pool,
-		Db:     db,
+		Config:         c,
+		Redis:          pool,
+		Db:             db,
+		PasswordPolicy: policy,
+		Moderation:     filter,
+		PushWriter: getKafkaWriter(c.PushConfig.Host,
+			c.PushConfig.Topic,
+			c.PushConfig.BatchTimeout,
+			c.PushConfig.BatchSize,
+			c.PushConfig.BatchBytes,
+		),
+		SearchWriter: searchWriter,
+	}
+}
+
+func getKafkaWriter(host, topic string, timeout int, size int, bytes int64) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(host),
+		Topic:        topic,
+		Balancer:     &kafka.LeastBytes{},
+		BatchTimeout: time.Millisecond * time.Duration(timeout),
+		BatchSize:    size,
+		BatchBytes:   bytes,
 	}
 }
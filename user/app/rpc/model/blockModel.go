@@ -0,0 +1,67 @@
+package model
+
+import (
+	"gorm.io/gorm"
+	"strconv"
+)
+
+// Block 表结构，用户拉黑或屏蔽目标用户的记录
+// 拉黑是双向的：双方互相看不到对方的视频与评论，解除关注关系且不能再关注或私信对方
+// 屏蔽是单向的：只是用户自己看不到目标用户的视频与评论
+type Block struct {
+	UserId     uint64 `json:"user_id" gorm:"column:user_id"`
+	TargetId   uint64 `json:"target_id" gorm:"column:target_id"`
+	Type       string `json:"type" gorm:"column:type"` // 1-拉黑，2-屏蔽
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"`
+}
+
+const (
+	BlockTypeBlock = "1"
+	BlockTypeMute  = "2"
+
+	BlockCacheKeyPrefix = "Block:UserId:BlockId:SET:"
+	MuteCacheKeyPrefix  = "Block:UserId:MuteId:SET:"
+
+	BlockCacheEmptyMember = 0 // 缓存集合中的占位成员，用于区分空集合与缓存不存在
+)
+
+func (Block) TableName() string {
+	return "block"
+}
+
+// BlockCacheKey 返回与用户存在拉黑关系的用户 id 集合的缓存 key 名称，
+// 缓存类型为 set 类型，key: UserId:BlockId:SET:{用户id}, member: 用户拉黑的以及拉黑了该用户的用户id
+// 集合中总是包含占位成员 0，拉黑关系变化时删除双方的缓存，由下次查询时从 DB 加载
+func (Block) BlockCacheKey(userid uint64) string {
+	return BlockCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
+
+// MuteCacheKey 返回用户屏蔽的用户 id 集合的缓存 key 名称，
+// 缓存类型为 set 类型，key: UserId:MuteId:SET:{用户id}, member: 用户屏蔽的用户id
+// 集合中总是包含占位成员 0，屏蔽关系变化时删除用户的缓存，由下次查询时从 DB 加载
+func (Block) MuteCacheKey(userid uint64) string {
+	return MuteCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
+
+// BlockIdList 从 DB 查询与用户存在拉黑关系（任意一方拉黑另一方）的用户 id 列表
+func BlockIdList(db *gorm.DB, userid uint64) ([]uint64, error) {
+	var ids []uint64
+	err := db.Raw("SELECT target_id FROM block WHERE user_id = ? AND type = ? "+
+		"UNION SELECT user_id FROM block WHERE target_id = ? AND type = ?",
+		userid, BlockTypeBlock, userid, BlockTypeBlock).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// MuteIdList 从 DB 查询用户屏蔽的用户 id 列表
+func MuteIdList(db *gorm.DB, userid uint64) ([]uint64, error) {
+	var ids []uint64
+	err := db.Model(&Block{}).Select("target_id").
+		Where(&Block{UserId: userid, Type: BlockTypeMute}).Find(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,29 @@
+package model
+
+import "gorm.io/gorm"
+
+// Favorite 表结构（由视频服务维护，用户服务只读取其中用于统计点赞数与获赞数的列）
+type Favorite struct {
+	UserId  uint64 `gorm:"column:user_id"`
+	VideoId uint64 `gorm:"column:video_id"`
+}
+
+func (Favorite) TableName() string {
+	return "favorite"
+}
+
+// CountFavorite 统计用户点赞的视频数
+func CountFavorite(db *gorm.DB, userid uint64) (int64, error) {
+	var cnt int64
+	err := db.Model(&Favorite{}).Where(&Favorite{UserId: userid}).Count(&cnt).Error
+	return cnt, err
+}
+
+// CountTotalFavorited 统计用户所有作品的获赞总数
+func CountTotalFavorited(db *gorm.DB, userid uint64) (int64, error) {
+	var cnt int64
+	err := db.Model(&Favorite{}).
+		Joins("JOIN video ON video.id = favorite.video_id").
+		Where("video.user_id = ?", userid).Count(&cnt).Error
+	return cnt, err
+}
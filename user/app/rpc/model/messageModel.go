@@ -0,0 +1,35 @@
+package model
+
+import "strconv"
+
+// Message 表结构
+type Message struct {
+	Id         uint64 `json:"id" gorm:"column:id"`
+	FromUserId uint64 `json:"from_user_id" gorm:"column:from_user_id"`
+	ToUserId   uint64 `json:"to_user_id" gorm:"column:to_user_id"`
+	Content    string `json:"content" gorm:"column:content"`
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"` // 毫秒时间戳，用于客户端轮询时的游标
+}
+
+const (
+	LatestMessageCacheKeyPrefix = "Msg:ChatId:LatestMessage:"
+)
+
+func (Message) TableName() string {
+	return "message"
+}
+
+// ChatId 返回两个用户之间会话的 id，与双方顺序无关，格式: {较小用户id}_{较大用户id}
+func (Message) ChatId(userid, toUserId uint64) string {
+	if userid > toUserId {
+		userid, toUserId = toUserId, userid
+	}
+	return strconv.FormatUint(userid, 10) + "_" + strconv.FormatUint(toUserId, 10)
+}
+
+// LatestMessageCacheKey 返回两个用户之间最新一条消息的缓存 key 名称，
+// 缓存类型为 string 类型，key: ChatId:LatestMessage:{会话id} value: 消息 json
+// 用于好友列表中展示最新消息，默认过期时间：12h
+func (Message) LatestMessageCacheKey(userid, toUserId uint64) string {
+	return LatestMessageCacheKeyPrefix + Message{}.ChatId(userid, toUserId)
+}
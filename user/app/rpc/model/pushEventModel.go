@@ -0,0 +1,19 @@
+package model
+
+const (
+	PushEventMessage = "message" // 收到新私信
+	PushEventFollow  = "follow"  // 新增粉丝
+)
+
+// PushEvent 写入 Kafka 推送主题的事件，由 api 网关通过 WebSocket 推送给 UserId 对应的用户
+type PushEvent struct {
+	Type       string      `json:"type"`        // 事件类型
+	UserId     uint64      `json:"user_id"`     // 接收事件的用户 id
+	Data       interface{} `json:"data"`        // 事件内容
+	CreateTime int64       `json:"create_time"` // 事件产生时间（毫秒时间戳）
+}
+
+// FollowEventData 新增粉丝事件内容
+type FollowEventData struct {
+	FollowerId uint64 `json:"follower_id"` // 新粉丝的用户 id
+}
@@ -192,17 +192,22 @@ func (p *RedisPool) GetUsername(conn redis.Conn, userid uint64) (string, error)
 	return username, err
 }
 
-func (p *RedisPool) SetUserInfo(conn redis.Conn, userid uint64, username string, followCount int64, followerCount int64) error {
+// SetUserInfo 写入用户信息缓存，已存在的 field 不会被覆盖
+// 用户关注数，粉丝数超过 cacheConfig.FOLLOW_COUNT_THRESHOLD 配置数值的用户信息缓存将不会过期
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetUserInfo(conn redis.Conn, userid uint64, info *model.UserInfo) error {
 	_, err := conn.Do("EVAL",
-		"redis.call('HSETNX', KEYS[1], ARGV[4], ARGV[1]; "+
-		"redis.call('HSETNX', KEYS[1], ARGV[5], ARGV[2]);"+
-		"redis.call('HSETNX', KEYS[1], ARGV[6], ARGV[3]);"+
-		"if (tonumber(ARGV[2]) >= tonumber(ARGV[8]) or tonumber(ARGV[3]) >= tonumber(ARGV[8])) then "+
-		"redis.call('PERSIST', KEYS[1]); "+
-		"else redis.call('EXPIRE', KEYS[1], ARGV[7]); end; "+
-		"return nil;",
-		1, model.User{}.CacheKey(userid), username, followCount, followerCount,
-		model.UsernameField, model.FollowCountField, model.FollowerCountField,
+		"for i = 1, 10 do "+
+			"redis.call('HSETNX', KEYS[1], ARGV[i], ARGV[i + 10]); end; "+
+			"if (tonumber(ARGV[16]) >= tonumber(ARGV[22]) or tonumber(ARGV[17]) >= tonumber(ARGV[22])) then "+
+			"redis.call('PERSIST', KEYS[1]); "+
+			"else redis.call('EXPIRE', KEYS[1], ARGV[21]); end; "+
+			"return nil;",
+		1, model.User{}.CacheKey(userid),
+		model.UsernameField, model.NicknameField, model.AvatarField, model.BackgroundImageField, model.SignatureField,
+		model.FollowCountField, model.FollowerCountField, model.TotalFavoritedField, model.WorkCountField, model.FavoriteCountField,
+		info.Username, info.Nickname, info.Avatar, info.BackgroundImage, info.Signature,
+		info.FollowCount, info.FollowerCount, info.TotalFavorited, info.WorkCount, info.FavoriteCount,
 		cacheConfig.USER_CACHE_TTL, cacheConfig.FOLLOW_COUNT_THRESHOLD)
 	if err != nil {
 		return err
@@ -210,58 +215,89 @@ func (p *RedisPool) SetUserInfo(conn redis.Conn, userid uint64, username string,
 	return nil
 }
 
-// GetUserInfo 获取用户名，关注数，粉丝数信息
-// 将用户id，用户名，关注数，点赞数均以 string 表示返回
-// 用户关注数，点赞数超过 cacheConfig.FOLLOW_COUNT_THRESHOLD 配置数值的用户信息缓存将不会过期
-func (p *RedisPool) GetUserInfo(conn redis.Conn, userid uint64) (username string, followCount int64, followerCount int64, err error) {
+// UpdateProfile 更新用户资料缓存，仅在缓存存在时写入 fields 中的 field
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) UpdateProfile(conn redis.Conn, userid uint64, fields map[string]string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	args := []interface{}{"if (redis.call('EXISTS', KEYS[1]) ~= 1) then " +
+		"return nil; end; " +
+		"redis.call('HSET', KEYS[1], unpack(ARGV)); " +
+		"return nil;", 1, model.User{}.CacheKey(userid)}
+	for field, value := range fields {
+		args = append(args, field, value)
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetUserInfo 获取用户名，用户资料，关注数，粉丝数，获赞数，作品数，点赞数信息
+// 缓存不存在时返回 CACHE_KEY_NOT_EXISTS_MSG，资料 field 缺失时 Username 为空，计数 field 缺失时为 COUNT_NOT_FOUND
+// 用户关注数，粉丝数超过 cacheConfig.FOLLOW_COUNT_THRESHOLD 配置数值的用户信息缓存将不会过期
+func (p *RedisPool) GetUserInfo(conn redis.Conn, userid uint64) (*model.UserInfo, error) {
+	info := &model.UserInfo{
+		FollowCount:    COUNT_NOT_FOUND,
+		FollowerCount:  COUNT_NOT_FOUND,
+		TotalFavorited: COUNT_NOT_FOUND,
+		WorkCount:      COUNT_NOT_FOUND,
+		FavoriteCount:  COUNT_NOT_FOUND,
+	}
+
 	raw, err := conn.Do("EVAL", "if (redis.call('EXISTS',KEYS[1]) ~= 1) then "+
 		"return nil; end; "+
-		"local cnt = redis.call('HGET', KEYS[1], ARGV[2]); "+
-		"local cnt2 = redis.call('HGET', KEYS[1], ARGV[3]); "+
-		"if (tonumber(cnt) >= tonumber(ARGV[4]) or tonumber(cnt2) >= tonumber(ARGV[4])) then "+
+		"local cnt = redis.call('HGET', KEYS[1], ARGV[6]); "+
+		"local cnt2 = redis.call('HGET', KEYS[1], ARGV[7]); "+
+		"if (tonumber(cnt or 0) >= tonumber(ARGV[11]) or tonumber(cnt2 or 0) >= tonumber(ARGV[11])) then "+
 		"redis.call('PERSIST', KEYS[1]); "+
-		"else redis.call('EXPIRE', KEYS[1], ARGV[5]); end; "+
-		"return redis.call('HMGET',KEYS[1],ARGV[1],ARGV[2],ARGV[3]); ", 1, model.User{}.CacheKey(userid),
-		model.UsernameField, model.FollowCountField, model.FollowerCountField,
+		"else redis.call('EXPIRE', KEYS[1], ARGV[12]); end; "+
+		"return redis.call('HMGET', KEYS[1], ARGV[1], ARGV[2], ARGV[3], ARGV[4], ARGV[5], "+
+		"ARGV[6], ARGV[7], ARGV[8], ARGV[9], ARGV[10]); ", 1, model.User{}.CacheKey(userid),
+		model.UsernameField, model.NicknameField, model.AvatarField, model.BackgroundImageField, model.SignatureField,
+		model.FollowCountField, model.FollowerCountField, model.TotalFavoritedField, model.WorkCountField, model.FavoriteCountField,
 		cacheConfig.FOLLOW_COUNT_THRESHOLD, cacheConfig.USER_CACHE_TTL)
-
 	if err != nil {
-		return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, err
+		return info, err
 	}
 
-	if raw == nil {
-		return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, err
+	r, ok := raw.([]interface{})
+	if !ok || len(r) != 10 {
+		return info, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
 	}
 
-	if r, ok := raw.([]interface{}); ok {
-		nameBytes, ok := r[0].([]byte)
+	// 资料 field 任一缺失则视为资料未缓存
+	profile := make([]string, 5)
+	for i := 0; i < 5; i++ {
+		b, ok := r[i].([]byte)
 		if !ok {
-			return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
-		}
-
-		username = string(nameBytes)
-
-		followCntBytes, ok := r[1].([]byte)
-		if !ok {
-			return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
-		}
-
-		followCount, err = strconv.ParseInt(string(followCntBytes), 10, 64)
-		if err != nil {
-			return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
+			profile = nil
+			break
 		}
+		profile[i] = string(b)
+	}
+	if profile != nil {
+		info.Username = profile[0]
+		info.Nickname = profile[1]
+		info.Avatar = profile[2]
+		info.BackgroundImage = profile[3]
+		info.Signature = profile[4]
+	}
 
-		followerCntBytes, ok := r[2].([]byte)
+	counts := []*int64{&info.FollowCount, &info.FollowerCount, &info.TotalFavorited, &info.WorkCount, &info.FavoriteCount}
+	for i, cnt := range counts {
+		b, ok := r[i+5].([]byte)
 		if !ok {
-			return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
+			continue
 		}
-
-		followerCount, err = strconv.ParseInt(string(followerCntBytes), 10, 64)
-		if err != nil {
-			return "", COUNT_NOT_FOUND, COUNT_NOT_FOUND, errors.New(CACHE_KEY_NOT_EXISTS_MSG)
+		if v, err := strconv.ParseInt(string(b), 10, 64); err == nil {
+			*cnt = v
 		}
 	}
-	return
+
+	return info, nil
 }
 
 // getUserList GetFollowUser 和 GetFollowerUser 通用 Redis 查询方法
@@ -411,6 +447,143 @@ func (p *RedisPool) RemFollowUserList(conn redis.Conn, userid, toUserId uint64)
 	return p.remExFolUserList(conn, UserCacheKey, ToUserCacheKey, userid, toUserId, cacheConfig.FOLLOW_CACHE_TTL)
 }
 
+// IsFriend 从缓存中判断 userid 与 toUserId 是否互相关注
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) IsFriend(conn redis.Conn, userid, toUserId uint64) (bool, error) {
+	raw, err := conn.Do("EVAL",
+		"if((redis.call('ZRANK', KEYS[1], ARGV[2]) ~= false or redis.call('ZRANK', KEYS[4], ARGV[1]) ~= false) "+
+			"and (redis.call('ZRANK', KEYS[3], ARGV[1]) ~= false or redis.call('ZRANK', KEYS[2], ARGV[2]) ~= false)) "+
+			"then return 1; "+
+			"else return nil; end; ", 4,
+		model.Follow{}.FollowListCacheKey(userid), model.Follow{}.FollowerListCacheKey(userid),
+		model.Follow{}.FollowListCacheKey(toUserId), model.Follow{}.FollowerListCacheKey(toUserId),
+		userid, toUserId)
+	if err != nil {
+		return false, err
+	}
+	if raw != nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+// SetLatestMessage 更新两个用户之间最新一条消息的缓存，只有比缓存中更新的消息才会写入
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetLatestMessage(conn redis.Conn, msg *model.Message, msgJson []byte) error {
+	_, err := conn.Do("EVAL", "local old = redis.call('GET', KEYS[1]); "+
+		"if (old ~= false and tonumber(cjson.decode(old)['create_time']) > tonumber(ARGV[2])) then "+
+		"return nil; end; "+
+		"redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[3]); "+
+		"return nil; ", 1, model.Message{}.LatestMessageCacheKey(msg.FromUserId, msg.ToUserId),
+		msgJson, msg.CreateTime, cacheConfig.MESSAGE_CACHE_TTL)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetLatestMessages 批量获取用户与多个好友之间最新一条消息的缓存，返回值与 friendIds 一一对应，缓存不存在的为 nil
+func (p *RedisPool) GetLatestMessages(conn redis.Conn, userid uint64, friendIds []uint64) ([][]byte, error) {
+	if len(friendIds) == 0 {
+		return nil, nil
+	}
+	keys := make([]interface{}, len(friendIds))
+	for i, id := range friendIds {
+		keys[i] = model.Message{}.LatestMessageCacheKey(userid, id)
+	}
+	return redis.ByteSlices(conn.Do("MGET", keys...))
+}
+
+// GetUnreadCount 获取用户各类型的未读通知数缓存，缓存不存在时返回 nil
+func (p *RedisPool) GetUnreadCount(conn redis.Conn, userid uint64) (map[string]int64, error) {
+	raw, err := redis.Int64Map(conn.Do("HGETALL", model.Notification{}.UnreadCountCacheKey(userid)))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	return raw, nil
+}
+
+// SetUnreadCount 设置用户各类型的未读通知数缓存，counts 中需要包含所有通知类型
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetUnreadCount(conn redis.Conn, userid uint64, counts map[string]int64) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1]); " +
+		"for i = 2, #ARGV, 2 do redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1]); end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"return nil; ", 1, model.Notification{}.UnreadCountCacheKey(userid), cacheConfig.NOTIFY_UNREAD_CACHE_TTL}
+	for k, v := range counts {
+		args = append(args, k, v)
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelUnreadCount 删除用户的未读通知数缓存，用于标记已读后由下次查询从 DB 重新加载
+func (p *RedisPool) DelUnreadCount(conn redis.Conn, userid uint64) error {
+	_, err := conn.Do("DEL", model.Notification{}.UnreadCountCacheKey(userid))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsBlocked 从缓存中判断两个用户之间是否存在拉黑关系，缓存不存在时 exists 返回 false
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) IsBlocked(conn redis.Conn, userid, toUserId uint64) (blocked bool, exists bool, err error) {
+	res, err := redis.Int(conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) == 0) then return -1; end; "+
+		"return redis.call('SISMEMBER', KEYS[1], ARGV[1]); ", 1, model.Block{}.BlockCacheKey(userid), toUserId))
+	if err != nil {
+		return false, false, err
+	}
+	if res == -1 {
+		return false, false, nil
+	}
+	return res == 1, true, nil
+}
+
+// SetBlockCache 设置用户的拉黑与屏蔽用户 id 集合缓存，两个集合都会加入占位成员 0 以区分空集合与缓存不存在
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetBlockCache(conn redis.Conn, userid uint64, blockIds, muteIds []uint64, ttl int) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1], KEYS[2]); " +
+		"redis.call('SADD', KEYS[1], ARGV[3]); " +
+		"redis.call('SADD', KEYS[2], ARGV[3]); " +
+		"local n = tonumber(ARGV[2]); " +
+		"for i = 4, #ARGV do " +
+		"if (i - 3 <= n) then redis.call('SADD', KEYS[1], ARGV[i]); " +
+		"else redis.call('SADD', KEYS[2], ARGV[i]); end; end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"redis.call('EXPIRE', KEYS[2], ARGV[1]); " +
+		"return nil; ", 2, model.Block{}.BlockCacheKey(userid), model.Block{}.MuteCacheKey(userid),
+		ttl, len(blockIds), model.BlockCacheEmptyMember}
+	for _, id := range blockIds {
+		args = append(args, id)
+	}
+	for _, id := range muteIds {
+		args = append(args, id)
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelBlockCache 删除双方的拉黑与屏蔽缓存，用于拉黑或屏蔽关系变化后由下次查询从 DB 重新加载
+// （拉黑与屏蔽缓存总是一起加载，所以需要一起删除）
+func (p *RedisPool) DelBlockCache(conn redis.Conn, userid, toUserId uint64) error {
+	_, err := conn.Do("DEL", model.Block{}.BlockCacheKey(userid), model.Block{}.MuteCacheKey(userid),
+		model.Block{}.BlockCacheKey(toUserId), model.Block{}.MuteCacheKey(toUserId))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // InitRedis 通过从 DB 获取数据初始化 redis（缓存预热）
 func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 	// 从 DB 中获取数据
@@ -443,23 +616,41 @@ func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 	}
 
 	// 初始化缓存
-	var FollowerCount int64  // 粉丝数
-	var FollowCount int64    // 关注数
 	conn := p.NewRedisConn() // Redis Conn
 	defer conn.Close()
 
 	for _, user := range UserList {
-		err = db.Model(&model.Follow{}).Where(&model.Follow{Follower: user.Id}).Count(&FollowCount).Error
+		info := &model.UserInfo{
+			Username:        user.Username,
+			Nickname:        user.Nickname,
+			Avatar:          user.Avatar,
+			BackgroundImage: user.BackgroundImage,
+			Signature:       user.Signature,
+		}
+
+		// 关注数
+		err = db.Model(&model.Follow{}).Where(&model.Follow{Follower: user.Id}).Count(&info.FollowCount).Error
 		if err != nil {
 			return err
 		}
 
-		err = db.Model(&model.Follow{}).Where(&model.Follow{Following: user.Id}).Count(&FollowerCount).Error
+		// 粉丝数
+		err = db.Model(&model.Follow{}).Where(&model.Follow{Following: user.Id}).Count(&info.FollowerCount).Error
 		if err != nil {
 			return err
 		}
 
-		err = p.SetUserInfo(conn, user.Id, user.Username, FollowCount, FollowerCount)
+		if info.TotalFavorited, err = model.CountTotalFavorited(db, user.Id); err != nil {
+			return err
+		}
+		if info.WorkCount, err = model.CountWork(db, user.Id); err != nil {
+			return err
+		}
+		if info.FavoriteCount, err = model.CountFavorite(db, user.Id); err != nil {
+			return err
+		}
+
+		err = p.SetUserInfo(conn, user.Id, info)
 		if err != nil {
 			return err
 		}
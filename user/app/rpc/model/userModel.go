@@ -4,16 +4,47 @@ import "strconv"
 
 // User 表结构
 type User struct {
-	Id       uint64 `gorm:"column:id"`
-	Username string `gorm:"column:username"`
-	Password string `gorm:"column:password"`
+	Id              uint64 `gorm:"column:id"`
+	Username        string `gorm:"column:username"`
+	Password        string `gorm:"column:password"`
+	Nickname        string `gorm:"column:nickname"`
+	Avatar          string `gorm:"column:avatar"`
+	BackgroundImage string `gorm:"column:background_image"`
+	Signature       string `gorm:"column:signature"`
+	Role            string `gorm:"column:role"`   // 0-普通用户，1-审核员，2-管理员
+	Status          string `gorm:"column:status"` // 0-正常，1-已封禁
+}
+
+// UserInfo 用户信息缓存内容，与 User 缓存 Hash 中的各个 field 一一对应
+// 计数值在缓存中不存在时为 -1
+type UserInfo struct {
+	Username        string
+	Nickname        string
+	Avatar          string
+	BackgroundImage string
+	Signature       string
+	FollowCount     int64
+	FollowerCount   int64
+	TotalFavorited  int64
+	WorkCount       int64
+	FavoriteCount   int64
 }
 
 const (
-	UserCacheKeyPrefix = "User:Userid:UserInfo:Hash"
-	UsernameField      = "username"
-	FollowCountField   = "followCount"
-	FollowerCountField = "followerCount"
+	UserStatusNormal = "0"
+	UserStatusBanned = "1"
+
+	UserCacheKeyPrefix   = "User:Userid:UserInfo:Hash"
+	UsernameField        = "username"
+	NicknameField        = "nickname"
+	AvatarField          = "avatar"
+	BackgroundImageField = "backgroundImage"
+	SignatureField       = "signature"
+	FollowCountField     = "followCount"
+	FollowerCountField   = "followerCount"
+	TotalFavoritedField  = "totalFavorited"
+	WorkCountField       = "workCount"
+	FavoriteCountField   = "favoriteCount"
 )
 
 func (User) TableName() string {
@@ -21,7 +52,8 @@ func (User) TableName() string {
 }
 
 // CacheKey 返回 user 对应的缓存 key 名称，
-// user 缓存使用的是 Redis Hash 结构，field-value 存储 username,follow count,follower count
+// user 缓存使用的是 Redis Hash 结构，field-value 存储 username, nickname, avatar, background image, signature,
+// follow count, follower count, total favorited, work count, favorite count
 // 默认过期时间： 12h
 // 超过 10w 粉丝或关注数的用户将不设置过期时间
 func (User) CacheKey(userid uint64) string {
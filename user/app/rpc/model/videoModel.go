@@ -0,0 +1,27 @@
+package model
+
+import "gorm.io/gorm"
+
+// Video 表结构（由视频服务维护，用户服务只读取其中用于统计作品数与获赞数的列）
+type Video struct {
+	Id           uint64 `gorm:"column:id"`
+	UserId       uint64 `gorm:"column:user_id"`
+	Status       string `gorm:"column:status"`
+	PublishState string `gorm:"column:publish_state"`
+}
+
+const (
+	VideoStatusApproved        = "1" // 审核通过并且已发布的视频才计入作品数，草稿与定时发布的视频不计入
+	VideoPublishStatePublished = "0"
+)
+
+func (Video) TableName() string {
+	return "video"
+}
+
+// CountWork 统计用户发布且审核通过的作品数
+func CountWork(db *gorm.DB, userid uint64) (int64, error) {
+	var cnt int64
+	err := db.Model(&Video{}).Where("user_id = ? and status = ? and publish_state = ?", userid, VideoStatusApproved, VideoPublishStatePublished).Count(&cnt).Error
+	return cnt, err
+}
@@ -19,12 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserRpc_Register_FullMethodName     = "/user.UserRpc/Register"
-	UserRpc_Login_FullMethodName        = "/user.UserRpc/Login"
-	UserRpc_GetUser_FullMethodName      = "/user.UserRpc/GetUser"
-	UserRpc_FollowAction_FullMethodName = "/user.UserRpc/FollowAction"
-	UserRpc_FollowList_FullMethodName   = "/user.UserRpc/FollowList"
-	UserRpc_FollowerList_FullMethodName = "/user.UserRpc/FollowerList"
+	UserRpc_Register_FullMethodName                = "/user.UserRpc/Register"
+	UserRpc_Login_FullMethodName                   = "/user.UserRpc/Login"
+	UserRpc_GetUser_FullMethodName                 = "/user.UserRpc/GetUser"
+	UserRpc_FollowAction_FullMethodName            = "/user.UserRpc/FollowAction"
+	UserRpc_FollowList_FullMethodName              = "/user.UserRpc/FollowList"
+	UserRpc_FollowerList_FullMethodName            = "/user.UserRpc/FollowerList"
+	UserRpc_ChangePassword_FullMethodName          = "/user.UserRpc/ChangePassword"
+	UserRpc_UpdateProfile_FullMethodName           = "/user.UserRpc/UpdateProfile"
+	UserRpc_FriendList_FullMethodName              = "/user.UserRpc/FriendList"
+	UserRpc_SendMessage_FullMethodName             = "/user.UserRpc/SendMessage"
+	UserRpc_ListMessages_FullMethodName            = "/user.UserRpc/ListMessages"
+	UserRpc_ListNotifications_FullMethodName       = "/user.UserRpc/ListNotifications"
+	UserRpc_MarkNotificationsRead_FullMethodName   = "/user.UserRpc/MarkNotificationsRead"
+	UserRpc_UnreadNotificationCount_FullMethodName = "/user.UserRpc/UnreadNotificationCount"
+	UserRpc_BlockAction_FullMethodName             = "/user.UserRpc/BlockAction"
+	UserRpc_BlockList_FullMethodName               = "/user.UserRpc/BlockList"
+	UserRpc_MuteAction_FullMethodName              = "/user.UserRpc/MuteAction"
+	UserRpc_MuteList_FullMethodName                = "/user.UserRpc/MuteList"
+	UserRpc_GetRelations_FullMethodName            = "/user.UserRpc/GetRelations"
 )
 
 // UserRpcClient is the client API for UserRpc service.
@@ -37,6 +50,19 @@ type UserRpcClient interface {
 	FollowAction(ctx context.Context, in *FollowActionReq, opts ...grpc.CallOption) (*FollowActionResp, error)
 	FollowList(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListResp, error)
 	FollowerList(ctx context.Context, in *FollowerListReq, opts ...grpc.CallOption) (*FollowerListResp, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error)
+	FriendList(ctx context.Context, in *FriendListReq, opts ...grpc.CallOption) (*FriendListResp, error)
+	SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
+	ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (*ListMessagesResp, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsReq, opts ...grpc.CallOption) (*ListNotificationsResp, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error)
+	UnreadNotificationCount(ctx context.Context, in *UnreadNotificationCountReq, opts ...grpc.CallOption) (*UnreadNotificationCountResp, error)
+	BlockAction(ctx context.Context, in *BlockActionReq, opts ...grpc.CallOption) (*BlockActionResp, error)
+	BlockList(ctx context.Context, in *BlockListReq, opts ...grpc.CallOption) (*BlockListResp, error)
+	MuteAction(ctx context.Context, in *MuteActionReq, opts ...grpc.CallOption) (*MuteActionResp, error)
+	MuteList(ctx context.Context, in *MuteListReq, opts ...grpc.CallOption) (*MuteListResp, error)
+	GetRelations(ctx context.Context, in *RelationsReq, opts ...grpc.CallOption) (*RelationsResp, error)
 }
 
 type userRpcClient struct {
@@ -101,6 +127,123 @@ func (c *userRpcClient) FollowerList(ctx context.Context, in *FollowerListReq, o
 	return out, nil
 }
 
+func (c *userRpcClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	out := new(ChangePasswordResp)
+	err := c.cc.Invoke(ctx, UserRpc_ChangePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error) {
+	out := new(UpdateProfileResp)
+	err := c.cc.Invoke(ctx, UserRpc_UpdateProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) FriendList(ctx context.Context, in *FriendListReq, opts ...grpc.CallOption) (*FriendListResp, error) {
+	out := new(FriendListResp)
+	err := c.cc.Invoke(ctx, UserRpc_FriendList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error) {
+	out := new(SendMessageResp)
+	err := c.cc.Invoke(ctx, UserRpc_SendMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (*ListMessagesResp, error) {
+	out := new(ListMessagesResp)
+	err := c.cc.Invoke(ctx, UserRpc_ListMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) ListNotifications(ctx context.Context, in *ListNotificationsReq, opts ...grpc.CallOption) (*ListNotificationsResp, error) {
+	out := new(ListNotificationsResp)
+	err := c.cc.Invoke(ctx, UserRpc_ListNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error) {
+	out := new(MarkNotificationsReadResp)
+	err := c.cc.Invoke(ctx, UserRpc_MarkNotificationsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) UnreadNotificationCount(ctx context.Context, in *UnreadNotificationCountReq, opts ...grpc.CallOption) (*UnreadNotificationCountResp, error) {
+	out := new(UnreadNotificationCountResp)
+	err := c.cc.Invoke(ctx, UserRpc_UnreadNotificationCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) BlockAction(ctx context.Context, in *BlockActionReq, opts ...grpc.CallOption) (*BlockActionResp, error) {
+	out := new(BlockActionResp)
+	err := c.cc.Invoke(ctx, UserRpc_BlockAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) BlockList(ctx context.Context, in *BlockListReq, opts ...grpc.CallOption) (*BlockListResp, error) {
+	out := new(BlockListResp)
+	err := c.cc.Invoke(ctx, UserRpc_BlockList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) MuteAction(ctx context.Context, in *MuteActionReq, opts ...grpc.CallOption) (*MuteActionResp, error) {
+	out := new(MuteActionResp)
+	err := c.cc.Invoke(ctx, UserRpc_MuteAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) MuteList(ctx context.Context, in *MuteListReq, opts ...grpc.CallOption) (*MuteListResp, error) {
+	out := new(MuteListResp)
+	err := c.cc.Invoke(ctx, UserRpc_MuteList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRpcClient) GetRelations(ctx context.Context, in *RelationsReq, opts ...grpc.CallOption) (*RelationsResp, error) {
+	out := new(RelationsResp)
+	err := c.cc.Invoke(ctx, UserRpc_GetRelations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRpcServer is the server API for UserRpc service.
 // All implementations must embed UnimplementedUserRpcServer
 // for forward compatibility
@@ -111,6 +254,19 @@ type UserRpcServer interface {
 	FollowAction(context.Context, *FollowActionReq) (*FollowActionResp, error)
 	FollowList(context.Context, *FollowListReq) (*FollowListResp, error)
 	FollowerList(context.Context, *FollowerListReq) (*FollowerListResp, error)
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileResp, error)
+	FriendList(context.Context, *FriendListReq) (*FriendListResp, error)
+	SendMessage(context.Context, *SendMessageReq) (*SendMessageResp, error)
+	ListMessages(context.Context, *ListMessagesReq) (*ListMessagesResp, error)
+	ListNotifications(context.Context, *ListNotificationsReq) (*ListNotificationsResp, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error)
+	UnreadNotificationCount(context.Context, *UnreadNotificationCountReq) (*UnreadNotificationCountResp, error)
+	BlockAction(context.Context, *BlockActionReq) (*BlockActionResp, error)
+	BlockList(context.Context, *BlockListReq) (*BlockListResp, error)
+	MuteAction(context.Context, *MuteActionReq) (*MuteActionResp, error)
+	MuteList(context.Context, *MuteListReq) (*MuteListResp, error)
+	GetRelations(context.Context, *RelationsReq) (*RelationsResp, error)
 	mustEmbedUnimplementedUserRpcServer()
 }
 
@@ -136,6 +292,45 @@ func (UnimplementedUserRpcServer) FollowList(context.Context, *FollowListReq) (*
 func (UnimplementedUserRpcServer) FollowerList(context.Context, *FollowerListReq) (*FollowerListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FollowerList not implemented")
 }
+func (UnimplementedUserRpcServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserRpcServer) UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUserRpcServer) FriendList(context.Context, *FriendListReq) (*FriendListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FriendList not implemented")
+}
+func (UnimplementedUserRpcServer) SendMessage(context.Context, *SendMessageReq) (*SendMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedUserRpcServer) ListMessages(context.Context, *ListMessagesReq) (*ListMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedUserRpcServer) ListNotifications(context.Context, *ListNotificationsReq) (*ListNotificationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedUserRpcServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedUserRpcServer) UnreadNotificationCount(context.Context, *UnreadNotificationCountReq) (*UnreadNotificationCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnreadNotificationCount not implemented")
+}
+func (UnimplementedUserRpcServer) BlockAction(context.Context, *BlockActionReq) (*BlockActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAction not implemented")
+}
+func (UnimplementedUserRpcServer) BlockList(context.Context, *BlockListReq) (*BlockListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockList not implemented")
+}
+func (UnimplementedUserRpcServer) MuteAction(context.Context, *MuteActionReq) (*MuteActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteAction not implemented")
+}
+func (UnimplementedUserRpcServer) MuteList(context.Context, *MuteListReq) (*MuteListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteList not implemented")
+}
+func (UnimplementedUserRpcServer) GetRelations(context.Context, *RelationsReq) (*RelationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelations not implemented")
+}
 func (UnimplementedUserRpcServer) mustEmbedUnimplementedUserRpcServer() {}
 
 // UnsafeUserRpcServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +452,240 @@ func _UserRpc_FollowerList_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRpc_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_FriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).FriendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_FriendList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).FriendList(ctx, req.(*FriendListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).SendMessage(ctx, req.(*SendMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_ListMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).ListMessages(ctx, req.(*ListMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).ListNotifications(ctx, req.(*ListNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_UnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreadNotificationCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).UnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_UnreadNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).UnreadNotificationCount(ctx, req.(*UnreadNotificationCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_BlockAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).BlockAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_BlockAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).BlockAction(ctx, req.(*BlockActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_BlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).BlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_BlockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).BlockList(ctx, req.(*BlockListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_MuteAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).MuteAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_MuteAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).MuteAction(ctx, req.(*MuteActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_MuteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).MuteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_MuteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).MuteList(ctx, req.(*MuteListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRpc_GetRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRpcServer).GetRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRpc_GetRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRpcServer).GetRelations(ctx, req.(*RelationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserRpc_ServiceDesc is the grpc.ServiceDesc for UserRpc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,6 +717,58 @@ var UserRpc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FollowerList",
 			Handler:    _UserRpc_FollowerList_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserRpc_ChangePassword_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UserRpc_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "FriendList",
+			Handler:    _UserRpc_FriendList_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _UserRpc_SendMessage_Handler,
+		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _UserRpc_ListMessages_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _UserRpc_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _UserRpc_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "UnreadNotificationCount",
+			Handler:    _UserRpc_UnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "BlockAction",
+			Handler:    _UserRpc_BlockAction_Handler,
+		},
+		{
+			MethodName: "BlockList",
+			Handler:    _UserRpc_BlockList_Handler,
+		},
+		{
+			MethodName: "MuteAction",
+			Handler:    _UserRpc_MuteAction_Handler,
+		},
+		{
+			MethodName: "MuteList",
+			Handler:    _UserRpc_MuteList_Handler,
+		},
+		{
+			MethodName: "GetRelations",
+			Handler:    _UserRpc_GetRelations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -13,19 +13,49 @@ import (
 )
 
 type (
-	FollowActionReq  = user.FollowActionReq
-	FollowActionResp = user.FollowActionResp
-	FollowListReq    = user.FollowListReq
-	FollowListResp   = user.FollowListResp
-	FollowerListReq  = user.FollowerListReq
-	FollowerListResp = user.FollowerListResp
-	GetUserReq       = user.GetUserReq
-	GetUserResp      = user.GetUserResp
-	LoginReq         = user.LoginReq
-	LoginResp        = user.LoginResp
-	RegisterReq      = user.RegisterReq
-	RegisterResp     = user.RegisterResp
-	User             = user.User
+	BlockActionReq              = user.BlockActionReq
+	BlockActionResp             = user.BlockActionResp
+	BlockListReq                = user.BlockListReq
+	BlockListResp               = user.BlockListResp
+	ChangePasswordReq           = user.ChangePasswordReq
+	ChangePasswordResp          = user.ChangePasswordResp
+	FollowActionReq             = user.FollowActionReq
+	FollowActionResp            = user.FollowActionResp
+	FollowListReq               = user.FollowListReq
+	FollowListResp              = user.FollowListResp
+	FollowerListReq             = user.FollowerListReq
+	FollowerListResp            = user.FollowerListResp
+	FriendListReq               = user.FriendListReq
+	FriendListResp              = user.FriendListResp
+	FriendUser                  = user.FriendUser
+	GetUserReq                  = user.GetUserReq
+	GetUserResp                 = user.GetUserResp
+	ListMessagesReq             = user.ListMessagesReq
+	ListMessagesResp            = user.ListMessagesResp
+	ListNotificationsReq        = user.ListNotificationsReq
+	ListNotificationsResp       = user.ListNotificationsResp
+	LoginReq                    = user.LoginReq
+	LoginResp                   = user.LoginResp
+	MarkNotificationsReadReq    = user.MarkNotificationsReadReq
+	MarkNotificationsReadResp   = user.MarkNotificationsReadResp
+	Message                     = user.Message
+	MuteActionReq               = user.MuteActionReq
+	MuteActionResp              = user.MuteActionResp
+	MuteListReq                 = user.MuteListReq
+	MuteListResp                = user.MuteListResp
+	Notification                = user.Notification
+	RegisterReq                 = user.RegisterReq
+	RegisterResp                = user.RegisterResp
+	Relation                    = user.Relation
+	RelationsReq                = user.RelationsReq
+	RelationsResp               = user.RelationsResp
+	SendMessageReq              = user.SendMessageReq
+	SendMessageResp             = user.SendMessageResp
+	UnreadNotificationCountReq  = user.UnreadNotificationCountReq
+	UnreadNotificationCountResp = user.UnreadNotificationCountResp
+	UpdateProfileReq            = user.UpdateProfileReq
+	UpdateProfileResp           = user.UpdateProfileResp
+	User                        = user.User
 
 	UserRpc interface {
 		Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
@@ -34,6 +64,19 @@ type (
 		FollowAction(ctx context.Context, in *FollowActionReq, opts ...grpc.CallOption) (*FollowActionResp, error)
 		FollowList(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListResp, error)
 		FollowerList(ctx context.Context, in *FollowerListReq, opts ...grpc.CallOption) (*FollowerListResp, error)
+		ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+		UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error)
+		FriendList(ctx context.Context, in *FriendListReq, opts ...grpc.CallOption) (*FriendListResp, error)
+		SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
+		ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (*ListMessagesResp, error)
+		ListNotifications(ctx context.Context, in *ListNotificationsReq, opts ...grpc.CallOption) (*ListNotificationsResp, error)
+		MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error)
+		UnreadNotificationCount(ctx context.Context, in *UnreadNotificationCountReq, opts ...grpc.CallOption) (*UnreadNotificationCountResp, error)
+		BlockAction(ctx context.Context, in *BlockActionReq, opts ...grpc.CallOption) (*BlockActionResp, error)
+		BlockList(ctx context.Context, in *BlockListReq, opts ...grpc.CallOption) (*BlockListResp, error)
+		MuteAction(ctx context.Context, in *MuteActionReq, opts ...grpc.CallOption) (*MuteActionResp, error)
+		MuteList(ctx context.Context, in *MuteListReq, opts ...grpc.CallOption) (*MuteListResp, error)
+		GetRelations(ctx context.Context, in *RelationsReq, opts ...grpc.CallOption) (*RelationsResp, error)
 	}
 
 	defaultUserRpc struct {
@@ -76,3 +119,68 @@ func (m *defaultUserRpc) FollowerList(ctx context.Context, in *FollowerListReq,
 	client := user.NewUserRpcClient(m.cli.Conn())
 	return client.FollowerList(ctx, in, opts...)
 }
+
+func (m *defaultUserRpc) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.ChangePassword(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.UpdateProfile(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) FriendList(ctx context.Context, in *FriendListReq, opts ...grpc.CallOption) (*FriendListResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.FriendList(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.SendMessage(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (*ListMessagesResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.ListMessages(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) ListNotifications(ctx context.Context, in *ListNotificationsReq, opts ...grpc.CallOption) (*ListNotificationsResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.ListNotifications(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.MarkNotificationsRead(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) UnreadNotificationCount(ctx context.Context, in *UnreadNotificationCountReq, opts ...grpc.CallOption) (*UnreadNotificationCountResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.UnreadNotificationCount(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) BlockAction(ctx context.Context, in *BlockActionReq, opts ...grpc.CallOption) (*BlockActionResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.BlockAction(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) BlockList(ctx context.Context, in *BlockListReq, opts ...grpc.CallOption) (*BlockListResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.BlockList(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) MuteAction(ctx context.Context, in *MuteActionReq, opts ...grpc.CallOption) (*MuteActionResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.MuteAction(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) MuteList(ctx context.Context, in *MuteListReq, opts ...grpc.CallOption) (*MuteListResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.MuteList(ctx, in, opts...)
+}
+
+func (m *defaultUserRpc) GetRelations(ctx context.Context, in *RelationsReq, opts ...grpc.CallOption) (*RelationsResp, error) {
+	client := user.NewUserRpcClient(m.cli.Conn())
+	return client.GetRelations(ctx, in, opts...)
+}
@@ -7,10 +7,13 @@ import (
 )
 
 type Config struct {
-	DbConfig    DbConfig    `yaml:"DbConfig"`
-	KafkaConfig KafkaConfig `yaml:"KafkaConfig"`
-	RedisConfig RedisConfig `yaml:"RedisConfig"`
-	WorkerId    uint32      `yaml:"WorkerId"`
+	DbConfig       DbConfig       `yaml:"DbConfig"`
+	KafkaConfig    KafkaConfig    `yaml:"KafkaConfig"`
+	PlayConfig     PlayConfig     `yaml:"PlayConfig"`
+	StatConfig     StatConfig     `yaml:"StatConfig"`
+	TrendingConfig TrendingConfig `yaml:"TrendingConfig"`
+	RedisConfig    RedisConfig    `yaml:"RedisConfig"`
+	WorkerId       uint32         `yaml:"WorkerId"`
 }
 
 type KafkaConfig struct {
@@ -20,6 +23,32 @@ type KafkaConfig struct {
 	MaxBytes int    `yaml:"MaxBytes"`
 }
 
+// PlayConfig 播放事件消费设置
+type PlayConfig struct {
+	Host           string  `yaml:"Host"`
+	Topic          string  `yaml:"Topic"`
+	GroupId        string  `yaml:"GroupId"` // 消费组，累加到 Redis 后才提交 offset
+	MinBytes       int     `yaml:"MinBytes"`
+	MaxBytes       int     `yaml:"MaxBytes"`
+	FlushInterval  int     `yaml:"FlushInterval"`  // 播放统计写入 DB 的间隔，单位秒
+	FlushBatch     int     `yaml:"FlushBatch"`     // 每批写入 DB 的视频数量
+	ViewerTTL      int     `yaml:"ViewerTTL"`      // 独立观众集合的过期时间，单位秒，视频长时间没有播放时淘汰
+	DedupeTTL      int     `yaml:"DedupeTTL"`      // 同一观众在该时间（秒）内重复上报同一视频的开始或完成播放只统计一次，为 0 时不去重
+	ViewWeight     float64 `yaml:"ViewWeight"`     // 每次播放的热门排行榜分数，需与视频服务 TrendingConfig.ViewWeight 一致
+	HistoryMaxSize int     `yaml:"HistoryMaxSize"` // Redis 中每个用户保留的观看历史数量，超出的部分归档到 DB
+}
+
+// StatConfig 创作者数据每日统计设置
+type StatConfig struct {
+	RollupInterval int `yaml:"RollupInterval"` // 汇总间隔，单位秒
+	RollupDays     int `yaml:"RollupDays"`     // 每次重新汇总最近几天的数据（包括当天）
+}
+
+// TrendingConfig 热门排行榜设置
+type TrendingConfig struct {
+	FavoriteWeight float64 `yaml:"FavoriteWeight"` // 点赞写库成功后的热门排行榜分数，取消点赞确实删除了点赞记录时扣除
+}
+
 type RedisConfig struct {
 	Host        string `yaml:"Host"`
 	Port        int    `yaml:"Port"`
@@ -0,0 +1,187 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/creatorstat"
+	"Mini-Tiktok/video/app/kafka/internal/svc"
+	"Mini-Tiktok/video/app/kafka/model"
+	"context"
+	"encoding/json"
+	"github.com/gomodule/redigo/redis"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"time"
+)
+
+type PlayStatLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPlayStatLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlayStatLogic {
+	return &PlayStatLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Consume 消费播放事件并累加到 Redis，累加成功后才提交 offset，Redis 出错时等待后重试同一条消息
+// 拉取消息失败（如 Kafka 暂时不可用）时等待后重试，context 取消时退出
+func (l *PlayStatLogic) Consume() {
+	conf := l.svcCtx.Config.PlayConfig
+	for {
+		m, err := l.svcCtx.PlayReader.FetchMessage(l.ctx)
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return
+			}
+			log.Println(err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		var ev model.PlayEvent
+		err = json.Unmarshal(m.Value, &ev)
+		if err != nil {
+			log.Println(err)
+		} else if ev.ActionType == model.PlayActionStart || ev.ActionType == model.PlayActionComplete {
+			for {
+				conn := l.svcCtx.Redis.NewRedisConn()
+				err = l.svcCtx.Redis.AddPlayEvent(conn, &ev, conf.ViewerTTL, conf.DedupeTTL, conf.ViewWeight)
+				conn.Close()
+				if err == nil {
+					break
+				}
+				log.Println(err)
+				time.Sleep(time.Second)
+			}
+		}
+
+		// 登录用户开始播放时记录观看历史，失败只记录日志
+		if err == nil && ev.ActionType == model.PlayActionStart && ev.UserId != 0 {
+			err = l.recordHistory(&ev)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+
+		err = l.svcCtx.PlayReader.CommitMessages(l.ctx, m)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// recordHistory 将视频加入用户的观看历史，Redis 中超出数量的最早记录归档到 DB
+// 同一视频已归档时更新为较新的观看时间
+func (l *PlayStatLogic) recordHistory(ev *model.PlayEvent) error {
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	archived, err := l.svcCtx.Redis.AddHistory(conn, ev.UserId, ev.VideoId, ev.CreateTime, l.svcCtx.Config.PlayConfig.HistoryMaxSize)
+	if err != nil {
+		return err
+	}
+	if len(archived) == 0 {
+		return nil
+	}
+	return l.svcCtx.Db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "video_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"watch_time": gorm.Expr("greatest(watch_time, values(watch_time))")}),
+	}).Create(&archived).Error
+}
+
+// FlushLoop 每隔 FlushInterval 秒将 Redis 中累加的播放统计写入 DB
+func (l *PlayStatLogic) FlushLoop() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.PlayConfig.FlushInterval) * time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		err := l.Flush()
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// Flush 分批取出有未写入数据的视频，将播放统计累加到 DB，直到没有待写入的视频
+// 不存在的视频（客户端上报了错误的 id）的统计直接丢弃
+func (l *PlayStatLogic) Flush() error {
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	for {
+		ids, err := l.svcCtx.Redis.PopPlayDirty(conn, l.svcCtx.Config.PlayConfig.FlushBatch)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var exists []uint64
+		err = l.svcCtx.Db.Model(&model.Video{}).Where("id in ?", ids).Pluck("id", &exists).Error
+		if err != nil {
+			return err
+		}
+		valid := make(map[uint64]bool, len(exists))
+		for _, id := range exists {
+			valid[id] = true
+		}
+
+		now := time.Now().Unix()
+		for _, id := range ids {
+			err = l.flushVideo(conn, id, valid[id], now)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// flushVideo 将一个视频的播放统计累加到 DB，写入失败时加回缓存
+func (l *PlayStatLogic) flushVideo(conn redis.Conn, videoId uint64, valid bool, now int64) error {
+	stat, err := l.svcCtx.Redis.TakePlayDelta(conn, videoId)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return nil
+	}
+
+	stat.UpdateTime = now
+	err = l.svcCtx.Db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "video_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"view_count":     gorm.Expr("view_count + ?", stat.ViewCount),
+			"complete_count": gorm.Expr("complete_count + ?", stat.CompleteCount),
+			"watch_duration": gorm.Expr("watch_duration + ?", stat.WatchDuration),
+			// 独立观众集合过期后会从 0 重新计数，所以只在估计值更大时更新
+			"unique_viewers": gorm.Expr("greatest(unique_viewers, ?)", stat.UniqueViewers),
+			"update_time":    now,
+		}),
+	}).Create(stat).Error
+	if err != nil {
+		err2 := l.svcCtx.Redis.RestorePlayDelta(conn, stat)
+		if err2 != nil {
+			return err2
+		}
+		return err
+	}
+
+	// 累加到当天的每日统计，写入失败只影响每日统计
+	if stat.ViewCount == 0 {
+		return nil
+	}
+	daily := creatorstat.DailyStat{
+		TargetType: creatorstat.TARGET_VIDEO,
+		TargetId:   videoId,
+		Date:       creatorstat.Date(time.Unix(now, 0)),
+		Views:      stat.ViewCount,
+		UpdateTime: now,
+	}
+	return l.svcCtx.Db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "target_type"}, {Name: "target_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"views":       gorm.Expr("views + ?", stat.ViewCount),
+			"update_time": now,
+		}),
+	}).Create(&daily).Error
+}
@@ -0,0 +1,212 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/creatorstat"
+	"Mini-Tiktok/video/app/kafka/internal/svc"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"time"
+)
+
+const STAT_BATCH_SIZE = 500
+
+type StatRollupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewStatRollupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StatRollupLogic {
+	return &StatRollupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// statCount 按 id 分组计数的查询结果
+type statCount struct {
+	Id  uint64 `gorm:"column:id"`
+	Cnt int64  `gorm:"column:cnt"`
+}
+
+// RollupLoop 启动时汇总一次，之后每隔 RollupInterval 秒重新汇总最近 RollupDays 天的数据
+// 多个消费者实例同时运行时，每次汇总前在 redis 中取得有效期为 RollupInterval 秒的锁，
+// 每个汇总周期只有取得锁的实例执行汇总，锁不主动释放，持有锁的实例退出后由其他实例在锁过期后接替
+func (l *StatRollupLogic) RollupLoop() {
+	conf := l.svcCtx.Config.StatConfig
+	ticker := time.NewTicker(time.Duration(conf.RollupInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		if !l.tryLock(conf.RollupInterval) {
+			<-ticker.C
+			continue
+		}
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+		// 从最早的一天开始汇总，账号的取关数依赖前一天的粉丝数
+		for i := conf.RollupDays - 1; i >= 0; i-- {
+			err := l.Rollup(today.AddDate(0, 0, -i))
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		<-ticker.C
+	}
+}
+
+// tryLock 尝试取得汇总锁，redis 出错时不汇总，等待下一个周期
+func (l *StatRollupLogic) tryLock(ttl int) bool {
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	ok, err := l.svcCtx.Redis.TryLock(conn, creatorstat.ROLLUP_LOCK_KEY, ttl)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	return ok
+}
+
+// Rollup 汇总一天的数据，重复汇总同一天时覆盖之前的结果（播放次数与分享次数不由汇总写入，不会被覆盖）
+// 1. 视频：当天的新点赞数与新评论数
+// 2. 账号：当天收到的新点赞数、新粉丝数、当天结束时的粉丝数与点赞总数，
+// 取关数 = 新粉丝数 - (当天粉丝数 - 前一天粉丝数)（关注表只保存当前的关注关系，所以由粉丝数的变化推算）
+func (l *StatRollupLogic) Rollup(day time.Time) error {
+	start, end := day.Unix(), day.AddDate(0, 0, 1).Unix()
+	date, prevDate := creatorstat.Date(day), creatorstat.Date(day.AddDate(0, 0, -1))
+	db := l.svcCtx.Db
+
+	// 1. 视频
+	var likes, comments []statCount
+	err := db.Table("favorite").Select("video_id as id, count(*) as cnt").
+		Where("create_time >= ? and create_time < ?", start, end).Group("video_id").Scan(&likes).Error
+	if err != nil {
+		return err
+	}
+	err = db.Table("comment").Select("video_id as id, count(*) as cnt").
+		Where("create_time >= ? and create_time < ? and status = ?", start, end, COMMENT_VISIBLE).Group("video_id").Scan(&comments).Error
+	if err != nil {
+		return err
+	}
+	videoStats := make(map[uint64]*creatorstat.DailyStat)
+	videoStat := func(id uint64) *creatorstat.DailyStat {
+		if s, ok := videoStats[id]; ok {
+			return s
+		}
+		s := &creatorstat.DailyStat{TargetType: creatorstat.TARGET_VIDEO, TargetId: id, Date: date}
+		videoStats[id] = s
+		return s
+	}
+	for _, c := range likes {
+		videoStat(c.Id).Likes = c.Cnt
+	}
+	for _, c := range comments {
+		videoStat(c.Id).Comments = c.Cnt
+	}
+
+	// 2. 账号
+	var newLikes, likeTotals, newFollowers, followerTotals []statCount
+	err = db.Table("favorite f").Joins("join video v on v.id = f.video_id").Select("v.user_id as id, count(*) as cnt").
+		Where("f.create_time >= ? and f.create_time < ?", start, end).Group("v.user_id").Scan(&newLikes).Error
+	if err != nil {
+		return err
+	}
+	err = db.Table("favorite f").Joins("join video v on v.id = f.video_id").Select("v.user_id as id, count(*) as cnt").
+		Where("f.create_time < ?", end).Group("v.user_id").Scan(&likeTotals).Error
+	if err != nil {
+		return err
+	}
+	err = db.Table("follow").Select("following_id as id, count(*) as cnt").
+		Where("create_time >= ? and create_time < ?", start, end).Group("following_id").Scan(&newFollowers).Error
+	if err != nil {
+		return err
+	}
+	err = db.Table("follow").Select("following_id as id, count(*) as cnt").
+		Where("create_time < ?", end).Group("following_id").Scan(&followerTotals).Error
+	if err != nil {
+		return err
+	}
+	var prev []creatorstat.DailyStat
+	err = db.Select("target_id, follower_total").Where("target_type = ? and date = ?", creatorstat.TARGET_USER, prevDate).Find(&prev).Error
+	if err != nil {
+		return err
+	}
+
+	userStats := make(map[uint64]*creatorstat.DailyStat)
+	userStat := func(id uint64) *creatorstat.DailyStat {
+		if s, ok := userStats[id]; ok {
+			return s
+		}
+		s := &creatorstat.DailyStat{TargetType: creatorstat.TARGET_USER, TargetId: id, Date: date}
+		userStats[id] = s
+		return s
+	}
+	for _, c := range newLikes {
+		userStat(c.Id).Likes = c.Cnt
+	}
+	for _, c := range likeTotals {
+		userStat(c.Id).LikeTotal = c.Cnt
+	}
+	for _, c := range newFollowers {
+		userStat(c.Id).NewFollowers = c.Cnt
+	}
+	for _, c := range followerTotals {
+		userStat(c.Id).FollowerTotal = c.Cnt
+	}
+	prevTotals := make(map[uint64]int64, len(prev))
+	for _, p := range prev {
+		prevTotals[p.TargetId] = p.FollowerTotal
+		userStat(p.TargetId) // 粉丝全部取关的账号也需要记录
+	}
+	for id, s := range userStats {
+		if last, ok := prevTotals[id]; ok {
+			if unfollows := s.NewFollowers - (s.FollowerTotal - last); unfollows > 0 {
+				s.Unfollows = unfollows
+			}
+		}
+	}
+
+	// 3. 写入 DB：先清零当天由汇总写入的列，再写入新的结果
+	now := time.Now().Unix()
+	videoRows := make([]*creatorstat.DailyStat, 0, len(videoStats))
+	for _, s := range videoStats {
+		s.UpdateTime = now
+		videoRows = append(videoRows, s)
+	}
+	userRows := make([]*creatorstat.DailyStat, 0, len(userStats))
+	for _, s := range userStats {
+		s.UpdateTime = now
+		userRows = append(userRows, s)
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&creatorstat.DailyStat{}).Where("target_type = ? and date = ?", creatorstat.TARGET_VIDEO, date).
+			Updates(map[string]interface{}{"likes": 0, "comments": 0}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&creatorstat.DailyStat{}).Where("target_type = ? and date = ?", creatorstat.TARGET_USER, date).
+			Updates(map[string]interface{}{"likes": 0, "new_followers": 0, "unfollows": 0}).Error
+		if err != nil {
+			return err
+		}
+		if len(videoRows) > 0 {
+			err = tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "target_type"}, {Name: "target_id"}, {Name: "date"}},
+				DoUpdates: clause.AssignmentColumns([]string{"likes", "comments", "update_time"}),
+			}).CreateInBatches(videoRows, STAT_BATCH_SIZE).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(userRows) > 0 {
+			err = tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "target_type"}, {Name: "target_id"}, {Name: "date"}},
+				DoUpdates: clause.AssignmentColumns([]string{"likes", "new_followers", "unfollows", "follower_total", "like_total", "update_time"}),
+			}).CreateInBatches(userRows, STAT_BATCH_SIZE).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -6,6 +6,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"github.com/gomodule/redigo/redis"
+	"gorm.io/gorm"
+	"time"
 )
 
 type WriteDbLogic struct {
@@ -77,6 +80,11 @@ func (l *WriteDbLogic) WriteDb(msg []byte) error {
 						return err
 					}
 
+					err = l.updateFavoriteStat(conn, favorite, 1)
+					if err != nil {
+						return err
+					}
+
 				} else {
 					return errors.New(MODEL_UNKNOWN_ERROR)
 				}
@@ -90,10 +98,11 @@ func (l *WriteDbLogic) WriteDb(msg []byte) error {
 						return err
 					}
 
-					err = l.svcCtx.Db.Delete(&model.Favorite{}, &model.Favorite{
+					db := l.svcCtx.Db.Delete(&model.Favorite{}, &model.Favorite{
 						UserId:  favorite.UserId,
 						VideoId: favorite.VideoId,
-					}).Error
+					})
+					err = db.Error
 					if err != nil {
 						err = l.svcCtx.Db.Delete(&model.Favorite{}, &model.Favorite{
 							UserId:  favorite.UserId,
@@ -113,6 +122,14 @@ func (l *WriteDbLogic) WriteDb(msg []byte) error {
 						return err
 					}
 
+					// 确实删除了点赞记录才更新用户点赞数、作者获赞数与热门排行榜
+					if db.RowsAffected > 0 {
+						err = l.updateFavoriteStat(conn, favorite, -1)
+						if err != nil {
+							return err
+						}
+					}
+
 				} else {
 					return errors.New(MODEL_UNKNOWN_ERROR)
 				}
@@ -127,6 +144,32 @@ func (l *WriteDbLogic) WriteDb(msg []byte) error {
 	return nil
 }
 
+// updateFavoriteStat 点赞记录确实写入或删除后，更新用户点赞数、作者获赞数缓存与视频的热门排行榜分数
+func (l *WriteDbLogic) updateFavoriteStat(conn redis.Conn, favorite *model.Favorite, delta int64) error {
+	err := l.updateUserFavoriteCount(conn, favorite, delta)
+	if err != nil {
+		return err
+	}
+	score := float64(delta) * l.svcCtx.Config.TrendingConfig.FavoriteWeight
+	if score == 0 {
+		return nil
+	}
+	return l.svcCtx.Redis.IncrTrending(conn, favorite.VideoId, score, time.Now().Unix())
+}
+
+// updateUserFavoriteCount 查询视频作者，更新点赞用户的点赞数与作者的获赞数缓存
+func (l *WriteDbLogic) updateUserFavoriteCount(conn redis.Conn, favorite *model.Favorite, delta int64) error {
+	var authorId uint64
+	err := l.svcCtx.Db.Model(&model.Video{}).Select("user_id").Where(&model.Video{Id: favorite.VideoId}).Take(&authorId).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return err
+	}
+	return l.svcCtx.Redis.IncrUserFavoriteCount(conn, favorite.UserId, authorId, delta)
+}
+
 func ParseFavoriteModel(columns []byte) (*model.Favorite, error) {
 	var favor *model.Favorite
 	err := json.Unmarshal(columns, &favor)
@@ -6,4 +6,5 @@ const (
 	OP_UNKNOWN_ERROR    = "unknown operation"
 	MODEL_FAVORITE      = "favorite"
 	MODEL_UNKNOWN_ERROR = "unknown model"
+	COMMENT_VISIBLE     = "1"
 )
@@ -17,6 +17,7 @@ type ServiceContext struct {
 	Redis       *redisCache.RedisPool
 	Db          *gorm.DB
 	KafkaReader *kafka.Reader
+	PlayReader  *kafka.Reader
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -44,6 +45,13 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Db:          db,
 		Redis:       pool,
 		KafkaReader: reader,
+		PlayReader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  strings.Split(c.PlayConfig.Host, ","),
+			Topic:    c.PlayConfig.Topic,
+			GroupID:  c.PlayConfig.GroupId,
+			MinBytes: c.PlayConfig.MinBytes,
+			MaxBytes: c.PlayConfig.MaxBytes,
+		}),
 	}
 }
 
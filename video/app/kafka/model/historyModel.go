@@ -0,0 +1,25 @@
+package model
+
+import "strconv"
+
+// WatchHistory 表结构，用户观看历史的归档
+type WatchHistory struct {
+	UserId    uint64 `gorm:"column:user_id"`
+	VideoId   uint64 `gorm:"column:video_id"`
+	WatchTime int64  `gorm:"column:watch_time"`
+}
+
+const (
+	HistoryCacheKeyPrefix = "History:UserId:VideoId:ZSET:"
+	HistoryPausedCacheKey = "History:Paused:UserId:SET"
+)
+
+func (WatchHistory) TableName() string {
+	return "watch_history"
+}
+
+// CacheKey 返回 用户最近观看历史 对应的缓存 key 名称，
+// 缓存类型为 zset 类型，key: History:UserId:VideoId:ZSET:{用户id}, member: {视频id}, score: 观看时间
+func (WatchHistory) CacheKey(userid uint64) string {
+	return HistoryCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
@@ -0,0 +1,64 @@
+package model
+
+import "strconv"
+
+// PlayEvent 播放事件，由 api 网关在客户端开始播放与播放完成时写入 Kafka
+type PlayEvent struct {
+	VideoId    uint64 `json:"video_id"`
+	UserId     uint64 `json:"user_id"`          // 未登录为 0
+	Viewer     string `json:"viewer,omitempty"` // 观众标识（用户 id 或设备 id），用于估计独立观众数，为空时不计入
+	ActionType string `json:"action_type"`      // 1-开始播放，2-播放完成
+	Duration   int64  `json:"duration"`         // 观看时长，单位 ms，只有播放完成事件有
+	CreateTime int64  `json:"create_time"`
+}
+
+// VideoPlayStat 表结构，视频播放统计
+type VideoPlayStat struct {
+	VideoId       uint64 `gorm:"column:video_id"`
+	ViewCount     int64  `gorm:"column:view_count"`     // 播放次数
+	CompleteCount int64  `gorm:"column:complete_count"` // 完播次数
+	WatchDuration int64  `gorm:"column:watch_duration"` // 完播上报的观看时长总和，单位 ms
+	UniqueViewers int64  `gorm:"column:unique_viewers"` // 独立观众数（HyperLogLog 估计值）
+	UpdateTime    int64  `gorm:"column:update_time"`
+}
+
+const (
+	PlayActionStart    = "1"
+	PlayActionComplete = "2"
+
+	PlayViewCountCacheKeyPrefix = "Play:VideoId:ViewCount:"
+	PlayDeltaCacheKeyPrefix     = "Play:VideoId:Delta:HASH:"
+	PlayViewerCacheKeyPrefix    = "Play:VideoId:Viewer:HLL:"
+	PlayDirtyCacheKey           = "Play:Dirty:VideoId:SET"
+	PlayDedupeCacheKeyPrefix    = "Play:VideoId:Dedupe:"
+)
+
+func (VideoPlayStat) TableName() string {
+	return "video_play_stat"
+}
+
+// ViewCountCacheKey 返回 视频播放次数 对应的缓存 key 名称，
+// 缓存类型为 string 类型，key: Play:VideoId:ViewCount:{视频id} value: 播放次数（包括还未写入 DB 的部分）
+// 由视频服务读取时从 DB 加载，消费者只在缓存存在时累加
+func (VideoPlayStat) ViewCountCacheKey(videoId uint64) string {
+	return PlayViewCountCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
+
+// DeltaCacheKey 返回 视频未写入 DB 的播放统计 对应的缓存 key 名称，
+// 缓存类型为 hash 类型，key: Play:VideoId:Delta:HASH:{视频id} field: view_count, complete_count, watch_duration
+// 定时写入 DB 后删除，有未写入数据的视频 id 记录在 Play:Dirty:VideoId:SET 集合中
+func (VideoPlayStat) DeltaCacheKey(videoId uint64) string {
+	return PlayDeltaCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
+
+// ViewerCacheKey 返回 视频独立观众 对应的缓存 key 名称，
+// 缓存类型为 HyperLogLog 类型，key: Play:VideoId:Viewer:HLL:{视频id} element: 观众标识
+func (VideoPlayStat) ViewerCacheKey(videoId uint64) string {
+	return PlayViewerCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
+
+// DedupeCacheKey 返回 观众最近一次上报播放事件 对应的缓存 key 名称，
+// 缓存类型为 string 类型，key: Play:VideoId:Dedupe:{视频id}:{事件类型}:{观众标识} value: 1，过期前同一观众的同类事件不重复统计
+func (VideoPlayStat) DedupeCacheKey(videoId uint64, actionType, viewer string) string {
+	return PlayDedupeCacheKeyPrefix + strconv.FormatUint(videoId, 10) + ":" + actionType + ":" + viewer
+}
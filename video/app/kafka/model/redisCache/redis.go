@@ -1,10 +1,12 @@
 package redisCache
 
 import (
+	"Mini-Tiktok/common/trending"
 	"Mini-Tiktok/video/app/kafka/internal/config"
 	"Mini-Tiktok/video/app/kafka/model"
 	"fmt"
 	"github.com/gomodule/redigo/redis"
+	"strconv"
 	"time"
 )
 
@@ -71,3 +73,162 @@ func (p *RedisPool) DelFavorite(conn redis.Conn, videoId, userId uint64) error {
 	}
 	return nil
 }
+
+// IncrUserFavoriteCount 点赞写库成功后更新用户信息缓存：点赞用户的点赞数与视频作者的获赞数增加 delta
+// 只在对应 field 存在时更新，不存在时由用户服务下次查询时从 DB 加载
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrUserFavoriteCount(conn redis.Conn, userId, authorId uint64, delta int64) error {
+	_, err := conn.Do("EVAL", "if (redis.call('HEXISTS', KEYS[1], ARGV[1]) == 1) then "+
+		"redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[3]); end; "+
+		"if (redis.call('HEXISTS', KEYS[2], ARGV[2]) == 1) then "+
+		"redis.call('HINCRBY', KEYS[2], ARGV[2], ARGV[3]); end; "+
+		"return nil; ", 2, model.User{}.CacheKey(userId), model.User{}.CacheKey(authorId),
+		model.FavoriteCountField, model.TotalFavoritedField, delta)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IncrTrending 将 score 累加到视频在各时间窗口热门排行榜的当前时间桶，并刷新时间桶的过期时间
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrTrending(conn redis.Conn, videoId uint64, score float64, now int64) error {
+	args := []interface{}{"for i, k in ipairs(KEYS) do " +
+		"redis.call('ZINCRBY', k, ARGV[1], ARGV[2]); " +
+		"redis.call('EXPIRE', k, ARGV[i+2]); end; " +
+		"return nil; ", len(trending.Windows)}
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketCacheKey(w.Bucket(now)))
+	}
+	args = append(args, score, videoId)
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketTTL())
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddPlayEvent 累加视频的播放统计，视频 id 加入待写入 DB 的集合：
+// 开始播放时播放次数 + 1（播放次数缓存存在时同时累加），记录独立观众，并将 viewWeight 累加到热门排行榜的当前时间桶；
+// 播放完成时完播次数 + 1 并累加观看时长
+// 有观众标识且 dedupeTTL 不为 0 时，同一观众在 dedupeTTL 秒内对同一视频的同类事件只统计一次
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) AddPlayEvent(conn redis.Conn, ev *model.PlayEvent, viewerTTL, dedupeTTL int, viewWeight float64) error {
+	stat := model.VideoPlayStat{}
+	args := []interface{}{"if (ARGV[4] ~= '' and tonumber(ARGV[7]) ~= 0 and " +
+		"not redis.call('SET', KEYS[5], 1, 'NX', 'EX', ARGV[7])) then return nil; end; " +
+		"if (ARGV[1] == '1') then " +
+		"redis.call('HINCRBY', KEYS[1], 'view_count', 1); " +
+		"if (redis.call('EXISTS', KEYS[2]) == 1) then redis.call('INCR', KEYS[2]); end; " +
+		"if (ARGV[4] ~= '') then " +
+		"redis.call('PFADD', KEYS[3], ARGV[4]); " +
+		"redis.call('EXPIRE', KEYS[3], ARGV[5]); end; " +
+		"if (tonumber(ARGV[6]) ~= 0) then " +
+		"for i = 6, #KEYS do " +
+		"redis.call('ZINCRBY', KEYS[i], ARGV[6], ARGV[2]); " +
+		"redis.call('EXPIRE', KEYS[i], ARGV[i+2]); end; end; " +
+		"else " +
+		"redis.call('HINCRBY', KEYS[1], 'complete_count', 1); " +
+		"redis.call('HINCRBY', KEYS[1], 'watch_duration', ARGV[3]); end; " +
+		"redis.call('SADD', KEYS[4], ARGV[2]); " +
+		"return nil; ", 5 + len(trending.Windows),
+		stat.DeltaCacheKey(ev.VideoId), stat.ViewCountCacheKey(ev.VideoId), stat.ViewerCacheKey(ev.VideoId), model.PlayDirtyCacheKey,
+		stat.DedupeCacheKey(ev.VideoId, ev.ActionType, ev.Viewer)}
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketCacheKey(w.Bucket(ev.CreateTime)))
+	}
+	args = append(args, ev.ActionType, ev.VideoId, ev.Duration, ev.Viewer, viewerTTL, viewWeight, dedupeTTL)
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketTTL())
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PopPlayDirty 从待写入 DB 的集合中取出最多 count 个视频 id
+func (p *RedisPool) PopPlayDirty(conn redis.Conn, count int) ([]uint64, error) {
+	return redis.Uint64s(conn.Do("SPOP", model.PlayDirtyCacheKey, count))
+}
+
+// TakePlayDelta 取出并删除视频未写入 DB 的播放统计，同时返回独立观众数的估计值
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) TakePlayDelta(conn redis.Conn, videoId uint64) (*model.VideoPlayStat, error) {
+	stat := model.VideoPlayStat{}
+	vals, err := redis.Int64s(conn.Do("EVAL", "local res = redis.call('HMGET', KEYS[1], 'view_count', 'complete_count', 'watch_duration'); "+
+		"redis.call('DEL', KEYS[1]); "+
+		"for i = 1, 3 do res[i] = tonumber(res[i]) or 0; end; "+
+		"res[4] = redis.call('PFCOUNT', KEYS[2]); "+
+		"return res; ", 2, stat.DeltaCacheKey(videoId), stat.ViewerCacheKey(videoId)))
+	if err != nil {
+		return nil, err
+	}
+	return &model.VideoPlayStat{
+		VideoId:       videoId,
+		ViewCount:     vals[0],
+		CompleteCount: vals[1],
+		WatchDuration: vals[2],
+		UniqueViewers: vals[3],
+	}, nil
+}
+
+// RestorePlayDelta 写入 DB 失败时将取出的播放统计加回缓存，等待下次写入
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) RestorePlayDelta(conn redis.Conn, s *model.VideoPlayStat) error {
+	_, err := conn.Do("EVAL", "redis.call('HINCRBY', KEYS[1], 'view_count', ARGV[2]); "+
+		"redis.call('HINCRBY', KEYS[1], 'complete_count', ARGV[3]); "+
+		"redis.call('HINCRBY', KEYS[1], 'watch_duration', ARGV[4]); "+
+		"redis.call('SADD', KEYS[2], ARGV[1]); "+
+		"return nil; ", 2, s.DeltaCacheKey(s.VideoId), model.PlayDirtyCacheKey, s.VideoId, s.ViewCount, s.CompleteCount, s.WatchDuration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddHistory 将视频加入用户的观看历史（用户暂停记录时不加入），返回超过 maxSize 被移出缓存的最早记录，由调用方归档到 DB
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) AddHistory(conn redis.Conn, userId, videoId uint64, watchTime int64, maxSize int) ([]model.WatchHistory, error) {
+	vals, err := redis.Strings(conn.Do("EVAL", "if (redis.call('SISMEMBER', KEYS[2], ARGV[1]) == 1) then return {}; end; "+
+		"redis.call('ZADD', KEYS[1], ARGV[3], ARGV[2]); "+
+		"local n = redis.call('ZCARD', KEYS[1]) - tonumber(ARGV[4]); "+
+		"if (n <= 0) then return {}; end; "+
+		"return redis.call('ZPOPMIN', KEYS[1], n); ", 2, model.WatchHistory{}.CacheKey(userId), model.HistoryPausedCacheKey, userId, videoId, watchTime, maxSize))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]model.WatchHistory, 0, len(vals)/2)
+	for i := 0; i+1 < len(vals); i += 2 {
+		id, err := strconv.ParseUint(vals[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		t, err := strconv.ParseInt(vals[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, model.WatchHistory{
+			UserId:    userId,
+			VideoId:   id,
+			WatchTime: t,
+		})
+	}
+	return list, nil
+}
+
+// TryLock 使用 SET NX EX 尝试取得锁，锁在 ttl 秒后自动过期，返回是否取得锁
+func (p *RedisPool) TryLock(conn redis.Conn, key string, ttl int) (bool, error) {
+	_, err := redis.String(conn.Do("SET", key, 1, "NX", "EX", ttl))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
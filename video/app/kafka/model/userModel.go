@@ -0,0 +1,17 @@
+package model
+
+import "strconv"
+
+const (
+	UserCacheKeyPrefix  = "User:Userid:UserInfo:Hash"
+	TotalFavoritedField = "totalFavorited"
+	FavoriteCountField  = "favoriteCount"
+)
+
+// User 用户服务的用户信息缓存，点赞写库成功后需要更新其中点赞用户的点赞数与视频作者的获赞数
+type User struct{}
+
+// CacheKey 返回 user 对应的缓存 key 名称（与用户服务保持一致）
+func (User) CacheKey(userid uint64) string {
+	return UserCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
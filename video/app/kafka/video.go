@@ -17,6 +17,15 @@ func main() {
 	config.MustLoad(*configFile, &c)
 	svcctx := svc.NewServiceContext(c)
 	l := logic.NewWriteDbLogic(context.Background(), svcctx)
+
+	// 播放统计：消费播放事件累加到 Redis，并定时写入 DB
+	play := logic.NewPlayStatLogic(context.Background(), svcctx)
+	go play.Consume()
+	go play.FlushLoop()
+
+	// 创作者数据每日统计
+	go logic.NewStatRollupLogic(context.Background(), svcctx).RollupLoop()
+
 	fmt.Println("Database Writer Service Start...")
 	fmt.Println("start consuming ...")
 	logic.InitModels()
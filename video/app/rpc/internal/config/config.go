@@ -1,6 +1,7 @@
 package config
 
 import (
+	"Mini-Tiktok/common/moderation"
 	"github.com/zeromicro/go-zero/zrpc"
 	"strconv"
 )
@@ -15,6 +16,20 @@ type Config struct {
 		BatchSize    int
 		BatchBytes   int64
 	}
+	PushConfig struct {
+		Host         string
+		Topic        string
+		BatchTimeout int
+		BatchSize    int
+		BatchBytes   int64
+	}
+	SearchConfig struct {
+		Host         string
+		Topic        string
+		BatchTimeout int
+		BatchSize    int
+		BatchBytes   int64
+	}
 	DbConfig    DbConfig
 	RedisConfig struct {
 		Host        string
@@ -26,8 +41,52 @@ type Config struct {
 		Active      int
 		IdleTimeout int
 	}
-	CacheConfig CacheConfig
-	WorkerId    uint32
+	CacheConfig  CacheConfig
+	Moderation   moderation.Config
+	ReviewConfig struct {
+		ListLimit int `json:",default=20"` // 每次获取审核队列的视频数量
+	}
+	ReportConfig struct {
+		HideThreshold    int64 `json:",default=5"`   // 举报人数达到该值时自动隐藏举报目标，等待审核
+		ContentMaxLength int   `json:",default=200"` // 举报说明的最大长度（字符数）
+		ListLimit        int   `json:",default=20"`  // 每次获取举报目标列表的数量
+	}
+	TopicConfig struct {
+		ListLimit int `json:",default=20"` // 每次获取话题视频列表的数量
+	}
+	HistoryConfig struct {
+		ListLimit int `json:",default=20"` // 每次获取观看历史的数量
+	}
+	CollectionConfig struct {
+		NameMaxLength int `json:",default=20"`   // 合集名称的最大长度（字符数）
+		MaxCount      int `json:",default=100"`  // 每个用户最多创建的合集数量
+		MaxVideoCount int `json:",default=1000"` // 每个合集最多收藏的视频数量
+		ListLimit     int `json:",default=20"`   // 每次获取合集视频列表的数量
+	}
+	ShareConfig struct {
+		Secret        string // 分享链接签名密钥，不能为空且不能与 jwt 密钥相同
+		JwtSecret     string `json:",optional"`                          // jwt 服务的 AccessSecret，只用于启动时检查分享链接签名密钥没有复用 jwt 密钥
+		LinkPrefix    string `json:",default=https://www.douyin.com/s/"` // 分享链接前缀，之后拼接分享 token
+		MessageFormat string `json:",default=分享了视频「%s」，点击观看：%s"`         // 私信好友时的消息内容，参数为视频标题与分享链接
+	}
+	ScheduleConfig struct {
+		Interval   int `json:",default=10"`  // 定时发布任务的执行间隔（秒）
+		BatchSize  int `json:",default=100"` // 每次发布的到期视频数量
+		DraftLimit int `json:",default=100"` // 草稿列表的最大数量
+	}
+	StatConfig struct {
+		MaxDays int `json:",default=90"` // 每次查询创作者数据的最大天数
+	}
+	TrendingConfig struct {
+		CommentWeight float64 `json:",default=5"`   // 每条评论的热度分数
+		ShareWeight   float64 `json:",default=8"`   // 每次分享的热度分数
+		ViewWeight    float64 `json:",default=1"`   // 每次播放的热度分数
+		Decay         float64 `json:",default=0.5"` // 窗口内最早的时间桶的权重，越早的时间桶权重越低
+		MaxSize       int     `json:",default=300"` // 每个窗口排行榜的视频数量
+		CacheTTL      int     `json:",default=60"`  // 排行榜缓存时间（秒），过期后重新合并时间桶
+		ListLimit     int     `json:",default=20"`  // 每次获取排行榜视频的数量
+	}
+	WorkerId uint32
 }
 
 type DbConfig struct {
@@ -59,4 +118,12 @@ type CacheConfig struct {
 	VIDEO_MAX_CACHE_SIZE          int
 	VIDEO_FAVORITE_MAX_CACHE_SIZE int
 	VIDEO_COMMENT_MAX_CACHE_SIZE  int
+	BLOCK_CACHE_TTL               int `json:",default=3600"`
+	REPORT_CACHE_TTL              int `json:",default=3600"`
+	TOPIC_VIDEO_CACHE_TTL         int `json:",default=43200"`
+	TOPIC_VIDEO_MAX_CACHE_SIZE    int `json:",default=300"`
+	TOPIC_HOT_CACHE_TTL           int `json:",default=300"`
+	TOPIC_HOT_MAX_CACHE_SIZE      int `json:",default=300"`
+	VIEW_CACHE_TTL                int `json:",default=43200"`
+	SHARE_CACHE_TTL               int `json:",default=43200"`
 }
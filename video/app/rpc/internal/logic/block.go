@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+// hiddenUserIds 获取对用户隐藏视频与评论的用户 id 集合（因被举报而自动隐藏的账号，双方存在拉黑关系，或被用户屏蔽），
+// 先查缓存，缓存不存在则从 DB 加载并写入缓存；未登录用户（userid 为 0）只隐藏被举报隐藏的账号
+// 用户自己的内容总是可见的
+func hiddenUserIds(svcCtx *svc.ServiceContext, conn redis.Conn, userid uint64) (map[uint64]struct{}, error) {
+	ids, exists, err := svcCtx.Redis.GetReportHiddenUsers(conn)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		ids, err = model.HiddenUserIdList(svcCtx.Db)
+		if err != nil {
+			return nil, err
+		}
+		err = svcCtx.Redis.SetReportHiddenUsers(conn, ids, svcCtx.Config.CacheConfig.REPORT_CACHE_TTL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if userid != 0 {
+		blockIds, exists, err := svcCtx.Redis.GetHiddenUsers(conn, userid)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			blockIds, err = model.BlockIdList(svcCtx.Db, userid)
+			if err != nil {
+				return nil, err
+			}
+			muteIds, err := model.MuteIdList(svcCtx.Db, userid)
+			if err != nil {
+				return nil, err
+			}
+			err = svcCtx.Redis.SetBlockCache(conn, userid, blockIds, muteIds, svcCtx.Config.CacheConfig.BLOCK_CACHE_TTL)
+			if err != nil {
+				return nil, err
+			}
+			blockIds = append(blockIds, muteIds...)
+		}
+		ids = append(ids, blockIds...)
+	}
+
+	hidden := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		if id != model.BlockCacheEmptyMember && id != userid {
+			hidden[id] = struct{}{}
+		}
+	}
+	return hidden, nil
+}
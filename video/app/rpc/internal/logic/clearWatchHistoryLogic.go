@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClearWatchHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewClearWatchHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClearWatchHistoryLogic {
+	return &ClearWatchHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ClearWatchHistory 清空用户的观看历史，同时清空缓存与 DB 中归档的记录
+func (l *ClearWatchHistoryLogic) ClearWatchHistory(in *video.ClearWatchHistoryReq) (*video.WatchHistoryActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.WatchHistoryActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	err = l.svcCtx.Redis.ClearHistory(conn, userid)
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Db.Where("user_id = ?", userid).Delete(&model.WatchHistory{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.WatchHistoryActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"gorm.io/gorm"
+	"strings"
+	"unicode/utf8"
+)
+
+// toVideoCollection 将合集表结构转换为视频服务返回的 Collection
+func toVideoCollection(c *model.Collection) *video.Collection {
+	return &video.Collection{
+		Id:         c.Id,
+		UserId:     c.UserId,
+		Name:       c.Name,
+		IsPublic:   c.IsPublic(),
+		VideoCount: c.VideoCount,
+		CreateTime: c.CreateTime,
+		UpdateTime: c.UpdateTime,
+	}
+}
+
+// checkCollectionName 检查合集名称，名称去除首尾空白后不能为空或超过最大长度，
+// 命中拒绝类敏感词时不通过，屏蔽类敏感词替换为 *
+func checkCollectionName(svcCtx *svc.ServiceContext, name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > svcCtx.Config.CollectionConfig.NameMaxLength {
+		return "", false
+	}
+	check := svcCtx.Moderation.Check(name)
+	if check.Action == moderation.ACTION_REJECT {
+		return "", false
+	}
+	return check.Text, true
+}
+
+// getOwnCollection 获取用户自己的合集，合集不存在或不属于该用户时返回 nil
+func getOwnCollection(db *gorm.DB, userid, collectionId uint64) (*model.Collection, error) {
+	var c model.Collection
+	err := db.Where("id = ? and user_id = ?", collectionId, userid).Take(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// removeVideoFromCollections 将视频从所有合集中移除，并更新这些合集的视频数量
+func removeVideoFromCollections(svcCtx *svc.ServiceContext, videoId uint64) error {
+	return svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		var collectionIds []uint64
+		err := tx.Model(&model.CollectionVideo{}).Where("video_id = ?", videoId).Pluck("collection_id", &collectionIds).Error
+		if err != nil {
+			return err
+		}
+		if len(collectionIds) == 0 {
+			return nil
+		}
+		err = tx.Where("video_id = ?", videoId).Delete(&model.CollectionVideo{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&model.Collection{}).Where("id in ?", collectionIds).
+			Update("video_count", gorm.Expr("greatest(video_count - 1, 0)")).Error
+	})
+}
@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"github.com/ncghost1/snowflake-go"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCollectionActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionActionLogic {
+	return &CollectionActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CollectionAction 创建、修改（重命名与设置公开状态）或删除用户的合集，删除合集时同时删除合集中的视频记录
+func (l *CollectionActionLogic) CollectionAction(in *video.CollectionActionReq) (*video.CollectionActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if in.Status != "" && in.Status != model.CollectionPrivate && in.Status != model.CollectionPublic {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	switch in.ActionType {
+	case COLLECTION_CREATE:
+		name, ok := checkCollectionName(l.svcCtx, in.Name)
+		if !ok {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COLLECTION_NAME_MSG,
+			}, nil
+		}
+		status := in.Status
+		if status == "" {
+			status = model.CollectionPrivate
+		}
+
+		var count int64
+		err = l.svcCtx.Db.Model(&model.Collection{}).Where("user_id = ?", userid).Count(&count).Error
+		if err != nil {
+			return nil, err
+		}
+		if count >= int64(l.svcCtx.Config.CollectionConfig.MaxCount) {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COLLECTION_LIMIT_MSG,
+			}, nil
+		}
+
+		// 新合集排在用户合集列表的最后
+		var maxSort int64
+		err = l.svcCtx.Db.Model(&model.Collection{}).Where("user_id = ?", userid).
+			Select("coalesce(max(sort), 0)").Scan(&maxSort).Error
+		if err != nil {
+			return nil, err
+		}
+
+		sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+		if err != nil {
+			return nil, err
+		}
+		collectionId, err := sf.Generate() // 使用雪花算法生成合集id
+		if err != nil {
+			return nil, err
+		}
+		c := &model.Collection{
+			Id:         collectionId,
+			UserId:     userid,
+			Name:       name,
+			Status:     status,
+			Sort:       maxSort + 1,
+			CreateTime: now,
+			UpdateTime: now,
+		}
+		err = l.svcCtx.Db.Create(c).Error
+		if err != nil {
+			return nil, err
+		}
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+			Collection: toVideoCollection(c),
+		}, nil
+
+	case COLLECTION_UPDATE:
+		c, err := getOwnCollection(l.svcCtx.Db, userid, in.CollectionId)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COLLECTION_MSG,
+			}, nil
+		}
+
+		if in.Name != "" {
+			name, ok := checkCollectionName(l.svcCtx, in.Name)
+			if !ok {
+				return &video.CollectionActionResp{
+					StatusCode: STATUS_FAIL,
+					StatusMsg:  STATUS_COLLECTION_NAME_MSG,
+				}, nil
+			}
+			c.Name = name
+		}
+		if in.Status != "" {
+			c.Status = in.Status
+		}
+		c.UpdateTime = now
+		err = l.svcCtx.Db.Model(&model.Collection{}).Where("id = ?", c.Id).Updates(map[string]interface{}{
+			"name":        c.Name,
+			"status":      c.Status,
+			"update_time": c.UpdateTime,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+			Collection: toVideoCollection(c),
+		}, nil
+
+	case COLLECTION_DELETE:
+		c, err := getOwnCollection(l.svcCtx.Db, userid, in.CollectionId)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COLLECTION_MSG,
+			}, nil
+		}
+		err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Where("collection_id = ?", c.Id).Delete(&model.CollectionVideo{}).Error
+			if err != nil {
+				return err
+			}
+			return tx.Where("id = ?", c.Id).Delete(&model.Collection{}).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+		}, nil
+	}
+
+	return &video.CollectionActionResp{
+		StatusCode: STATUS_FAIL,
+		StatusMsg:  STATUS_FAIL_PARAM_MSG,
+	}, nil
+}
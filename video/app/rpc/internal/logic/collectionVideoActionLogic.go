@@ -0,0 +1,119 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollectionVideoActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCollectionVideoActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollectionVideoActionLogic {
+	return &CollectionVideoActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CollectionVideoAction 向用户的合集添加或移除视频，只能添加公开并且对当前用户可见的视频（作者可以添加自己未公开的视频）
+func (l *CollectionVideoActionLogic) CollectionVideoAction(in *video.CollectionVideoActionReq) (*video.CollectionActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || (in.ActionType != COLLECTION_VIDEO_ADD && in.ActionType != COLLECTION_VIDEO_REMOVE) {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	c, err := getOwnCollection(l.svcCtx.Db, userid, in.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_COLLECTION_MSG,
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	if in.ActionType == COLLECTION_VIDEO_ADD {
+		var v model.Video
+		err = l.svcCtx.Db.Where(&model.Video{Id: in.VideoId}).Take(&v).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		if err == gorm.ErrRecordNotFound || (!v.IsPublic() && v.UserId != userid) {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_VIDEO_MSG,
+			}, nil
+		}
+		canView, err := canViewVideo(l.ctx, l.svcCtx, userid, &v)
+		if err != nil {
+			return nil, err
+		}
+		if !canView {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_VIDEO_MSG,
+			}, nil
+		}
+		if c.VideoCount >= int64(l.svcCtx.Config.CollectionConfig.MaxVideoCount) {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_COLLECTION_FULL_MSG,
+			}, nil
+		}
+	}
+
+	// 合集视频表上有 (collection_id, video_id) 主键，重复添加或移除不存在的视频时不更新视频数量
+	err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		var res *gorm.DB
+		delta := int64(1)
+		if in.ActionType == COLLECTION_VIDEO_ADD {
+			res = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CollectionVideo{
+				CollectionId: c.Id,
+				VideoId:      in.VideoId,
+				CreateTime:   now,
+			})
+		} else {
+			res = tx.Where("collection_id = ? and video_id = ?", c.Id, in.VideoId).Delete(&model.CollectionVideo{})
+			delta = -1
+		}
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		c.VideoCount += delta
+		c.UpdateTime = now
+		return tx.Model(&model.Collection{}).Where("id = ?", c.Id).Updates(map[string]interface{}{
+			"video_count": gorm.Expr("video_count + ?", delta),
+			"update_time": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.CollectionActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		Collection: toVideoCollection(c),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package logic
 
 import (
+	"Mini-Tiktok/common/moderation"
 	"Mini-Tiktok/user/app/rpc/userrpc"
 	"Mini-Tiktok/video/app/rpc/model"
 	"context"
@@ -46,6 +47,15 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 
 	switch in.ActionType {
 	case COMMENT_UPDATE: // 评论操作
+		// 敏感词检查：命中拒绝类敏感词直接返回，屏蔽类敏感词替换为 *
+		check := l.svcCtx.Moderation.Check(in.Content)
+		if check.Action == moderation.ACTION_REJECT {
+			return &video.CommentResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_SENSITIVE_MSG,
+			}, nil
+		}
+
 		createTime := time.Now().Unix()
 		sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
 		if err != nil {
@@ -59,8 +69,9 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 			Id:         commentId,
 			UserId:     userid,
 			VideoId:    videoId,
-			Content:    in.Content,
+			Content:    check.Text,
 			CreateTime: createTime,
+			Status:     model.CommentStatusVisible,
 		}
 		commentJson, err := json.Marshal(comment)
 		if err != nil {
@@ -73,6 +84,14 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 			return nil, err
 		}
 
+		// 命中审核类敏感词，记录到待审核列表，记录失败不影响评论结果
+		if check.Action == moderation.ACTION_REVIEW {
+			err = moderation.FlagForReview(l.svcCtx.Db, l.svcCtx.Config.WorkerId, moderation.FLAG_COMMENT, commentId, userid, check.Text, check.Words)
+			if err != nil {
+				l.Errorf("flag comment for review: %v", err)
+			}
+		}
+
 		// 再更新缓存
 		// （为什么不是删缓存？因为评论 id 保证唯一，不涉及冲突创建同个评论信息）
 		// （这个项目只有创建和删除评论操作，但是如果说有更新评论的操作则需要删缓存）
@@ -83,6 +102,24 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 			return nil, err
 		}
 
+		// 更新热门排行榜，失败不影响评论结果
+		err = addTrendingScore(l.svcCtx, conn, videoId, l.svcCtx.Config.TrendingConfig.CommentWeight)
+		if err != nil {
+			l.Errorf("add trending score: %v", err)
+		}
+
+		// 通知视频作者（自己评论自己的视频不通知），推送失败不影响评论结果
+		var authorId uint64
+		err = l.svcCtx.Db.Model(&model.Video{}).Select("user_id").Where(&model.Video{Id: videoId}).Take(&authorId).Error
+		if err != nil {
+			l.Errorf("query video author: %v", err)
+		} else if authorId != userid {
+			err = pushEvent(l.ctx, l.svcCtx, model.PushEventComment, authorId, &comment)
+			if err != nil {
+				l.Errorf("push comment event: %v", err)
+			}
+		}
+
 		r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
 			UserID:  in.UserId,
 			QueryID: in.UserId,
@@ -103,16 +140,10 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 			StatusCode: STATUS_SUCCESS,
 			StatusMsg:  STATUS_SUCCESS_MSG,
 			Comment: &video.Comment{
-				Content:    in.Content,
+				Content:    comment.Content,
 				CreateDate: createDate,
 				ID:         commentId,
-				User: &video.User{
-					FollowCount:   user.FollowCount,
-					FollowerCount: user.FollowerCount,
-					ID:            user.ID,
-					IsFollow:      user.IsFollow,
-					Name:          user.Name,
-				},
+				User:       toVideoUser(user),
 			},
 		}, nil
 	case COMMENT_DELETE: // 删除评论操作
@@ -136,6 +167,12 @@ func (l *CommentActionLogic) CommentAction(in *video.CommentReq) (*video.Comment
 			return nil, err
 		}
 
+		// 更新热门排行榜，失败不影响删除评论结果
+		err = addTrendingScore(l.svcCtx, conn, videoId, -l.svcCtx.Config.TrendingConfig.CommentWeight)
+		if err != nil {
+			l.Errorf("add trending score: %v", err)
+		}
+
 		return &video.CommentResp{
 			StatusCode: STATUS_SUCCESS,
 			StatusMsg:  STATUS_SUCCESS_MSG,
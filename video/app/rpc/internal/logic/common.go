@@ -1,17 +1,47 @@
 package logic
 
 const (
-	STATUS_SUCCESS        = "0"
-	STATUS_SUCCESS_MSG    = "OK"
-	STATUS_FAIL           = "1"
-	STATUS_FAIL_PARAM_MSG = "Request parameter error"
-	COMMENT_UPDATE        = "1"
-	COMMENT_DELETE        = "2"
-	FAVORITE_UPDATE       = "1"
-	FAVORITE_DELETE       = "2"
-	OP_INSERT             = "insert"
-	OP_DELETE             = "delete"
-	MODEL_FAVORITE        = "favorite"
-	EMPTY_NEXT_TIME       = int64(0)
-	COUNT_NOT_FOUND       = int64(-1)
+	STATUS_SUCCESS              = "0"
+	STATUS_SUCCESS_MSG          = "OK"
+	STATUS_FAIL                 = "1"
+	STATUS_FAIL_PARAM_MSG       = "Request parameter error"
+	STATUS_SENSITIVE_MSG        = "Comment contains sensitive words"
+	STATUS_REVIEW_MSG           = "Video is not in a reviewable state"
+	STATUS_REPORT_DUP_MSG       = "You have already reported it"
+	STATUS_REPORT_TARGET_MSG    = "Report target does not exist"
+	STATUS_REPORT_SELF_MSG      = "Cannot report your own content"
+	STATUS_REPORT_RESTORE_MSG   = "Report target is not hidden"
+	STATUS_PERMISSION_MSG       = "Permission denied"
+	STATUS_TOPIC_MSG            = "Topic does not exist"
+	STATUS_NOT_AUTHOR_MSG       = "Only the author can view the statistics"
+	STATUS_COLLECTION_MSG       = "Collection does not exist"
+	STATUS_COLLECTION_NAME_MSG  = "Collection name is empty, too long or contains sensitive words"
+	STATUS_COLLECTION_LIMIT_MSG = "Too many collections"
+	STATUS_COLLECTION_FULL_MSG  = "Collection is full"
+	STATUS_VIDEO_MSG            = "Video does not exist"
+	STATUS_SHARE_TOKEN_MSG      = "Share link is invalid"
+	STATUS_DRAFT_MSG            = "Draft does not exist"
+	STATUS_PUBLISH_TIME_MSG     = "Publish time must be in the future and within the allowed range"
+	STATUS_NOT_DUPLICATE_MSG    = "Video is not a duplicate upload"
+	COMMENT_UPDATE              = "1"
+	COMMENT_DELETE              = "2"
+	FAVORITE_UPDATE             = "1"
+	FAVORITE_DELETE             = "2"
+	COLLECTION_CREATE           = "1"
+	COLLECTION_UPDATE           = "2"
+	COLLECTION_DELETE           = "3"
+	COLLECTION_VIDEO_ADD        = "1"
+	COLLECTION_VIDEO_REMOVE     = "2"
+	DRAFT_PUBLISH               = "1"
+	DRAFT_SCHEDULE              = "2"
+	DRAFT_UNSCHEDULE            = "3"
+	DRAFT_DELETE                = "4"
+	REVIEW_APPROVE              = "1"
+	REVIEW_REJECT               = "2"
+	REVIEW_TAKE_DOWN            = "3"
+	OP_INSERT                   = "insert"
+	OP_DELETE                   = "delete"
+	MODEL_FAVORITE              = "favorite"
+	EMPTY_NEXT_TIME             = int64(0)
+	COUNT_NOT_FOUND             = int64(-1)
 )
@@ -0,0 +1,27 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/video"
+)
+
+// toVideoUser 将用户服务返回的 User 转换为视频服务返回的 User
+func toVideoUser(u *userrpc.User) *video.User {
+	if u == nil {
+		return nil
+	}
+	return &video.User{
+		FollowCount:     u.FollowCount,
+		FollowerCount:   u.FollowerCount,
+		ID:              u.ID,
+		IsFollow:        u.IsFollow,
+		Name:            u.Name,
+		Nickname:        u.Nickname,
+		Avatar:          u.Avatar,
+		BackgroundImage: u.BackgroundImage,
+		Signature:       u.Signature,
+		TotalFavorited:  u.TotalFavorited,
+		WorkCount:       u.WorkCount,
+		FavoriteCount:   u.FavoriteCount,
+	}
+}
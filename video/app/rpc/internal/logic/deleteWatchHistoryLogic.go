@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWatchHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteWatchHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWatchHistoryLogic {
+	return &DeleteWatchHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteWatchHistory 删除一条观看记录，同时删除缓存与 DB 中归档的记录
+func (l *DeleteWatchHistoryLogic) DeleteWatchHistory(in *video.DeleteWatchHistoryReq) (*video.WatchHistoryActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.WatchHistoryActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	err = l.svcCtx.Redis.DelHistory(conn, userid, in.VideoId)
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Db.Where("user_id = ? and video_id = ?", userid, in.VideoId).Delete(&model.WatchHistory{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.WatchHistoryActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
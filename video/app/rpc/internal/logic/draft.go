@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"encoding/json"
+	"github.com/gomodule/redigo/redis"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// unpublishedStates 草稿与定时发布两种未发布状态
+var unpublishedStates = []string{model.VideoPublishStateDraft, model.VideoPublishStateScheduled}
+
+// toVideoDraft 将视频表结构转换为视频服务返回的 Draft
+func toVideoDraft(v *model.Video) *video.Draft {
+	return &video.Draft{
+		ID:           v.Id,
+		Title:        v.Title,
+		PlayURL:      v.PlayUrl,
+		CoverURL:     v.CoverUrl,
+		Status:       v.Status,
+		ReviewReason: v.ReviewReason,
+		Visibility:   v.Visibility,
+		PublishState: v.PublishState,
+		PublishTime:  v.PublishTime,
+		CreateTime:   v.CreateTime,
+	}
+}
+
+// publishVideo 发布草稿或定时发布的视频，以发布时间作为视频的投稿时间，使视频出现在 Feed 流的最前面
+// 带上原发布状态条件更新，作者手动发布与定时任务（或多个定时任务实例）同时发布时只有一方成功，返回 false 表示视频已被发布或删除
+// 审核通过的视频在同一个事务中更新话题视频数，之后加入 Feed 流、发布列表与话题缓存，未审核通过的视频由审核通过时加入
+// 事务中同时写入缓存更新任务，缓存更新失败时只记录日志，由定时任务 RetryCacheTasks 重试，不影响发布结果
+func publishVideo(ctx context.Context, svcCtx *svc.ServiceContext, conn redis.Conn, v *model.Video) (bool, error) {
+	now := time.Now().Unix()
+	oldCreateTime := v.CreateTime
+	published := false
+	err := svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Video{}).Where("id = ? and publish_state in ?", v.Id, unpublishedStates).Updates(map[string]interface{}{
+			"publish_state": model.VideoPublishStatePublished,
+			"publish_time":  now,
+			"create_time":   now,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		err := tx.Model(&model.VideoTopic{}).Where("video_id = ?", v.Id).Update("create_time", now).Error
+		if err != nil {
+			return err
+		}
+		// 重新读取视频，使用最新的审核状态
+		err = tx.Where("id = ?", v.Id).Take(v).Error
+		if err != nil {
+			return err
+		}
+		if v.Status == model.VideoStatusApproved {
+			_, err = updateTopicVideoCount(tx, v.Id, 1)
+			if err != nil {
+				return err
+			}
+		}
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.VideoCacheTask{VideoId: v.Id, CreateTime: now}).Error
+		if err != nil {
+			return err
+		}
+		published = true
+		return nil
+	})
+	if err != nil || !published {
+		return false, err
+	}
+
+	logger := logx.WithContext(ctx)
+	err = syncPublishedVideoCache(svcCtx, conn, v, oldCreateTime)
+	if err != nil {
+		logger.Errorf("sync cache of published video %d, retry later: %v", v.Id, err)
+	} else if err = svcCtx.Db.Delete(&model.VideoCacheTask{}, "video_id = ?", v.Id).Error; err != nil {
+		logger.Errorf("delete cache task of video %d: %v", v.Id, err)
+	}
+
+	// 更新搜索索引，失败只记录日志
+	err = searchVideoEvent(ctx, svcCtx, v)
+	if err != nil {
+		logger.Errorf("write search event: %v", err)
+	}
+	return true, nil
+}
+
+// syncPublishedVideoCache 按 DB 中已发布的视频更新缓存，重复执行的结果相同，可以在失败后重试
+// 审核通过的视频加入 Feed 流、发布列表与话题缓存，作品数缓存删除后由用户服务重新统计；
+// 未审核通过的视频可能因作者查看草稿而写入了视频信息缓存，删除后由下次查询重新加载
+func syncPublishedVideoCache(svcCtx *svc.ServiceContext, conn redis.Conn, v *model.Video, oldCreateTime int64) error {
+	if v.Status != model.VideoStatusApproved {
+		return svcCtx.Redis.DelVideoInfoAndFeed(conn, v.UserId, v.Id, oldCreateTime)
+	}
+
+	videoJson, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	err = svcCtx.Redis.AddVideoInfoAndFeed(conn, v.UserId, v.Id, videoJson, v.CreateTime, svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+	if err != nil {
+		return err
+	}
+	err = svcCtx.Redis.DelWorkCount(conn, v.UserId)
+	if err != nil {
+		return err
+	}
+
+	var topicIds []uint64
+	err = svcCtx.Db.Model(&model.VideoTopic{}).Where("video_id = ?", v.Id).Pluck("topic_id", &topicIds).Error
+	if err != nil {
+		return err
+	}
+	if len(topicIds) == 0 {
+		return nil
+	}
+	return svcCtx.Redis.AddTopicVideo(conn, topicIds, v.Id, v.CreateTime, svcCtx.Config.CacheConfig.TOPIC_VIDEO_MAX_CACHE_SIZE)
+}
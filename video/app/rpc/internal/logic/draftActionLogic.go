@@ -0,0 +1,153 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/fingerprint"
+	"Mini-Tiktok/common/moderation"
+	"Mini-Tiktok/common/schedule"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DraftActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDraftActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DraftActionLogic {
+	return &DraftActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DraftAction 作者操作草稿或定时发布的视频：立即发布，定时发布（或修改发布时间），取消定时发布（转为草稿），删除
+// 所有操作都带上原发布状态条件更新，视频已被定时任务发布时返回草稿不存在
+func (l *DraftActionLogic) DraftAction(in *video.DraftActionReq) (*video.DraftActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.DraftActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var v model.Video
+	err = l.svcCtx.Db.Where("id = ? and user_id = ? and publish_state in ?", in.VideoId, userid, unpublishedStates).Take(&v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.DraftActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_DRAFT_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	ok := false
+	switch in.ActionType {
+	case DRAFT_PUBLISH:
+		ok, err = publishVideo(l.ctx, l.svcCtx, conn, &v)
+	case DRAFT_SCHEDULE:
+		if !schedule.IsValidPublishTime(in.PublishTime, time.Now().Unix()) {
+			return &video.DraftActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_PUBLISH_TIME_MSG,
+			}, nil
+		}
+		// 发布时间没有变化时条件更新的 RowsAffected 为 0，直接返回成功
+		if v.PublishState == model.VideoPublishStateScheduled && v.PublishTime == in.PublishTime {
+			ok = true
+			break
+		}
+		res := l.svcCtx.Db.Model(&model.Video{}).Where("id = ? and publish_state in ?", v.Id, unpublishedStates).Updates(map[string]interface{}{
+			"publish_state": model.VideoPublishStateScheduled,
+			"publish_time":  in.PublishTime,
+		})
+		ok, err = res.RowsAffected > 0, res.Error
+	case DRAFT_UNSCHEDULE:
+		res := l.svcCtx.Db.Model(&model.Video{}).Where("id = ? and publish_state = ?", v.Id, model.VideoPublishStateScheduled).Updates(map[string]interface{}{
+			"publish_state": model.VideoPublishStateDraft,
+			"publish_time":  0,
+		})
+		ok, err = res.RowsAffected > 0, res.Error
+	case DRAFT_DELETE:
+		ok, err = l.deleteDraft(&v)
+		if ok && err == nil {
+			err = l.svcCtx.Redis.DelVideoInfoAndFeed(conn, v.UserId, v.Id, v.CreateTime)
+		}
+	default:
+		return &video.DraftActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.DraftActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_DRAFT_MSG,
+		}, nil
+	}
+
+	return &video.DraftActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
+
+// deleteDraft 删除草稿及其话题对应关系、待审核记录、指纹与合集中的收藏
+// 草稿在搜索索引中不可见，不需要更新索引；OSS 上的视频文件不在这里删除
+func (l *DraftActionLogic) deleteDraft(v *model.Video) (bool, error) {
+	deleted := false
+	err := l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? and publish_state in ?", v.Id, unpublishedStates).Delete(&model.Video{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		err := tx.Where("video_id = ?", v.Id).Delete(&model.VideoTopic{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("type in ? and target_id = ?", []string{moderation.FLAG_TITLE, moderation.FLAG_DUPLICATE}, v.Id).
+			Delete(&moderation.Flag{}).Error
+		if err != nil {
+			return err
+		}
+		// 删除指纹索引与重复投稿记录，以该视频为原视频的重复投稿记录保留
+		err = tx.Where("video_id = ?", v.Id).Delete(&fingerprint.VideoFingerprint{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("video_id = ?", v.Id).Delete(&fingerprint.VideoAudioFingerprint{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("video_id = ?", v.Id).Delete(&fingerprint.VideoDuplicate{}).Error
+		if err != nil {
+			return err
+		}
+		deleted = true
+		return nil
+	})
+	if err != nil || !deleted {
+		return false, err
+	}
+	return true, removeVideoFromCollections(l.svcCtx, v.Id)
+}
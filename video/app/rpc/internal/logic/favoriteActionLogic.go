@@ -82,6 +82,18 @@ func (l *FavoriteActionLogic) FavoriteAction(in *video.FavoriteReq) (*video.Favo
 			return nil, err
 		}
 
+		// 通知视频作者（自己点赞自己的视频不通知），推送失败不影响点赞结果
+		var authorId uint64
+		err = l.svcCtx.Db.Model(&model.Video{}).Select("user_id").Where(&model.Video{Id: videoId}).Take(&authorId).Error
+		if err != nil {
+			l.Errorf("query video author: %v", err)
+		} else if authorId != userid {
+			err = pushEvent(l.ctx, l.svcCtx, model.PushEventFavorite, authorId, &model.FavoriteEventData{UserId: userid, VideoId: videoId})
+			if err != nil {
+				l.Errorf("push favorite event: %v", err)
+			}
+		}
+
 	case FAVORITE_DELETE: // 取消点赞
 		favorite := model.Favorite{
 			UserId:  userid,
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCollectionListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCollectionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCollectionListLogic {
+	return &GetCollectionListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCollectionList 获取用户的合集列表，按用户设置的顺序排序，查看他人的合集时只返回公开合集
+func (l *GetCollectionListLogic) GetCollectionList(in *video.CollectionListReq) (*video.CollectionListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	queryId, err2 := strconv.ParseUint(in.QueryUserId, 10, 64)
+	if err != nil || err2 != nil {
+		return &video.CollectionListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	query := l.svcCtx.Db.Where("user_id = ?", queryId)
+	if userid != queryId {
+		query = query.Where("status = ?", model.CollectionPublic)
+	}
+	var collections []model.Collection
+	err = query.Order("sort, create_time").Find(&collections).Error
+	if err != nil {
+		return nil, err
+	}
+
+	collectionList := make([]*video.Collection, len(collections))
+	for i := range collections {
+		collectionList[i] = toVideoCollection(&collections[i])
+	}
+
+	return &video.CollectionListResp{
+		StatusCode:     STATUS_SUCCESS,
+		StatusMsg:      STATUS_SUCCESS_MSG,
+		CollectionList: collectionList,
+	}, nil
+}
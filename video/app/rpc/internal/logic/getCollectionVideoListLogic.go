@@ -0,0 +1,114 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCollectionVideoListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCollectionVideoListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCollectionVideoListLogic {
+	return &GetCollectionVideoListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCollectionVideoList 分页获取合集中的视频，按加入合集的时间从新到旧排序，私密合集只有创建者可见
+// 视频信息与点赞列表相同，先查缓存再查数据库，然后通过 toVideoList 补充作者与计数信息
+func (l *GetCollectionVideoListLogic) GetCollectionVideoList(in *video.CollectionVideoListReq) (*video.CollectionVideoListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || in.Cursor < 0 {
+		return &video.CollectionVideoListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var c model.Collection
+	err = l.svcCtx.Db.Where("id = ?", in.CollectionId).Take(&c).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if err == gorm.ErrRecordNotFound || (!c.IsPublic() && c.UserId != userid) {
+		return &video.CollectionVideoListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_COLLECTION_MSG,
+		}, nil
+	}
+
+	limit := l.svcCtx.Config.CollectionConfig.ListLimit
+	var items []model.CollectionVideo
+	err = l.svcCtx.Db.Where("collection_id = ?", c.Id).Order("create_time desc, video_id desc").
+		Offset(int(in.Cursor)).Limit(limit).Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	modelVideoList := make([]*model.Video, 0, len(items))
+	removed := 0 // 本页中已被删除的视频数量，移除后后续记录的偏移量会减少
+	for _, item := range items {
+		info, exists, err := l.svcCtx.Redis.GetExVideoInfo(conn, item.VideoId, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+		if err != nil {
+			return nil, err
+		}
+		var v *model.Video
+		if exists {
+			err = json.Unmarshal(info, &v)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			err = l.svcCtx.Db.Where(&model.Video{Id: item.VideoId}).Take(&v).Error
+			if err != nil {
+				if err == gorm.ErrRecordNotFound {
+					// 视频已被删除，将其从所有合集中移除
+					err = removeVideoFromCollections(l.svcCtx, item.VideoId)
+					if err != nil {
+						return nil, err
+					}
+					c.VideoCount--
+					removed++
+					continue
+				}
+				return nil, err
+			}
+		}
+		modelVideoList = append(modelVideoList, v)
+	}
+
+	videoList, err := toVideoList(l.ctx, l.svcCtx, conn, userid, modelVideoList)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.CollectionVideoListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		Collection: toVideoCollection(&c),
+		VideoList:  videoList,
+		NextCursor: in.Cursor + int64(len(items)-removed),
+		HasMore:    len(items) == limit,
+	}, nil
+}
@@ -71,7 +71,7 @@ func (l *GetCommentListLogic) GetCommentList(in *video.CommentListReq) (*video.C
 	if !exists || remain == l.svcCtx.Config.CacheConfig.VIDEO_COMMENT_MAX_CACHE_SIZE {
 		// 从数据库查找比缓存评论要早的评论
 		var comList []model.Comment
-		err = l.svcCtx.Db.Where("video_id = ? and create_time <= ?", videoId, latestTime).Find(&comList).Error
+		err = l.svcCtx.Db.Where("video_id = ? and create_time <= ? and status = ?", videoId, latestTime, model.CommentStatusVisible).Find(&comList).Error
 		if err != nil {
 			return nil, err
 		}
@@ -98,7 +98,18 @@ func (l *GetCommentListLogic) GetCommentList(in *video.CommentListReq) (*video.C
 		modelComList = append(modelComList, comList...)
 	}
 
+	// 过滤被举报隐藏的账号，与用户存在拉黑关系或被用户屏蔽的用户的评论（未登录时 UserId 无法解析，只过滤被举报隐藏的账号）
+	userid, _ := strconv.ParseUint(in.UserId, 10, 64)
+	hidden, err := hiddenUserIds(l.svcCtx, conn, userid)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, v := range modelComList {
+		if _, ok := hidden[v.UserId]; ok || v.Status == model.CommentStatusHidden {
+			continue
+		}
+
 		// 通过评论的 userid 查 user 信息
 		queryUserId := strconv.FormatUint(v.UserId, 10)
 		r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{UserID: in.UserId, QueryID: queryUserId})
@@ -114,13 +125,7 @@ func (l *GetCommentListLogic) GetCommentList(in *video.CommentListReq) (*video.C
 
 		var u *video.User
 		if r.StatusCode == STATUS_SUCCESS {
-			u = &video.User{
-				FollowCount:   r.User.FollowCount,
-				FollowerCount: r.User.FollowerCount,
-				ID:            r.User.ID,
-				IsFollow:      r.User.IsFollow,
-				Name:          r.User.Name,
-			}
+			u = toVideoUser(r.User)
 		} else {
 			u = nil
 		}
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/creatorstat"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const STAT_DATE_LAYOUT = "2006-01-02"
+
+type GetCreatorStatsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCreatorStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCreatorStatsLogic {
+	return &GetCreatorStatsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCreatorStats 获取视频或账号在日期范围内的每日统计，只有作者本人可以查询
+// 每日统计由视频服务的消费者定时汇总，当天的数据会有延迟
+func (l *GetCreatorStatsLogic) GetCreatorStats(in *video.CreatorStatsReq) (*video.CreatorStatsResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.CreatorStatsResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	start, err1 := time.ParseInLocation(STAT_DATE_LAYOUT, in.StartDate, time.Local)
+	end, err2 := time.ParseInLocation(STAT_DATE_LAYOUT, in.EndDate, time.Local)
+	if err1 != nil || err2 != nil || end.Before(start) || end.Sub(start) >= time.Duration(l.svcCtx.Config.StatConfig.MaxDays)*24*time.Hour {
+		return &video.CreatorStatsResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	targetType, targetId := creatorstat.TARGET_USER, userid
+	if in.VideoId != 0 {
+		var authorId uint64
+		err = l.svcCtx.Db.Model(&model.Video{}).Select("user_id").Where("id = ?", in.VideoId).Take(&authorId).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		if authorId != userid {
+			return &video.CreatorStatsResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_NOT_AUTHOR_MSG,
+			}, nil
+		}
+		targetType, targetId = creatorstat.TARGET_VIDEO, in.VideoId
+	}
+
+	var rows []creatorstat.DailyStat
+	err = l.svcCtx.Db.Where("target_type = ? and target_id = ? and date between ? and ?",
+		targetType, targetId, creatorstat.Date(start), creatorstat.Date(end)).Order("date").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 账号的累计值在没有数据的日期沿用前一天，范围内第一天之前的累计值从更早的记录获取
+	var last creatorstat.DailyStat
+	if targetType == creatorstat.TARGET_USER {
+		err = l.svcCtx.Db.Where("target_type = ? and target_id = ? and date < ?", targetType, targetId, creatorstat.Date(start)).
+			Order("date desc").Limit(1).Find(&last).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byDate := make(map[int]creatorstat.DailyStat, len(rows))
+	for _, r := range rows {
+		byDate[r.Date] = r
+	}
+	statList := make([]*video.DailyStat, 0, len(rows))
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		r, ok := byDate[creatorstat.Date(d)]
+		if ok {
+			last = r
+		} else {
+			r = creatorstat.DailyStat{FollowerTotal: last.FollowerTotal, LikeTotal: last.LikeTotal}
+		}
+		statList = append(statList, &video.DailyStat{
+			Date:          d.Format(STAT_DATE_LAYOUT),
+			Likes:         r.Likes,
+			Comments:      r.Comments,
+			Views:         r.Views,
+			Shares:        r.Shares,
+			NewFollowers:  r.NewFollowers,
+			Unfollows:     r.Unfollows,
+			FollowerTotal: r.FollowerTotal,
+			LikeTotal:     r.LikeTotal,
+		})
+	}
+
+	return &video.CreatorStatsResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		StatList:   statList,
+	}, nil
+}
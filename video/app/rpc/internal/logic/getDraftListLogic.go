@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDraftListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDraftListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDraftListLogic {
+	return &GetDraftListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDraftList 获取作者的草稿与定时发布的视频，按投稿时间倒序，草稿只有作者自己可见，直接查 DB
+func (l *GetDraftListLogic) GetDraftList(in *video.DraftListReq) (*video.DraftListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.DraftListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var list []model.Video
+	err = l.svcCtx.Db.Where("user_id = ? and publish_state in ?", userid, unpublishedStates).
+		Order("create_time DESC").Limit(l.svcCtx.Config.ScheduleConfig.DraftLimit).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	draftList := make([]*video.Draft, len(list))
+	for i := range list {
+		draftList[i] = toVideoDraft(&list[i])
+	}
+
+	return &video.DraftListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		DraftList:  draftList,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/fingerprint"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDuplicateOriginalLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDuplicateOriginalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDuplicateOriginalLogic {
+	return &GetDuplicateOriginalLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDuplicateOriginal 返回投稿时被检测为重复投稿的视频所对应的原视频与相似度，供人工审核（内部接口，权限检查与审计日志由管理服务完成，这里再检查一次操作人的角色）
+func (l *GetDuplicateOriginalLogic) GetDuplicateOriginal(in *video.DuplicateOriginalReq) (*video.DuplicateOriginalResp, error) {
+	ok, err := isModerator(l.svcCtx, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.DuplicateOriginalResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_PERMISSION_MSG,
+		}, nil
+	}
+
+	var duplicates []fingerprint.VideoDuplicate
+	err = l.svcCtx.Db.Where("video_id = ?", in.VideoId).Limit(1).Find(&duplicates).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(duplicates) == 0 {
+		return &video.DuplicateOriginalResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_NOT_DUPLICATE_MSG,
+		}, nil
+	}
+
+	var originals []model.Video
+	err = l.svcCtx.Db.Where("id = ?", duplicates[0].OriginalId).Limit(1).Find(&originals).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(originals) == 0 {
+		return &video.DuplicateOriginalResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_VIDEO_MSG,
+		}, nil
+	}
+	v := originals[0]
+
+	r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
+		UserID:  strconv.FormatUint(v.UserId, 10),
+		QueryID: strconv.FormatUint(v.UserId, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.DuplicateOriginalResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		Original: &video.Video{
+			Author:       toVideoUser(r.User),
+			CoverURL:     v.CoverUrl,
+			ID:           v.Id,
+			PlayURL:      v.PlayUrl,
+			Title:        v.Title,
+			Status:       v.Status,
+			ReviewReason: v.ReviewReason,
+		},
+		Score: duplicates[0].Score,
+	}, nil
+}
@@ -1,12 +1,10 @@
 package logic
 
 import (
-	"Mini-Tiktok/user/app/rpc/userrpc"
 	"Mini-Tiktok/video/app/rpc/model"
 	"Mini-Tiktok/video/app/rpc/videorpc"
 	"context"
 	"encoding/json"
-	"errors"
 	"gorm.io/gorm"
 	"strconv"
 	"time"
@@ -134,94 +132,9 @@ func (l *GetFavoriteListLogic) GetFavoriteList(in *video.FavoriteListReq) (*vide
 		modelVideoList = append(modelVideoList, videoInfo)
 	}
 
-	videoList := make([]*video.Video, len(modelVideoList))
-	for i, v := range modelVideoList {
-		// 使用视频作者 id 查作者信息
-		var userInfo *video.User
-		r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
-			UserID:  in.QueryUserId,
-			QueryID: strconv.FormatUint(v.UserId, 10),
-		})
-		if err != nil {
-			return nil, err
-		}
-
-		userInfo = &video.User{
-			FollowCount:   r.User.FollowCount,
-			FollowerCount: r.User.FollowerCount,
-			ID:            r.User.ID,
-			IsFollow:      r.User.IsFollow,
-			Name:          r.User.Name,
-		}
-
-		if r.StatusCode == STATUS_FAIL {
-			return nil, errors.New(r.StatusMsg)
-		}
-
-		// 一次性获取点赞数，评论数，用户是否点赞过视频的缓存数据
-		favCount, comCount, isFavor, err := l.svcCtx.Redis.GetExFavComCountIsFavor(conn, v.Id, userid,
-			l.svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL,
-			l.svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		// 如果缓存未找到，还需要查库，以下相同
-		if favCount == COUNT_NOT_FOUND {
-			err := l.svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{VideoId: v.Id}).Count(&favCount).Error
-			if err != nil {
-				return nil, err
-			}
-
-			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
-			err = l.svcCtx.Redis.SendSetExFavorCount(conn, v.Id, favCount, l.svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if comCount == COUNT_NOT_FOUND {
-			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id}).Count(&comCount).Error
-			if err != nil {
-				return nil, err
-			}
-
-			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
-			err = l.svcCtx.Redis.SendSetExCommentCount(conn, v.Id, comCount, l.svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if !isFavor {
-			var cnt int64
-			err = l.svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{UserId: userid, VideoId: v.Id}).Count(&cnt).Error
-			if err != nil {
-				return nil, err
-			}
-			if cnt > 0 {
-				isFavor = true
-			}
-		}
-
-		vid := &video.Video{
-			Author: &video.User{
-				FollowCount:   userInfo.FollowCount,
-				FollowerCount: userInfo.FollowerCount,
-				ID:            userInfo.ID,
-				IsFollow:      userInfo.IsFollow,
-				Name:          userInfo.Name,
-			},
-			CommentCount:  comCount,
-			CoverURL:      v.CoverUrl,
-			FavoriteCount: favCount,
-			ID:            v.Id,
-			IsFavorite:    isFavor,
-			PlayURL:       v.PlayUrl,
-			Title:         v.Title,
-		}
-		videoList[i] = vid
+	videoList, err := toVideoList(l.ctx, l.svcCtx, conn, userid, modelVideoList)
+	if err != nil {
+		return nil, err
 	}
 
 	err = conn.Flush()
@@ -73,7 +73,8 @@ func (l *GetFeedLogic) GetFeed(in *video.FeedReq) (*video.FeedResp, error) {
 			maxT = modelVideoList[len(modelVideoList)-1].CreateTime
 		}
 
-		err = l.svcCtx.Db.Find(&remainList, "create_time <= ?", maxT).Limit(remain).Error
+		err = l.svcCtx.Db.Where("create_time <= ? and status = ? and publish_state = ?", maxT, model.VideoStatusApproved, model.VideoPublishStatePublished).
+			Order("create_time desc").Limit(remain).Find(&remainList).Error
 		if err != nil {
 			if err != gorm.ErrRecordNotFound {
 				nextTime = EMPTY_NEXT_TIME // 剩余视频不足以完成下一次推送请求，提前将返回的 nextTime 设为 0（节省下一次需要查 DB 的消耗）
@@ -99,6 +100,54 @@ func (l *GetFeedLogic) GetFeed(in *video.FeedReq) (*video.FeedResp, error) {
 		nextTime = modelVideoList[len(modelVideoList)-1].CreateTime
 	}
 
+	// 过滤被举报隐藏的账号，与用户存在拉黑关系或被用户屏蔽的作者的视频（在计算 nextTime 之后过滤，不影响下一次推送的位置）
+	hidden, err := hiddenUserIds(l.svcCtx, conn, userid)
+	if err != nil {
+		return nil, err
+	}
+	if len(hidden) > 0 {
+		filtered := modelVideoList[:0]
+		for _, v := range modelVideoList {
+			if _, ok := hidden[v.UserId]; !ok {
+				filtered = append(filtered, v)
+			}
+		}
+		modelVideoList = filtered
+	}
+
+	// 过滤设置了可见范围且当前用户无权查看的视频
+	var authorIds []uint64
+	for _, v := range modelVideoList {
+		if v.IsRestricted() {
+			authorIds = append(authorIds, v.UserId)
+		}
+	}
+	viewer, err := newVideoViewer(l.ctx, l.svcCtx, userid, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	visible := modelVideoList[:0]
+	for i := range modelVideoList {
+		if viewer.CanView(&modelVideoList[i]) {
+			visible = append(visible, modelVideoList[i])
+		}
+	}
+	modelVideoList = visible
+
+	// 批量获取视频播放次数与分享数
+	videoIds := make([]uint64, len(modelVideoList))
+	for i, v := range modelVideoList {
+		videoIds[i] = v.Id
+	}
+	views, err := viewCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := shareCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+
 	// 3. 从 modelVideoList 中的 userid 获取 user 信息,以及通过 videoId 获取评论数，点赞数，用户是否点赞信息
 	videoList := make([]*video.Video, len(modelVideoList))
 	for i, v := range modelVideoList {
@@ -136,7 +185,7 @@ func (l *GetFeedLogic) GetFeed(in *video.FeedReq) (*video.FeedResp, error) {
 		}
 
 		if comCount == COUNT_NOT_FOUND {
-			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id}).Count(&comCount).Error
+			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id, Status: model.CommentStatusVisible}).Count(&comCount).Error
 			if err != nil {
 				return nil, err
 			}
@@ -160,19 +209,19 @@ func (l *GetFeedLogic) GetFeed(in *video.FeedReq) (*video.FeedResp, error) {
 		}
 
 		vid := &video.Video{
-			Author: &video.User{
-				FollowCount:   userInfo.FollowCount,
-				FollowerCount: userInfo.FollowerCount,
-				ID:            userInfo.ID,
-				IsFollow:      userInfo.IsFollow,
-				Name:          userInfo.Name,
-			},
+			Author:        toVideoUser(userInfo),
 			CommentCount:  comCount,
 			CoverURL:      v.CoverUrl,
 			FavoriteCount: favCount,
+			ViewCount:     views[i],
+			ShareCount:    shares[i],
 			ID:            v.Id,
 			IsFavorite:    isFavor,
 			PlayURL:       v.PlayUrl,
+			DownloadURL:   v.DownloadUrl,
+			PreviewURL:    v.PreviewUrl,
+			SpriteURL:     v.SpriteUrl,
+			SpriteVTTURL:  v.SpriteVttUrl,
 			Title:         v.Title,
 		}
 		videoList[i] = vid
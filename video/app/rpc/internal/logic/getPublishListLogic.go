@@ -5,6 +5,7 @@ import (
 	"Mini-Tiktok/video/app/rpc/model"
 	"context"
 	"encoding/json"
+	"github.com/gomodule/redigo/redis"
 	"strconv"
 	"time"
 
@@ -39,52 +40,67 @@ func (l *GetPublishListLogic) GetPublishList(in *video.PublishListReq) (*video.P
 	}
 
 	var modelVideoList []model.Video
-	latestTime := time.Now().Unix()
 	conn := l.svcCtx.Redis.NewRedisConn()
 	defer conn.Close()
 
-	// 获取缓存中的最新发布视频列表（json格式信息）
-	list, exists, err := l.svcCtx.Redis.GetExPublishList(conn, queryid, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+	// 被举报隐藏的账号，与用户存在拉黑关系或被用户屏蔽的作者，返回空的发布列表
+	hidden, err := hiddenUserIds(l.svcCtx, conn, userid)
 	if err != nil {
 		return nil, err
 	}
-	if exists {
-		for _, v := range list {
-			var vid model.Video
-			err = json.Unmarshal(v, &vid)
-			if err != nil {
-				return nil, err
-			}
-			modelVideoList = append(modelVideoList, vid)
-		}
-		latestTime = modelVideoList[len(modelVideoList)-1].CreateTime
+	if _, ok := hidden[queryid]; ok {
+		return &video.PublishListResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+			VideoList:  nil,
+		}, nil
 	}
 
-	// 如果不存在该用户的缓存，或者缓存列表已满，则需要到数据库中查找该用户是否还有发布的视频
-	// 设计理论上不会出现缓存列表未满时还有发布视频的情况，除非缓存更新出现失败
-	remain := l.svcCtx.Config.CacheConfig.VIDEO_MAX_CACHE_SIZE - len(modelVideoList)
-	if !exists || remain == l.svcCtx.Config.CacheConfig.VIDEO_FAVORITE_MAX_CACHE_SIZE {
-		var vidList []model.Video
-		err = l.svcCtx.Db.Where("user_id = ? and create_time <= ?", queryid, latestTime).Find(&vidList).Error
+	// 作者查看自己的发布列表时直接查 DB，包括待审核，未通过与已下架的视频；其他用户只能看到审核通过的视频
+	// 草稿与定时发布的视频不在发布列表中，由草稿列表获取
+	if userid == queryid {
+		err = l.svcCtx.Db.Where("user_id = ? and publish_state = ?", queryid, model.VideoPublishStatePublished).Order("create_time DESC").Find(&modelVideoList).Error
+	} else {
+		modelVideoList, err = l.publicPublishList(conn, queryid)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 其他用户只能看到可见范围允许的视频
+	if userid != queryid {
+		var authorIds []uint64
+		for _, v := range modelVideoList {
+			if v.IsRestricted() {
+				authorIds = []uint64{queryid}
+				break
+			}
+		}
+		viewer, err := newVideoViewer(l.ctx, l.svcCtx, userid, authorIds)
 		if err != nil {
 			return nil, err
 		}
-
-		for i, v := range vidList {
-			if i <= remain {
-				marshal, err := json.Marshal(&v)
-				if err != nil {
-					return nil, err
-				}
-
-				// 以下将添加视频缓存的 Redis 命令添加到发送缓冲区
-				err = l.svcCtx.Redis.SendAddVideoInfo(conn, v.UserId, v.Id, marshal, time.Now().Unix(), l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
-				if err != nil {
-					return nil, err
-				}
+		visible := modelVideoList[:0]
+		for i := range modelVideoList {
+			if viewer.CanView(&modelVideoList[i]) {
+				visible = append(visible, modelVideoList[i])
 			}
 		}
-		modelVideoList = append(modelVideoList, vidList...)
+		modelVideoList = visible
+	}
+
+	// 批量获取视频播放次数与分享数
+	videoIds := make([]uint64, len(modelVideoList))
+	for i, v := range modelVideoList {
+		videoIds[i] = v.Id
+	}
+	views, err := viewCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := shareCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
 	}
 
 	videoList := make([]*video.Video, len(modelVideoList))
@@ -121,7 +137,7 @@ func (l *GetPublishListLogic) GetPublishList(in *video.PublishListReq) (*video.P
 		}
 
 		if comCount == COUNT_NOT_FOUND {
-			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id}).Count(&comCount).Error
+			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id, Status: model.CommentStatusVisible}).Count(&comCount).Error
 			if err != nil {
 				return nil, err
 			}
@@ -145,21 +161,26 @@ func (l *GetPublishListLogic) GetPublishList(in *video.PublishListReq) (*video.P
 		}
 
 		vid := &video.Video{
-			Author: &video.User{
-				FollowCount:   userInfo.FollowCount,
-				FollowerCount: userInfo.FollowerCount,
-				ID:            userInfo.ID,
-				IsFollow:      userInfo.IsFollow,
-				Name:          userInfo.Name,
-			},
+			Author:        toVideoUser(userInfo),
 			CommentCount:  comCount,
 			CoverURL:      v.CoverUrl,
 			FavoriteCount: favCount,
+			ViewCount:     views[i],
+			ShareCount:    shares[i],
 			ID:            v.Id,
 			IsFavorite:    isFavor,
 			PlayURL:       v.PlayUrl,
+			DownloadURL:   v.DownloadUrl,
+			PreviewURL:    v.PreviewUrl,
+			SpriteURL:     v.SpriteUrl,
+			SpriteVTTURL:  v.SpriteVttUrl,
 			Title:         v.Title,
 		}
+		if userid == v.UserId {
+			vid.Status = v.Status
+			vid.ReviewReason = v.ReviewReason
+			vid.Visibility = v.Visibility
+		}
 		videoList[i] = vid
 	}
 
@@ -175,3 +196,56 @@ func (l *GetPublishListLogic) GetPublishList(in *video.PublishListReq) (*video.P
 		VideoList:  videoList,
 	}, nil
 }
+
+// publicPublishList 获取用户审核通过的发布视频列表，先查缓存，缓存不存在或已满时再查 DB
+func (l *GetPublishListLogic) publicPublishList(conn redis.Conn, queryid uint64) ([]model.Video, error) {
+	var modelVideoList []model.Video
+	latestTime := time.Now().Unix()
+
+	// 获取缓存中的最新发布视频列表（json格式信息）
+	list, exists, err := l.svcCtx.Redis.GetExPublishList(conn, queryid, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		for _, v := range list {
+			var vid model.Video
+			err = json.Unmarshal(v, &vid)
+			if err != nil {
+				return nil, err
+			}
+			if vid.IsPublic() {
+				modelVideoList = append(modelVideoList, vid)
+			}
+			latestTime = vid.CreateTime
+		}
+	}
+
+	// 如果不存在该用户的缓存，或者缓存列表已满，则需要到数据库中查找该用户是否还有发布的视频
+	// 设计理论上不会出现缓存列表未满时还有发布视频的情况，除非缓存更新出现失败
+	remain := l.svcCtx.Config.CacheConfig.VIDEO_MAX_CACHE_SIZE - len(modelVideoList)
+	if !exists || remain == l.svcCtx.Config.CacheConfig.VIDEO_FAVORITE_MAX_CACHE_SIZE {
+		var vidList []model.Video
+		err = l.svcCtx.Db.Where("user_id = ? and create_time <= ? and status = ? and publish_state = ?", queryid, latestTime, model.VideoStatusApproved, model.VideoPublishStatePublished).Find(&vidList).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for i, v := range vidList {
+			if i <= remain {
+				marshal, err := json.Marshal(&v)
+				if err != nil {
+					return nil, err
+				}
+
+				// 以下将添加视频缓存的 Redis 命令添加到发送缓冲区
+				err = l.svcCtx.Redis.SendAddVideoInfo(conn, v.UserId, v.Id, marshal, time.Now().Unix(), l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		modelVideoList = append(modelVideoList, vidList...)
+	}
+	return modelVideoList, nil
+}
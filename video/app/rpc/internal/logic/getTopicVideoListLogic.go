@@ -0,0 +1,239 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/topic"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"github.com/gomodule/redigo/redis"
+	"gorm.io/gorm"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTopicVideoListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTopicVideoListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTopicVideoListLogic {
+	return &GetTopicVideoListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// topicVideo 话题视频列表的查询结果
+type topicVideo struct {
+	VideoId uint64 `gorm:"column:video_id"`
+	Score   int64  `gorm:"column:score"` // 投稿时间或点赞数
+}
+
+// GetTopicVideoList 获取话题下的公开视频列表，可以按投稿时间或点赞数排序
+// 1. 按投稿时间排序：先查最新视频列表缓存（缓存最新的 TOPIC_VIDEO_MAX_CACHE_SIZE 个视频），缓存不存在时从 DB 加载，
+// 缓存中的视频不够一页且缓存已满时，更早的视频从 DB 获取
+// 2. 按点赞数排序：先查热门视频列表缓存，缓存不存在时从 DB 计算点赞数最多的 TOPIC_HOT_MAX_CACHE_SIZE 个视频
+// 视频信息通过 GetVideoList 获取，同样会过滤被隐藏的作者
+func (l *GetTopicVideoListLogic) GetTopicVideoList(in *video.TopicVideoListReq) (*video.TopicVideoListResp, error) {
+	name := topic.Normalize(in.TopicName)
+	if !topic.IsValid(name) || in.Cursor < 0 {
+		return &video.TopicVideoListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var t model.Topic
+	err := l.svcCtx.Db.Where("name = ?", name).Take(&t).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.TopicVideoListResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_TOPIC_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	var ids []uint64
+	var nextCursor int64
+	var nextCursorVideoId uint64
+	var hasMore bool
+	switch in.SortType {
+	case "", model.TopicSortRecent:
+		ids, nextCursor, hasMore, err = l.recentVideoIds(conn, t.Id, in.Cursor, in.CursorVideoId)
+		if len(ids) > 0 {
+			nextCursorVideoId = ids[len(ids)-1]
+		} else {
+			nextCursorVideoId = in.CursorVideoId
+		}
+	case model.TopicSortHot:
+		ids, nextCursor, hasMore, err = l.hotVideoIds(conn, t.Id, in.Cursor)
+	default:
+		return &video.TopicVideoListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var videoList []*video.Video
+	if len(ids) > 0 {
+		r, err := NewGetVideoListLogic(l.ctx, l.svcCtx).GetVideoList(&video.VideoListReq{
+			UserId:      in.UserId,
+			VideoIdList: ids,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if r.StatusCode != STATUS_SUCCESS {
+			return &video.TopicVideoListResp{
+				StatusCode: r.StatusCode,
+				StatusMsg:  r.StatusMsg,
+			}, nil
+		}
+		videoList = r.VideoList
+	}
+
+	return &video.TopicVideoListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		Topic: &video.Topic{
+			Id:         t.Id,
+			Name:       t.Name,
+			VideoCount: t.VideoCount,
+		},
+		VideoList:         videoList,
+		NextCursor:        nextCursor,
+		NextCursorVideoId: nextCursorVideoId,
+		HasMore:           hasMore,
+	}, nil
+}
+
+// recentVideoIds 按投稿时间从新到旧、投稿时间相同时按 id 从大到小获取排在游标 (cursor, cursorId) 之后的一页视频 id，
+// cursor 为 0 时从最新的视频开始；只用投稿时间作游标时，投稿时间相同的视频跨页时会被跳过
+func (l *GetTopicVideoListLogic) recentVideoIds(conn redis.Conn, topicId uint64, cursor int64, cursorId uint64) ([]uint64, int64, bool, error) {
+	limit := int64(l.svcCtx.Config.TopicConfig.ListLimit)
+	cacheConf := l.svcCtx.Config.CacheConfig
+
+	ids, times, cached, exists, err := l.svcCtx.Redis.GetExTopicVideoList(conn, topicId, cursor, cursorId, limit, cacheConf.TOPIC_VIDEO_CACHE_TTL)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if !exists {
+		// 缓存不存在，从 DB 加载最新的视频写入缓存
+		var list []topicVideo
+		err = l.topicVideoQuery(topicId).Select("vt.video_id, vt.create_time as score").
+			Order("vt.create_time desc, vt.video_id desc").Limit(cacheConf.TOPIC_VIDEO_MAX_CACHE_SIZE).Scan(&list).Error
+		if err != nil {
+			return nil, 0, false, err
+		}
+		allIds := make([]uint64, 0, len(list))
+		allTimes := make([]int64, 0, len(list))
+		for _, tv := range list {
+			allIds = append(allIds, tv.VideoId)
+			allTimes = append(allTimes, tv.Score)
+		}
+		err = l.svcCtx.Redis.SetTopicVideoList(conn, topicId, allIds, allTimes, cacheConf.TOPIC_VIDEO_CACHE_TTL)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		ids, times = nil, nil
+		for i, t := range allTimes {
+			if int64(len(ids)) == limit {
+				break
+			}
+			if cursor == 0 || t < cursor || (t == cursor && allIds[i] < cursorId) {
+				ids = append(ids, allIds[i])
+				times = append(times, t)
+			}
+		}
+		cached = int64(len(allIds))
+	}
+
+	// 缓存已满时更早的视频可能不在缓存中，不够一页的部分从 DB 获取
+	if int64(len(ids)) < limit && cached >= int64(cacheConf.TOPIC_VIDEO_MAX_CACHE_SIZE) {
+		before, beforeId := cursor, cursorId
+		if len(times) > 0 {
+			before, beforeId = times[len(times)-1], ids[len(ids)-1]
+		}
+		query := l.topicVideoQuery(topicId).Select("vt.video_id, vt.create_time as score")
+		if before > 0 {
+			query = query.Where("(vt.create_time < ? or (vt.create_time = ? and vt.video_id < ?))", before, before, beforeId)
+		}
+		var list []topicVideo
+		err = query.Order("vt.create_time desc, vt.video_id desc").Limit(int(limit) - len(ids)).Scan(&list).Error
+		if err != nil {
+			return nil, 0, false, err
+		}
+		for _, tv := range list {
+			ids = append(ids, tv.VideoId)
+			times = append(times, tv.Score)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, cursor, false, nil
+	}
+	return ids, times[len(times)-1], int64(len(ids)) == limit, nil
+}
+
+// hotVideoIds 按点赞数从多到少获取从 offset 开始的一页视频 id，只展示点赞数最多的 TOPIC_HOT_MAX_CACHE_SIZE 个视频
+func (l *GetTopicVideoListLogic) hotVideoIds(conn redis.Conn, topicId uint64, offset int64) ([]uint64, int64, bool, error) {
+	limit := int64(l.svcCtx.Config.TopicConfig.ListLimit)
+	cacheConf := l.svcCtx.Config.CacheConfig
+
+	ids, cached, exists, err := l.svcCtx.Redis.GetTopicHotVideoList(conn, topicId, offset, limit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if !exists {
+		// 缓存不存在，从 DB 计算点赞数最多的视频写入缓存
+		var list []topicVideo
+		err = l.topicVideoQuery(topicId).Select("vt.video_id, count(f.video_id) as score").
+			Joins("left join favorite f on f.video_id = vt.video_id").
+			Group("vt.video_id").Order("score desc, vt.video_id desc").
+			Limit(cacheConf.TOPIC_HOT_MAX_CACHE_SIZE).Scan(&list).Error
+		if err != nil {
+			return nil, 0, false, err
+		}
+		allIds := make([]uint64, 0, len(list))
+		counts := make([]int64, 0, len(list))
+		for _, tv := range list {
+			allIds = append(allIds, tv.VideoId)
+			counts = append(counts, tv.Score)
+		}
+		err = l.svcCtx.Redis.SetTopicHotVideoList(conn, topicId, allIds, counts, cacheConf.TOPIC_HOT_CACHE_TTL)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		ids = nil
+		if offset < int64(len(allIds)) {
+			end := offset + limit
+			if end > int64(len(allIds)) {
+				end = int64(len(allIds))
+			}
+			ids = allIds[offset:end]
+		}
+		cached = int64(len(allIds))
+	}
+
+	next := offset + int64(len(ids))
+	return ids, next, next < cached, nil
+}
+
+// topicVideoQuery 返回话题下公开视频的查询
+func (l *GetTopicVideoListLogic) topicVideoQuery(topicId uint64) *gorm.DB {
+	return l.svcCtx.Db.Table(model.VideoTopic{}.TableName()+" as vt").
+		Joins("join video v on v.id = vt.video_id").
+		Where("vt.topic_id = ? and v.status = ? and v.publish_state = ?", topicId, model.VideoStatusApproved, model.VideoPublishStatePublished)
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/trending"
+	"context"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTrendingListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTrendingListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTrendingListLogic {
+	return &GetTrendingListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetTrendingList 获取时间窗口内的热门视频排行榜，排行榜由 Redis 中的时间桶按衰减权重合并并缓存一段时间，
+// 视频信息通过 GetVideoList 获取，未公开以及被过滤作者的视频不返回，所以一页的视频可能少于 ListLimit
+func (l *GetTrendingListLogic) GetTrendingList(in *video.TrendingReq) (*video.TrendingResp, error) {
+	window := in.Window
+	if window == "" {
+		window = trending.WINDOW_DAY
+	}
+	w, ok := trending.GetWindow(window)
+	if !ok || in.Cursor < 0 {
+		return &video.TrendingResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conf := l.svcCtx.Config.TrendingConfig
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	ids, total, err := l.svcCtx.Redis.GetTrendingList(conn, w, time.Now().Unix(), in.Cursor, int64(conf.ListLimit),
+		conf.Decay, conf.MaxSize, conf.CacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	next := in.Cursor + int64(len(ids))
+
+	var videoList []*video.Video
+	if len(ids) > 0 {
+		r, err := NewGetVideoListLogic(l.ctx, l.svcCtx).GetVideoList(&video.VideoListReq{
+			UserId:      in.UserId,
+			VideoIdList: ids,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if r.StatusCode != STATUS_SUCCESS {
+			return &video.TrendingResp{
+				StatusCode: r.StatusCode,
+				StatusMsg:  r.StatusMsg,
+			}, nil
+		}
+		videoList = r.VideoList
+	}
+
+	return &video.TrendingResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		VideoList:  videoList,
+		NextCursor: next,
+		HasMore:    next < total,
+	}, nil
+}
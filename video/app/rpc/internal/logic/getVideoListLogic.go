@@ -0,0 +1,202 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetVideoListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetVideoListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetVideoListLogic {
+	return &GetVideoListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetVideoList 按视频 id 批量获取视频信息（如搜索结果），视频信息先查缓存再查数据库，
+// 只返回公开的视频，并过滤被举报隐藏的账号，与用户存在拉黑关系或被用户屏蔽的作者的视频
+func (l *GetVideoListLogic) GetVideoList(in *video.VideoListReq) (*video.VideoListResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.VideoListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	hidden, err := hiddenUserIds(l.svcCtx, conn, userid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 获取视频信息
+	modelVideoList := make([]model.Video, 0, len(in.VideoIdList))
+	for _, id := range in.VideoIdList {
+		var v model.Video
+		info, exists, err := l.svcCtx.Redis.GetExVideoInfo(conn, id, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			err = json.Unmarshal(info, &v)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// 与点赞列表相同，不为批量查询出来的视频写入缓存
+			err = l.svcCtx.Db.Where(&model.Video{Id: id}).Take(&v).Error
+			if err != nil {
+				if err == gorm.ErrRecordNotFound {
+					continue
+				}
+				return nil, err
+			}
+		}
+
+		if !v.IsPublic() {
+			continue
+		}
+		if _, ok := hidden[v.UserId]; ok {
+			continue
+		}
+		modelVideoList = append(modelVideoList, v)
+	}
+
+	// 过滤设置了可见范围且当前用户无权查看的视频
+	var authorIds []uint64
+	for _, v := range modelVideoList {
+		if v.IsRestricted() {
+			authorIds = append(authorIds, v.UserId)
+		}
+	}
+	viewer, err := newVideoViewer(l.ctx, l.svcCtx, userid, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	visible := modelVideoList[:0]
+	for i := range modelVideoList {
+		if viewer.CanView(&modelVideoList[i]) {
+			visible = append(visible, modelVideoList[i])
+		}
+	}
+	modelVideoList = visible
+
+	// 2. 获取作者信息，以及评论数，点赞数，用户是否点赞信息
+	// 批量获取视频播放次数与分享数
+	videoIds := make([]uint64, len(modelVideoList))
+	for i, v := range modelVideoList {
+		videoIds[i] = v.Id
+	}
+	views, err := viewCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := shareCounts(l.svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+
+	videoList := make([]*video.Video, len(modelVideoList))
+	for i, v := range modelVideoList {
+		r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
+			UserID:  in.UserId,
+			QueryID: strconv.FormatUint(v.UserId, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// 一次性获取点赞数，评论数，用户是否点赞过视频的缓存数据
+		favCount, comCount, isFavor, err := l.svcCtx.Redis.GetExFavComCountIsFavor(conn, v.Id, userid,
+			l.svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL,
+			l.svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// 如果缓存未找到，还需要查库，以下相同
+		if favCount == COUNT_NOT_FOUND {
+			err := l.svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{VideoId: v.Id}).Count(&favCount).Error
+			if err != nil {
+				return nil, err
+			}
+
+			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
+			err = l.svcCtx.Redis.SendSetExFavorCount(conn, v.Id, favCount, l.svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if comCount == COUNT_NOT_FOUND {
+			err := l.svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id, Status: model.CommentStatusVisible}).Count(&comCount).Error
+			if err != nil {
+				return nil, err
+			}
+
+			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
+			err = l.svcCtx.Redis.SendSetExCommentCount(conn, v.Id, comCount, l.svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !isFavor && userid != 0 {
+			var cnt int64
+			err = l.svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{UserId: userid, VideoId: v.Id}).Count(&cnt).Error
+			if err != nil {
+				return nil, err
+			}
+			if cnt > 0 {
+				isFavor = true
+			}
+		}
+
+		videoList[i] = &video.Video{
+			Author:        toVideoUser(r.User),
+			CommentCount:  comCount,
+			CoverURL:      v.CoverUrl,
+			FavoriteCount: favCount,
+			ViewCount:     views[i],
+			ShareCount:    shares[i],
+			ID:            v.Id,
+			IsFavorite:    isFavor,
+			PlayURL:       v.PlayUrl,
+			DownloadURL:   v.DownloadUrl,
+			PreviewURL:    v.PreviewUrl,
+			SpriteURL:     v.SpriteUrl,
+			SpriteVTTURL:  v.SpriteVttUrl,
+			Title:         v.Title,
+		}
+	}
+
+	err = conn.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.VideoListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		VideoList:  videoList,
+	}, nil
+}
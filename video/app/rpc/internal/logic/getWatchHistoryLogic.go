@@ -0,0 +1,119 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWatchHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetWatchHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWatchHistoryLogic {
+	return &GetWatchHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetWatchHistory 获取用户的观看历史，按观看时间从新到旧排序
+// 先查 Redis 中最近的观看记录，不够一页时再查 DB 中归档的更早记录（排除缓存中已有的视频，这些视频之后又被观看过）
+// 视频信息通过 GetVideoList 获取，未公开以及被过滤作者的视频不返回
+func (l *GetWatchHistoryLogic) GetWatchHistory(in *video.WatchHistoryReq) (*video.WatchHistoryResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || in.Cursor < 0 {
+		return &video.WatchHistoryResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	limit := int64(l.svcCtx.Config.HistoryConfig.ListLimit)
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	ids, times, paused, err := l.svcCtx.Redis.GetHistory(conn, userid, in.Cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(ids)) < limit {
+		cached, err := l.svcCtx.Redis.GetHistoryIds(conn, userid)
+		if err != nil {
+			return nil, err
+		}
+		before := in.Cursor
+		if len(times) > 0 {
+			before = times[len(times)-1]
+		}
+		query := l.svcCtx.Db.Where("user_id = ?", userid)
+		if before > 0 {
+			query = query.Where("watch_time < ?", before)
+		}
+		if len(cached) > 0 {
+			query = query.Where("video_id not in ?", cached)
+		}
+		var archived []model.WatchHistory
+		err = query.Order("watch_time desc").Limit(int(limit) - len(ids)).Find(&archived).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range archived {
+			ids = append(ids, h.VideoId)
+			times = append(times, h.WatchTime)
+		}
+	}
+
+	if len(ids) == 0 {
+		return &video.WatchHistoryResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+			NextCursor: in.Cursor,
+			Paused:     paused,
+		}, nil
+	}
+
+	r, err := NewGetVideoListLogic(l.ctx, l.svcCtx).GetVideoList(&video.VideoListReq{
+		UserId:      in.UserId,
+		VideoIdList: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &video.WatchHistoryResp{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		}, nil
+	}
+
+	// GetVideoList 按请求的 id 顺序返回，只是会跳过部分视频
+	watchTimes := make(map[uint64]int64, len(ids))
+	for i, id := range ids {
+		watchTimes[id] = times[i]
+	}
+	itemList := make([]*video.WatchHistoryItem, len(r.VideoList))
+	for i, v := range r.VideoList {
+		itemList[i] = &video.WatchHistoryItem{
+			Video:     v,
+			WatchTime: watchTimes[v.ID],
+		}
+	}
+
+	return &video.WatchHistoryResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		ItemList:   itemList,
+		NextCursor: times[len(times)-1],
+		HasMore:    int64(len(ids)) == limit,
+		Paused:     paused,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReportTargetsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReportTargetsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReportTargetsLogic {
+	return &ListReportTargetsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListReportTargets 内部接口，权限检查与审计日志由管理服务完成，这里再检查一次操作人的角色。按首次被举报时间正序返回指定状态（默认已自动隐藏待审核）的举报目标，
+// cursor 为上一页最后一个目标的首次被举报时间
+func (l *ListReportTargetsLogic) ListReportTargets(in *video.ReportTargetListReq) (*video.ReportTargetListResp, error) {
+	ok, err := isModerator(l.svcCtx, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.ReportTargetListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_PERMISSION_MSG,
+		}, nil
+	}
+
+	status := in.Status
+	if status == "" {
+		status = model.ReportStatusHidden
+	}
+	if status != model.ReportStatusOpen && status != model.ReportStatusHidden && status != model.ReportStatusRestored {
+		return &video.ReportTargetListResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	limit := l.svcCtx.Config.ReportConfig.ListLimit
+	var targets []model.ReportTarget
+	err = l.svcCtx.Db.Where("status = ? and create_time > ?", status, in.Cursor).
+		Order("create_time").Limit(limit).Find(&targets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := EMPTY_NEXT_TIME
+	if len(targets) == limit {
+		nextCursor = targets[len(targets)-1].CreateTime
+	}
+
+	targetList := make([]*video.ReportTarget, len(targets))
+	for i, t := range targets {
+		targetList[i] = &video.ReportTarget{
+			TargetType:  t.TargetType,
+			TargetId:    t.TargetId,
+			OwnerId:     t.OwnerId,
+			ReportCount: t.ReportCount,
+			Status:      t.Status,
+			CreateTime:  t.CreateTime,
+			UpdateTime:  t.UpdateTime,
+		}
+	}
+
+	return &video.ReportTargetListResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		TargetList: targetList,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReviewQueueLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReviewQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReviewQueueLogic {
+	return &ListReviewQueueLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListReviewQueue 内部接口，权限检查与审计日志由管理服务完成，这里再检查一次操作人的角色。按投稿时间正序返回指定审核状态（默认待审核）的视频，cursor 为上一页最后一个视频的投稿时间
+func (l *ListReviewQueueLogic) ListReviewQueue(in *video.ReviewQueueReq) (*video.ReviewQueueResp, error) {
+	ok, err := isModerator(l.svcCtx, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.ReviewQueueResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_PERMISSION_MSG,
+		}, nil
+	}
+
+	status := in.Status
+	if status == "" {
+		status = model.VideoStatusPending
+	}
+	switch status {
+	case model.VideoStatusPending, model.VideoStatusApproved, model.VideoStatusRejected, model.VideoStatusTakenDown:
+	default:
+		return &video.ReviewQueueResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	limit := l.svcCtx.Config.ReviewConfig.ListLimit
+	var modelVideoList []model.Video
+	err = l.svcCtx.Db.Where("status = ? and create_time > ?", status, in.Cursor).
+		Order("create_time").Limit(limit).Find(&modelVideoList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 不足一页时说明队列已取完，返回的 NextCursor 为 0
+	nextCursor := EMPTY_NEXT_TIME
+	if len(modelVideoList) == limit {
+		nextCursor = modelVideoList[len(modelVideoList)-1].CreateTime
+	}
+
+	videoList := make([]*video.Video, len(modelVideoList))
+	for i, v := range modelVideoList {
+		r, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
+			UserID:  strconv.FormatUint(v.UserId, 10),
+			QueryID: strconv.FormatUint(v.UserId, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		videoList[i] = &video.Video{
+			Author:       toVideoUser(r.User),
+			CoverURL:     v.CoverUrl,
+			ID:           v.Id,
+			PlayURL:      v.PlayUrl,
+			Title:        v.Title,
+			Status:       v.Status,
+			ReviewReason: v.ReviewReason,
+		}
+	}
+
+	return &video.ReviewQueueResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		VideoList:  videoList,
+		NextCursor: nextCursor,
+	}, nil
+}
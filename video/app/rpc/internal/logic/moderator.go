@@ -0,0 +1,22 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/rbac"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"strconv"
+)
+
+// isModerator 审核相关的内部接口由管理服务在权限检查后调用，这里再按用户表中的角色检查一次操作人，
+// 避免接口被直接调用时绕过权限检查
+func isModerator(svcCtx *svc.ServiceContext, operatorId string) (bool, error) {
+	userid, err := strconv.ParseUint(operatorId, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+	role, err := model.UserRole(svcCtx.Db, userid)
+	if err != nil {
+		return false, err
+	}
+	return rbac.HasRole(role, rbac.ROLE_MODERATOR), nil
+}
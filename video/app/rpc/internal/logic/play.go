@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+// viewCounts 批量获取视频的播放次数，先查缓存，缓存不存在的视频从 DB 查询后写入缓存
+func viewCounts(svcCtx *svc.ServiceContext, conn redis.Conn, videoIds []uint64) ([]int64, error) {
+	if len(videoIds) == 0 {
+		return nil, nil
+	}
+	ttl := svcCtx.Config.CacheConfig.VIEW_CACHE_TTL
+	counts, err := svcCtx.Redis.GetExViewCounts(conn, videoIds, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	var missIds []uint64
+	for i, c := range counts {
+		if c == COUNT_NOT_FOUND {
+			missIds = append(missIds, videoIds[i])
+		}
+	}
+	if len(missIds) == 0 {
+		return counts, nil
+	}
+
+	var stats []model.VideoPlayStat
+	err = svcCtx.Db.Select("video_id, view_count").Where("video_id in ?", missIds).Find(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	dbCounts := make(map[uint64]int64, len(stats))
+	for _, s := range stats {
+		dbCounts[s.VideoId] = s.ViewCount
+	}
+	missCounts := make([]int64, len(missIds))
+	for i, id := range missIds {
+		missCounts[i] = dbCounts[id]
+	}
+	missCounts, err = svcCtx.Redis.SetViewCounts(conn, missIds, missCounts, ttl)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, 0; i < len(counts); i++ {
+		if counts[i] == COUNT_NOT_FOUND {
+			counts[i] = missCounts[j]
+			j++
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishSchedulerLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPublishSchedulerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishSchedulerLogic {
+	return &PublishSchedulerLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ScheduleLoop 每隔 Interval 秒发布一次到达发布时间的定时视频，并重试之前发布时失败的缓存更新
+// 多个视频服务实例同时执行时，由 publishVideo 的条件更新保证每个视频只发布一次
+func (l *PublishSchedulerLogic) ScheduleLoop() {
+	ticker := time.NewTicker(time.Duration(l.svcCtx.Config.ScheduleConfig.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		err := l.PublishDue()
+		if err != nil {
+			l.Errorf("publish scheduled videos: %v", err)
+		}
+		err = l.RetryCacheTasks()
+		if err != nil {
+			l.Errorf("retry video cache tasks: %v", err)
+		}
+		<-ticker.C
+	}
+}
+
+// PublishDue 按发布时间顺序发布到期的定时视频，每次最多发布 BatchSize 个，剩余的由下次执行发布
+// 单个视频发布失败时只记录日志，不影响同一批的其他视频，失败的视频仍是定时发布状态，由下次执行重试
+func (l *PublishSchedulerLogic) PublishDue() error {
+	var due []model.Video
+	err := l.svcCtx.Db.Where("publish_state = ? and publish_time <= ?", model.VideoPublishStateScheduled, time.Now().Unix()).
+		Order("publish_time").Limit(l.svcCtx.Config.ScheduleConfig.BatchSize).Find(&due).Error
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	for i := range due {
+		_, err = publishVideo(l.ctx, l.svcCtx, conn, &due[i])
+		if err != nil {
+			l.Errorf("publish scheduled video %d: %v", due[i].Id, err)
+		}
+	}
+	return nil
+}
+
+// RetryCacheTasks 按 DB 中的视频重新执行发布时失败的缓存更新，每次最多处理 BatchSize 个
+// 只处理创建超过 Interval 秒的任务，避免与正在执行的 publishVideo 重复更新；视频已被删除时直接删除任务
+// 视频不在 Feed 流缓存中，这里用当前的投稿时间删除未审核通过的视频的缓存即可
+func (l *PublishSchedulerLogic) RetryCacheTasks() error {
+	var tasks []model.VideoCacheTask
+	before := time.Now().Unix() - int64(l.svcCtx.Config.ScheduleConfig.Interval)
+	err := l.svcCtx.Db.Where("create_time <= ?", before).
+		Order("create_time").Limit(l.svcCtx.Config.ScheduleConfig.BatchSize).Find(&tasks).Error
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	for _, task := range tasks {
+		var v model.Video
+		err = l.svcCtx.Db.Where("id = ? and publish_state = ?", task.VideoId, model.VideoPublishStatePublished).Limit(1).Find(&v).Error
+		if err != nil {
+			l.Errorf("load video %d of cache task: %v", task.VideoId, err)
+			continue
+		}
+		if v.Id != 0 {
+			err = syncPublishedVideoCache(l.svcCtx, conn, &v, v.CreateTime)
+			if err != nil {
+				l.Errorf("sync cache of published video %d: %v", v.Id, err)
+				continue
+			}
+		}
+		err = l.svcCtx.Db.Delete(&model.VideoCacheTask{}, "video_id = ?", task.VideoId).Error
+		if err != nil {
+			l.Errorf("delete cache task of video %d: %v", task.VideoId, err)
+		}
+	}
+	return nil
+}
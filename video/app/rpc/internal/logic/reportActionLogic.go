@@ -0,0 +1,249 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"errors"
+	"github.com/ncghost1/snowflake-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const REPORT_HIDE_REASON = "reported" // 因举报被自动隐藏的视频的审核原因
+
+var errDuplicateReport = errors.New("duplicate report")
+
+type ReportActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReportActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReportActionLogic {
+	return &ReportActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReportAction 举报视频、评论或账号，同一用户对同一目标只能举报一次，
+// 举报记录按目标汇总，不同举报人数达到阈值时自动隐藏目标，等待审核：
+// 视频回到待审核状态并从缓存中删除，评论被隐藏并从缓存中删除，账号的视频与评论对其他用户隐藏
+func (l *ReportActionLogic) ReportAction(in *video.ReportReq) (*video.ReportResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	targetId, err := strconv.ParseUint(in.TargetId, 10, 64)
+	if err != nil {
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	switch in.Reason {
+	case model.ReportReasonSpam, model.ReportReasonPorn, model.ReportReasonViolence,
+		model.ReportReasonHarassment, model.ReportReasonIllegal, model.ReportReasonOther:
+	default:
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if utf8.RuneCountInString(in.Content) > l.svcCtx.Config.ReportConfig.ContentMaxLength {
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	// 1. 查找举报目标及其作者
+	var vid model.Video
+	var com model.Comment
+	var ownerId uint64
+	switch in.TargetType {
+	case model.ReportTargetVideo:
+		err = l.svcCtx.Db.Where(&model.Video{Id: targetId}).Take(&vid).Error
+		ownerId = vid.UserId
+	case model.ReportTargetComment:
+		err = l.svcCtx.Db.Where(&model.Comment{Id: targetId}).Take(&com).Error
+		ownerId = com.UserId
+	case model.ReportTargetUser:
+		r, rpcErr := l.svcCtx.UserRpc.GetUser(l.ctx, &userrpc.GetUserReq{
+			UserID:  strconv.FormatUint(userid, 10),
+			QueryID: strconv.FormatUint(targetId, 10),
+		})
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		if r.StatusCode != STATUS_SUCCESS {
+			err = gorm.ErrRecordNotFound
+		}
+		ownerId = targetId
+	default:
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.ReportResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_REPORT_TARGET_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+	if ownerId == userid {
+		return &video.ReportResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_REPORT_SELF_MSG,
+		}, nil
+	}
+
+	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+	if err != nil {
+		return nil, err
+	}
+	reportId, err := sf.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 在同一个事务中写入举报记录，更新目标的举报人数，达到阈值时隐藏目标
+	now := time.Now().Unix()
+	hidden := false
+	err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		// 举报表上有 (reporter_id, target_type, target_id) 唯一索引，重复举报不会写入
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Report{
+			Id:         reportId,
+			ReporterId: userid,
+			TargetType: in.TargetType,
+			TargetId:   targetId,
+			Reason:     in.Reason,
+			Content:    in.Content,
+			CreateTime: now,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errDuplicateReport
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"report_count": gorm.Expr("report_count + 1"),
+				"update_time":  now,
+			}),
+		}).Create(&model.ReportTarget{
+			TargetType:  in.TargetType,
+			TargetId:    targetId,
+			OwnerId:     ownerId,
+			ReportCount: 1,
+			Status:      model.ReportStatusOpen,
+			CreateTime:  now,
+			UpdateTime:  now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// 举报人数达到阈值，且目标未被隐藏时隐藏目标（带上状态条件更新，避免并发举报时重复隐藏）
+		res = tx.Model(&model.ReportTarget{}).
+			Where("target_type = ? and target_id = ? and status = ? and report_count >= ?",
+				in.TargetType, targetId, model.ReportStatusOpen, l.svcCtx.Config.ReportConfig.HideThreshold).
+			Update("status", model.ReportStatusHidden)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		switch in.TargetType {
+		case model.ReportTargetVideo:
+			res = tx.Model(&model.Video{}).Where("id = ? and status = ? and publish_state = ?", targetId, model.VideoStatusApproved, model.VideoPublishStatePublished).
+				Updates(map[string]interface{}{
+					"status":        model.VideoStatusPending,
+					"review_reason": REPORT_HIDE_REASON,
+					"review_time":   now,
+				})
+		case model.ReportTargetComment:
+			res = tx.Model(&model.Comment{}).Where("id = ? and status = ?", targetId, model.CommentStatusVisible).
+				Update("status", model.CommentStatusHidden)
+		default:
+			hidden = true
+			return nil
+		}
+		if res.Error != nil {
+			return res.Error
+		}
+		hidden = res.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errDuplicateReport) {
+			return &video.ReportResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_REPORT_DUP_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	// 3. 目标被隐藏后删除相关缓存
+	if hidden {
+		conn := l.svcCtx.Redis.NewRedisConn()
+		defer conn.Close()
+		switch in.TargetType {
+		case model.ReportTargetVideo:
+			err = l.svcCtx.Redis.DelVideoInfoAndFeed(conn, vid.UserId, vid.Id, vid.CreateTime)
+			if err != nil {
+				return nil, err
+			}
+			err = l.svcCtx.Redis.IncrWorkCount(conn, vid.UserId, -1)
+			if err != nil {
+				return nil, err
+			}
+			err = updateVideoTopics(l.svcCtx, conn, &vid, -1)
+			if err != nil {
+				return nil, err
+			}
+			// 从搜索结果中隐藏，失败只记录日志
+			vid.Status = model.VideoStatusPending
+			if err := searchVideoEvent(l.ctx, l.svcCtx, &vid); err != nil {
+				l.Errorf("write search event: %v", err)
+			}
+		case model.ReportTargetComment:
+			err = l.svcCtx.Redis.DelComment(conn, com.VideoId, com.Id, l.svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL)
+		case model.ReportTargetUser:
+			err = l.svcCtx.Redis.DelReportHiddenUsers(conn)
+			// 从用户搜索结果中隐藏，失败只记录日志
+			if err := searchUserVisibleEvent(l.ctx, l.svcCtx, targetId, false); err != nil {
+				l.Errorf("write search event: %v", err)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &video.ReportResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
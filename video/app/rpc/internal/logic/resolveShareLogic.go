@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveShareLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResolveShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveShareLogic {
+	return &ResolveShareLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ResolveShare 校验分享 token 的签名，返回对应的视频（通过 GetVideoList 获取，未公开以及被过滤作者的视频不返回）
+func (l *ResolveShareLogic) ResolveShare(in *video.ResolveShareReq) (*video.ResolveShareResp, error) {
+	videoId, ok := parseShareToken(l.svcCtx.Config.ShareConfig.Secret, in.ShareToken)
+	if !ok {
+		return &video.ResolveShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_SHARE_TOKEN_MSG,
+		}, nil
+	}
+
+	r, err := NewGetVideoListLogic(l.ctx, l.svcCtx).GetVideoList(&video.VideoListReq{
+		UserId:      in.UserId,
+		VideoIdList: []uint64{videoId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return &video.ResolveShareResp{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		}, nil
+	}
+	if len(r.VideoList) == 0 {
+		return &video.ResolveShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_VIDEO_MSG,
+		}, nil
+	}
+
+	return &video.ResolveShareResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		Video:      r.VideoList[0],
+	}, nil
+}
@@ -0,0 +1,131 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreReportTargetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreReportTargetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreReportTargetLogic {
+	return &RestoreReportTargetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RestoreReportTarget 内部接口，权限检查与审计日志由管理服务完成，这里再检查一次操作人的角色。恢复因举报被自动隐藏的目标，之后的举报不再自动隐藏该目标：
+// 视频仍因举报处于待审核状态时重新通过审核，评论恢复可见并删除评论列表缓存，账号删除隐藏账号集合缓存（用户搜索索引由管理服务更新）
+func (l *RestoreReportTargetLogic) RestoreReportTarget(in *video.RestoreReportTargetReq) (*video.RestoreReportTargetResp, error) {
+	ok, err := isModerator(l.svcCtx, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.RestoreReportTargetResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_PERMISSION_MSG,
+		}, nil
+	}
+
+	switch in.TargetType {
+	case model.ReportTargetVideo, model.ReportTargetComment, model.ReportTargetUser:
+	default:
+		return &video.RestoreReportTargetResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var com model.Comment
+	err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		// 带上状态条件更新，避免并发恢复时重复更新缓存
+		res := tx.Model(&model.ReportTarget{}).
+			Where("target_type = ? and target_id = ? and status = ?", in.TargetType, in.TargetId, model.ReportStatusHidden).
+			Update("status", model.ReportStatusRestored)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if in.TargetType != model.ReportTargetComment {
+			return nil
+		}
+
+		// 评论已被删除时只更新举报状态
+		err := tx.Where(&model.Comment{Id: in.TargetId}).Limit(1).Find(&com).Error
+		if err != nil || com.Id == 0 {
+			return err
+		}
+		return tx.Model(&model.Comment{}).Where("id = ? and status = ?", in.TargetId, model.CommentStatusHidden).
+			Update("status", model.CommentStatusVisible).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.RestoreReportTargetResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_REPORT_RESTORE_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	switch in.TargetType {
+	case model.ReportTargetVideo:
+		// 视频已被删除或已被审核员处理（通过、不通过或下架）时保留审核结果
+		var v model.Video
+		err = l.svcCtx.Db.Where(&model.Video{Id: in.TargetId}).Limit(1).Find(&v).Error
+		if err != nil {
+			return nil, err
+		}
+		if v.Id == 0 || v.Status != model.VideoStatusPending || v.ReviewReason != REPORT_HIDE_REASON {
+			break
+		}
+		r, err := NewReviewVideoLogic(l.ctx, l.svcCtx).ReviewVideo(&video.ReviewVideoReq{
+			VideoId:    strconv.FormatUint(v.Id, 10),
+			ActionType: REVIEW_APPROVE,
+			OperatorId: in.OperatorId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &video.RestoreReportTargetResp{
+			StatusCode: r.StatusCode,
+			StatusMsg:  r.StatusMsg,
+		}, nil
+	case model.ReportTargetComment:
+		if com.Id == 0 {
+			break
+		}
+		conn := l.svcCtx.Redis.NewRedisConn()
+		defer conn.Close()
+		err = l.svcCtx.Redis.DelCommentList(conn, com.VideoId)
+		if err != nil {
+			return nil, err
+		}
+	case model.ReportTargetUser:
+		conn := l.svcCtx.Redis.NewRedisConn()
+		defer conn.Close()
+		err = l.svcCtx.Redis.DelReportHiddenUsers(conn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &video.RestoreReportTargetResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
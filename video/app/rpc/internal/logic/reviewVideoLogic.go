@@ -0,0 +1,157 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewVideoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewVideoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewVideoLogic {
+	return &ReviewVideoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewVideo 审核视频（内部接口，权限检查与审计日志由管理服务完成，这里再检查一次操作人的角色）：通过（待审核/未通过/已下架 -> 已通过），不通过（待审核 -> 未通过），下架（已通过 -> 已下架）
+// 状态更新成功后再更新缓存：通过的视频加入 Feed 流与发布列表缓存，不通过或下架的视频从缓存中删除，
+// 通过与下架同时更新视频所属话题的视频数；草稿与定时发布的视频只更新审核状态，发布时再加入缓存
+func (l *ReviewVideoLogic) ReviewVideo(in *video.ReviewVideoReq) (*video.ReviewVideoResp, error) {
+	ok, err := isModerator(l.svcCtx, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &video.ReviewVideoResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_PERMISSION_MSG,
+		}, nil
+	}
+
+	videoId, err := strconv.ParseUint(in.VideoId, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var from []string
+	var to string
+	switch in.ActionType {
+	case REVIEW_APPROVE:
+		from = []string{model.VideoStatusPending, model.VideoStatusRejected, model.VideoStatusTakenDown}
+		to = model.VideoStatusApproved
+	case REVIEW_REJECT:
+		from = []string{model.VideoStatusPending}
+		to = model.VideoStatusRejected
+	case REVIEW_TAKE_DOWN:
+		from = []string{model.VideoStatusApproved}
+		to = model.VideoStatusTakenDown
+	default:
+		return &video.ReviewVideoResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var v model.Video
+	err = l.svcCtx.Db.Where(&model.Video{Id: videoId}).Take(&v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.ReviewVideoResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_PARAM_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+
+	// 带上原状态条件更新，避免并发审核时重复更新缓存与作品数
+	reviewTime := time.Now().Unix()
+	res := l.svcCtx.Db.Model(&model.Video{}).Where("id = ? and status in ?", videoId, from).Updates(map[string]interface{}{
+		"status":        to,
+		"review_reason": in.Reason,
+		"review_time":   reviewTime,
+	})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &video.ReviewVideoResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_REVIEW_MSG,
+		}, nil
+	}
+	v.Status = to
+	v.ReviewReason = in.Reason
+	v.ReviewTime = reviewTime
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	switch {
+	case !v.IsPublished():
+		// 草稿可能因作者查看而写入了视频信息缓存，删除后由下次查询重新加载
+		err = l.svcCtx.Redis.DelVideoInfoAndFeed(conn, v.UserId, v.Id, v.CreateTime)
+		if err != nil {
+			return nil, err
+		}
+	case in.ActionType == REVIEW_APPROVE:
+		videoJson, err := json.Marshal(&v)
+		if err != nil {
+			return nil, err
+		}
+		err = l.svcCtx.Redis.AddVideoInfoAndFeed(conn, v.UserId, v.Id, videoJson, v.CreateTime, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+		if err != nil {
+			return nil, err
+		}
+		err = l.svcCtx.Redis.IncrWorkCount(conn, v.UserId, 1)
+		if err != nil {
+			return nil, err
+		}
+		err = updateVideoTopics(l.svcCtx, conn, &v, 1)
+		if err != nil {
+			return nil, err
+		}
+	case in.ActionType == REVIEW_REJECT:
+		err = l.svcCtx.Redis.DelVideoInfoAndFeed(conn, v.UserId, v.Id, v.CreateTime)
+		if err != nil {
+			return nil, err
+		}
+	case in.ActionType == REVIEW_TAKE_DOWN:
+		err = l.svcCtx.Redis.DelVideoInfoAndFeed(conn, v.UserId, v.Id, v.CreateTime)
+		if err != nil {
+			return nil, err
+		}
+		err = l.svcCtx.Redis.IncrWorkCount(conn, v.UserId, -1)
+		if err != nil {
+			return nil, err
+		}
+		err = updateVideoTopics(l.svcCtx, conn, &v, -1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 更新搜索索引，失败只记录日志
+	err = searchVideoEvent(l.ctx, l.svcCtx, &v)
+	if err != nil {
+		l.Errorf("write search event: %v", err)
+	}
+
+	return &video.ReviewVideoResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+)
+
+// searchVideoEvent 视频审核状态或可见范围变化后将视频写入 Kafka 搜索主题，由搜索服务更新索引。
+// 只有公开并且所有用户可见的视频可以被搜索到：索引不区分搜索用户，设置了可见范围的视频即使对粉丝可见也不进入搜索结果，
+// 这样搜索结果总数与 has_more 不会把当前用户看不到的视频计算在内
+func searchVideoEvent(ctx context.Context, svcCtx *svc.ServiceContext, v *model.Video) error {
+	marshal, err := json.Marshal(&model.SearchEvent{
+		Type:       model.SearchEventVideo,
+		Action:     model.SearchActionUpsert,
+		Id:         v.Id,
+		Text:       v.Title,
+		Visible:    v.IsPublic() && !v.IsRestricted(),
+		CreateTime: v.CreateTime,
+	})
+	if err != nil {
+		return err
+	}
+	return svcCtx.SearchWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(v.Id, 10)),
+		Value: marshal,
+	})
+}
+
+// searchUserVisibleEvent 账号因被举报而自动隐藏后将其从用户搜索结果中隐藏，只修改索引中的可见状态
+func searchUserVisibleEvent(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, visible bool) error {
+	marshal, err := json.Marshal(&model.SearchEvent{
+		Type:    model.SearchEventUser,
+		Action:  model.SearchActionVisible,
+		Id:      userid,
+		Visible: visible,
+	})
+	if err != nil {
+		return err
+	}
+	return svcCtx.SearchWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatUint(userid, 10)),
+		Value: marshal,
+	})
+}
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetVideoVisibilityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetVideoVisibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetVideoVisibilityLogic {
+	return &SetVideoVisibilityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetVideoVisibility 作者修改视频的可见范围，先更新 DB 再替换缓存中的视频信息
+// 视频信息缓存与 Feed 流缓存对所有用户共用，读取时按缓存中的可见范围过滤，所以必须同时替换，不能只删除其中一个
+func (l *SetVideoVisibilityLogic) SetVideoVisibility(in *video.VideoVisibilityReq) (*video.VideoVisibilityResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || !model.IsValidVisibility(in.Visibility) {
+		return &video.VideoVisibilityResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var v model.Video
+	err = l.svcCtx.Db.Where("id = ? and user_id = ?", in.VideoId, userid).Take(&v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &video.VideoVisibilityResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_VIDEO_MSG,
+			}, nil
+		}
+		return nil, err
+	}
+	if v.Visibility == in.Visibility {
+		return &video.VideoVisibilityResp{
+			StatusCode: STATUS_SUCCESS,
+			StatusMsg:  STATUS_SUCCESS_MSG,
+		}, nil
+	}
+
+	err = l.svcCtx.Db.Model(&model.Video{}).Where("id = ?", v.Id).Update("visibility", in.Visibility).Error
+	if err != nil {
+		return nil, err
+	}
+	v.Visibility = in.Visibility
+
+	videoJson, err := json.Marshal(&v)
+	if err != nil {
+		return nil, err
+	}
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	err = l.svcCtx.Redis.UpdateVideoInfoAndFeed(conn, v.Id, videoJson, v.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 设置了可见范围的视频不能被搜索到，更新搜索索引，失败只记录日志
+	err = searchVideoEvent(l.ctx, l.svcCtx, &v)
+	if err != nil {
+		l.Errorf("write search event: %v", err)
+	}
+
+	return &video.VideoVisibilityResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetWatchHistoryPausedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetWatchHistoryPausedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetWatchHistoryPausedLogic {
+	return &SetWatchHistoryPausedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetWatchHistoryPaused 暂停或恢复记录观看历史，先写入 DB 再更新缓存中暂停记录的用户集合
+// 暂停期间已有的观看历史保留
+func (l *SetWatchHistoryPausedLogic) SetWatchHistoryPaused(in *video.WatchHistoryPausedReq) (*video.WatchHistoryActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.WatchHistoryActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	setting := model.WatchHistorySetting{
+		UserId:     userid,
+		Paused:     model.HistoryRecording,
+		UpdateTime: time.Now().Unix(),
+	}
+	if in.Paused {
+		setting.Paused = model.HistoryPaused
+	}
+	err = l.svcCtx.Db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"paused", "update_time"}),
+	}).Create(&setting).Error
+	if err != nil {
+		return nil, err
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+	err = l.svcCtx.Redis.SetHistoryPaused(conn, userid, in.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.WatchHistoryActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"github.com/gomodule/redigo/redis"
+)
+
+const SHARE_SIGN_SIZE = 8 // 分享 token 中签名的字节数
+
+// signShareToken 生成视频的分享 token：8 字节视频 id 加上截断的 HMAC-SHA256 签名，使用 URL 安全的 base64 编码（22 个字符）
+// token 只包含视频 id，视频的播放地址变化后分享链接仍然有效
+func signShareToken(secret string, videoId uint64) string {
+	buf := make([]byte, 8, 8+SHARE_SIGN_SIZE)
+	binary.BigEndian.PutUint64(buf, videoId)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(buf)
+	buf = append(buf, mac.Sum(nil)[:SHARE_SIGN_SIZE]...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// parseShareToken 校验分享 token 的签名并返回视频 id
+func parseShareToken(secret, token string) (uint64, bool) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != 8+SHARE_SIGN_SIZE {
+		return 0, false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(buf[:8])
+	if !hmac.Equal(buf[8:], mac.Sum(nil)[:SHARE_SIGN_SIZE]) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(buf[:8]), true
+}
+
+// shareCounts 批量获取视频的分享数，先查缓存，缓存不存在的视频从 DB 计数后写入缓存
+func shareCounts(svcCtx *svc.ServiceContext, conn redis.Conn, videoIds []uint64) ([]int64, error) {
+	if len(videoIds) == 0 {
+		return nil, nil
+	}
+	ttl := svcCtx.Config.CacheConfig.SHARE_CACHE_TTL
+	counts, err := svcCtx.Redis.GetExShareCounts(conn, videoIds, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	var missIds []uint64
+	for i, c := range counts {
+		if c == COUNT_NOT_FOUND {
+			missIds = append(missIds, videoIds[i])
+		}
+	}
+	if len(missIds) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		VideoId uint64
+		Cnt     int64
+	}
+	err = svcCtx.Db.Model(&model.Share{}).Select("video_id, count(*) as cnt").
+		Where("video_id in ?", missIds).Group("video_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	dbCounts := make(map[uint64]int64, len(rows))
+	for _, r := range rows {
+		dbCounts[r.VideoId] = r.Cnt
+	}
+	missCounts := make([]int64, len(missIds))
+	for i, id := range missIds {
+		missCounts[i] = dbCounts[id]
+	}
+	err = svcCtx.Redis.SetShareCounts(conn, missIds, missCounts, ttl)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, 0; i < len(counts); i++ {
+		if counts[i] == COUNT_NOT_FOUND {
+			counts[i] = missCounts[j]
+			j++
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,182 @@
+package logic
+
+import (
+	"Mini-Tiktok/common/creatorstat"
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ncghost1/snowflake-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShareActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareActionLogic {
+	return &ShareActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ShareAction 分享视频：生成签名的分享链接，私信好友时通过用户服务发送私信，
+// 然后写入分享记录与视频当天的分享数，更新分享数缓存与热门排行榜
+func (l *ShareActionLogic) ShareAction(in *video.ShareReq) (*video.ShareResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.ShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	var toUserId uint64
+	switch in.Channel {
+	case model.ShareChannelLink, model.ShareChannelExternal:
+	case model.ShareChannelMessage:
+		toUserId, err = strconv.ParseUint(in.ToUserId, 10, 64)
+		if err != nil {
+			return &video.ShareResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_PARAM_MSG,
+			}, nil
+		}
+	default:
+		return &video.ShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	conn := l.svcCtx.Redis.NewRedisConn()
+	defer conn.Close()
+
+	// 1. 只能分享公开并且对当前用户可见的视频
+	var v model.Video
+	info, exists, err := l.svcCtx.Redis.GetExVideoInfo(conn, in.VideoId, l.svcCtx.Config.CacheConfig.VIDEO_CACHE_TTL)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		err = json.Unmarshal(info, &v)
+	} else {
+		err = l.svcCtx.Db.Where(&model.Video{Id: in.VideoId}).Take(&v).Error
+	}
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if err == gorm.ErrRecordNotFound || !v.IsPublic() {
+		return &video.ShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_VIDEO_MSG,
+		}, nil
+	}
+	canView, err := canViewVideo(l.ctx, l.svcCtx, userid, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &video.ShareResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_VIDEO_MSG,
+		}, nil
+	}
+
+	token := signShareToken(l.svcCtx.Config.ShareConfig.Secret, v.Id)
+	shareURL := l.svcCtx.Config.ShareConfig.LinkPrefix + token
+
+	// 2. 私信好友，用户服务会检查双方是否为好友以及是否存在拉黑关系
+	if in.Channel == model.ShareChannelMessage {
+		r, err := l.svcCtx.UserRpc.SendMessage(l.ctx, &userrpc.SendMessageReq{
+			UserId:   in.UserId,
+			ToUserId: in.ToUserId,
+			Content:  fmt.Sprintf(l.svcCtx.Config.ShareConfig.MessageFormat, v.Title, shareURL),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if r.StatusCode != STATUS_SUCCESS {
+			return &video.ShareResp{
+				StatusCode: r.StatusCode,
+				StatusMsg:  r.StatusMsg,
+			}, nil
+		}
+	}
+
+	// 3. 写入分享记录，并累加视频当天的分享数（创作者数据）
+	sf, err := snowflake.New(l.svcCtx.Config.WorkerId)
+	if err != nil {
+		return nil, err
+	}
+	shareId, err := sf.Generate() // 使用雪花算法生成分享记录id
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&model.Share{
+			Id:         shareId,
+			UserId:     userid,
+			VideoId:    v.Id,
+			Channel:    in.Channel,
+			ToUserId:   toUserId,
+			CreateTime: now.Unix(),
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"shares":      gorm.Expr("shares + 1"),
+				"update_time": now.Unix(),
+			}),
+		}).Create(&creatorstat.DailyStat{
+			TargetType: creatorstat.TARGET_VIDEO,
+			TargetId:   v.Id,
+			Date:       creatorstat.Date(now),
+			Shares:     1,
+			UpdateTime: now.Unix(),
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 更新分享数缓存与热门排行榜，失败不影响分享结果
+	shareCount, err := l.svcCtx.Redis.IncrShareCount(conn, v.Id, l.svcCtx.Config.CacheConfig.SHARE_CACHE_TTL)
+	if err != nil {
+		l.Errorf("incr share count: %v", err)
+	}
+	if err != nil || shareCount == COUNT_NOT_FOUND {
+		counts, err := shareCounts(l.svcCtx, conn, []uint64{v.Id})
+		if err != nil {
+			return nil, err
+		}
+		shareCount = counts[0]
+	}
+	err = addTrendingScore(l.svcCtx, conn, v.Id, l.svcCtx.Config.TrendingConfig.ShareWeight)
+	if err != nil {
+		l.Errorf("add trending score: %v", err)
+	}
+
+	return &video.ShareResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+		ShareToken: token,
+		ShareURL:   shareURL,
+		ShareCount: shareCount,
+	}, nil
+}
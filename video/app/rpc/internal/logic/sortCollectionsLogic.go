@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"gorm.io/gorm"
+	"strconv"
+
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/video"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SortCollectionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSortCollectionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SortCollectionsLogic {
+	return &SortCollectionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SortCollections 调整用户合集的顺序，请求需要包含用户的全部合集 id，且不能重复
+func (l *SortCollectionsLogic) SortCollections(in *video.SortCollectionsReq) (*video.CollectionActionResp, error) {
+	userid, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+
+	var ids []uint64
+	err = l.svcCtx.Db.Model(&model.Collection{}).Where("user_id = ?", userid).Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		owned[id] = true
+	}
+	if len(in.CollectionIdList) != len(ids) {
+		return &video.CollectionActionResp{
+			StatusCode: STATUS_FAIL,
+			StatusMsg:  STATUS_FAIL_PARAM_MSG,
+		}, nil
+	}
+	for _, id := range in.CollectionIdList {
+		if !owned[id] {
+			return &video.CollectionActionResp{
+				StatusCode: STATUS_FAIL,
+				StatusMsg:  STATUS_FAIL_PARAM_MSG,
+			}, nil
+		}
+		delete(owned, id) // 重复的 id 第二次会查不到
+	}
+
+	err = l.svcCtx.Db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range in.CollectionIdList {
+			err := tx.Model(&model.Collection{}).Where("id = ?", id).Update("sort", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &video.CollectionActionResp{
+		StatusCode: STATUS_SUCCESS,
+		StatusMsg:  STATUS_SUCCESS_MSG,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"github.com/gomodule/redigo/redis"
+	"gorm.io/gorm"
+)
+
+// updateVideoTopics 视频公开状态变化后更新其所属话题的视频数与话题视频列表缓存，
+// delta 为 1 表示视频变为公开（加入最新视频列表缓存），为 -1 表示视频不再公开（从最新与热门视频列表缓存中删除）
+func updateVideoTopics(svcCtx *svc.ServiceContext, conn redis.Conn, v *model.Video, delta int64) error {
+	topicIds, err := updateTopicVideoCount(svcCtx.Db, v.Id, delta)
+	if err != nil {
+		return err
+	}
+	if len(topicIds) == 0 {
+		return nil
+	}
+
+	if delta > 0 {
+		return svcCtx.Redis.AddTopicVideo(conn, topicIds, v.Id, v.CreateTime, svcCtx.Config.CacheConfig.TOPIC_VIDEO_MAX_CACHE_SIZE)
+	}
+	return svcCtx.Redis.DelTopicVideo(conn, topicIds, v.Id)
+}
+
+// updateTopicVideoCount 更新视频所属话题的视频数，返回视频所属的话题 id，db 可以是事务
+func updateTopicVideoCount(db *gorm.DB, videoId uint64, delta int64) ([]uint64, error) {
+	var topicIds []uint64
+	err := db.Model(&model.VideoTopic{}).Where("video_id = ?", videoId).Pluck("topic_id", &topicIds).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(topicIds) == 0 {
+		return nil, nil
+	}
+
+	err = db.Model(&model.Topic{}).Where("id in ?", topicIds).
+		Update("video_count", gorm.Expr("video_count + ?", delta)).Error
+	if err != nil {
+		return nil, err
+	}
+	return topicIds, nil
+}
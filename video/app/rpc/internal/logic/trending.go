@@ -0,0 +1,16 @@
+package logic
+
+import (
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"github.com/gomodule/redigo/redis"
+	"time"
+)
+
+// addTrendingScore 视频产生互动后将热度分数累加到热门排行榜的当前时间桶，
+// score 为互动对应的权重（TrendingConfig），撤销互动（取消点赞、删除评论）时为负数
+func addTrendingScore(svcCtx *svc.ServiceContext, conn redis.Conn, videoId uint64, score float64) error {
+	if score == 0 {
+		return nil
+	}
+	return svcCtx.Redis.IncrTrending(conn, videoId, score, time.Now().Unix())
+}
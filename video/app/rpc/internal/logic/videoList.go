@@ -0,0 +1,135 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"Mini-Tiktok/video/app/rpc/video"
+	"context"
+	"errors"
+	"github.com/gomodule/redigo/redis"
+	"strconv"
+)
+
+// toVideoList 为视频补充作者信息、点赞数、评论数、播放次数以及当前用户是否点赞，转换为视频服务返回的 Video，
+// 未通过审核或已下架的视频只有作者本人可见，缓存未命中时的回写命令写入发送缓冲区，由调用方 Flush
+func toVideoList(ctx context.Context, svcCtx *svc.ServiceContext, conn redis.Conn, userid uint64, modelVideoList []*model.Video) ([]*video.Video, error) {
+	// 过滤未通过审核或已下架的视频，以及设置了可见范围且当前用户无权查看的视频（作者本人仍可见）
+	var authorIds []uint64
+	for _, v := range modelVideoList {
+		if v.IsRestricted() {
+			authorIds = append(authorIds, v.UserId)
+		}
+	}
+	viewer, err := newVideoViewer(ctx, svcCtx, userid, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	filtered := modelVideoList[:0]
+	for _, v := range modelVideoList {
+		if (v.IsPublic() || v.UserId == userid) && viewer.CanView(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	modelVideoList = filtered
+
+	// 批量获取视频播放次数与分享数
+	videoIds := make([]uint64, len(modelVideoList))
+	for i, v := range modelVideoList {
+		videoIds[i] = v.Id
+	}
+	views, err := viewCounts(svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := shareCounts(svcCtx, conn, videoIds)
+	if err != nil {
+		return nil, err
+	}
+
+	videoList := make([]*video.Video, len(modelVideoList))
+	for i, v := range modelVideoList {
+		// 使用视频作者 id 查作者信息
+		var userInfo *video.User
+		r, err := svcCtx.UserRpc.GetUser(ctx, &userrpc.GetUserReq{
+			UserID:  strconv.FormatUint(userid, 10),
+			QueryID: strconv.FormatUint(v.UserId, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		userInfo = toVideoUser(r.User)
+
+		if r.StatusCode == STATUS_FAIL {
+			return nil, errors.New(r.StatusMsg)
+		}
+
+		// 一次性获取点赞数，评论数，用户是否点赞过视频的缓存数据
+		favCount, comCount, isFavor, err := svcCtx.Redis.GetExFavComCountIsFavor(conn, v.Id, userid,
+			svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL,
+			svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// 如果缓存未找到，还需要查库，以下相同
+		if favCount == COUNT_NOT_FOUND {
+			err := svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{VideoId: v.Id}).Count(&favCount).Error
+			if err != nil {
+				return nil, err
+			}
+
+			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
+			err = svcCtx.Redis.SendSetExFavorCount(conn, v.Id, favCount, svcCtx.Config.CacheConfig.FAVORITE_CACHE_TTL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if comCount == COUNT_NOT_FOUND {
+			err := svcCtx.Db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id, Status: model.CommentStatusVisible}).Count(&comCount).Error
+			if err != nil {
+				return nil, err
+			}
+
+			// 将更新 Redis 命令写入缓冲区，后续更新命令一起调用 Flush() 提交，节省 RTT
+			err = svcCtx.Redis.SendSetExCommentCount(conn, v.Id, comCount, svcCtx.Config.CacheConfig.COMMENT_CACHE_TTL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !isFavor {
+			var cnt int64
+			err = svcCtx.Db.Model(&model.Favorite{}).Where(&model.Favorite{UserId: userid, VideoId: v.Id}).Count(&cnt).Error
+			if err != nil {
+				return nil, err
+			}
+			if cnt > 0 {
+				isFavor = true
+			}
+		}
+
+		vid := &video.Video{
+			Author:        userInfo,
+			CommentCount:  comCount,
+			CoverURL:      v.CoverUrl,
+			FavoriteCount: favCount,
+			ViewCount:     views[i],
+			ShareCount:    shares[i],
+			ID:            v.Id,
+			IsFavorite:    isFavor,
+			PlayURL:       v.PlayUrl,
+			DownloadURL:   v.DownloadUrl,
+			PreviewURL:    v.PreviewUrl,
+			SpriteURL:     v.SpriteUrl,
+			SpriteVTTURL:  v.SpriteVttUrl,
+			Title:         v.Title,
+		}
+		videoList[i] = vid
+	}
+
+	return videoList, nil
+}
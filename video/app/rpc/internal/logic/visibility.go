@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"Mini-Tiktok/user/app/rpc/userrpc"
+	"Mini-Tiktok/video/app/rpc/internal/svc"
+	"Mini-Tiktok/video/app/rpc/model"
+	"context"
+	"errors"
+	"strconv"
+)
+
+// videoViewer 当前用户与受限视频作者的关注关系，用于判断设置了可见范围的视频是否对当前用户可见
+// 关注关系每次请求时从用户服务获取，不写入缓存；视频缓存对所有用户共用，只能在读取缓存之后按当前用户过滤
+type videoViewer struct {
+	userid   uint64
+	follow   map[uint64]bool // 当前用户关注的作者
+	follower map[uint64]bool // 关注了当前用户的作者
+}
+
+// newVideoViewer 从用户服务获取当前用户与 authorIds 中作者的关注关系，
+// authorIds 只需要包含设置了可见范围的视频的作者，未登录用户（userid 为 0）不需要查询
+func newVideoViewer(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, authorIds []uint64) (*videoViewer, error) {
+	w := &videoViewer{
+		userid:   userid,
+		follow:   make(map[uint64]bool),
+		follower: make(map[uint64]bool),
+	}
+	if userid == 0 {
+		return w, nil
+	}
+
+	seen := make(map[uint64]bool, len(authorIds))
+	queryIds := make([]uint64, 0, len(authorIds))
+	for _, id := range authorIds {
+		if id != userid && !seen[id] {
+			seen[id] = true
+			queryIds = append(queryIds, id)
+		}
+	}
+	if len(queryIds) == 0 {
+		return w, nil
+	}
+
+	r, err := svcCtx.UserRpc.GetRelations(ctx, &userrpc.RelationsReq{
+		UserId:      strconv.FormatUint(userid, 10),
+		QueryIdList: queryIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != STATUS_SUCCESS {
+		return nil, errors.New(r.StatusMsg)
+	}
+	for _, rel := range r.RelationList {
+		w.follow[rel.UserId] = rel.IsFollow
+		w.follower[rel.UserId] = rel.IsFollower
+	}
+	return w, nil
+}
+
+// canViewVideo 判断单个视频的可见范围是否允许当前用户查看，只有设置了可见范围的视频才需要查询关注关系
+func canViewVideo(ctx context.Context, svcCtx *svc.ServiceContext, userid uint64, v *model.Video) (bool, error) {
+	if !v.IsRestricted() || v.UserId == userid {
+		return true, nil
+	}
+	viewer, err := newVideoViewer(ctx, svcCtx, userid, []uint64{v.UserId})
+	if err != nil {
+		return false, err
+	}
+	return viewer.CanView(v), nil
+}
+
+// CanView 判断视频的可见范围是否允许当前用户查看（不检查审核状态），作者本人总是可见的
+func (w *videoViewer) CanView(v *model.Video) bool {
+	if !v.IsRestricted() || v.UserId == w.userid {
+		return true
+	}
+	switch v.Visibility {
+	case model.VideoVisibilityFollowers:
+		return w.follow[v.UserId]
+	case model.VideoVisibilityFriends:
+		return w.follow[v.UserId] && w.follower[v.UserId]
+	}
+	return false // 仅自己可见以及无法识别的可见范围
+}
@@ -51,3 +51,123 @@ func (s *VideoRpcServer) GetFavoriteList(ctx context.Context, in *video.Favorite
 	l := logic.NewGetFavoriteListLogic(ctx, s.svcCtx)
 	return l.GetFavoriteList(in)
 }
+
+func (s *VideoRpcServer) ListReviewQueue(ctx context.Context, in *video.ReviewQueueReq) (*video.ReviewQueueResp, error) {
+	l := logic.NewListReviewQueueLogic(ctx, s.svcCtx)
+	return l.ListReviewQueue(in)
+}
+
+func (s *VideoRpcServer) ReviewVideo(ctx context.Context, in *video.ReviewVideoReq) (*video.ReviewVideoResp, error) {
+	l := logic.NewReviewVideoLogic(ctx, s.svcCtx)
+	return l.ReviewVideo(in)
+}
+
+func (s *VideoRpcServer) ReportAction(ctx context.Context, in *video.ReportReq) (*video.ReportResp, error) {
+	l := logic.NewReportActionLogic(ctx, s.svcCtx)
+	return l.ReportAction(in)
+}
+
+func (s *VideoRpcServer) ListReportTargets(ctx context.Context, in *video.ReportTargetListReq) (*video.ReportTargetListResp, error) {
+	l := logic.NewListReportTargetsLogic(ctx, s.svcCtx)
+	return l.ListReportTargets(in)
+}
+
+func (s *VideoRpcServer) RestoreReportTarget(ctx context.Context, in *video.RestoreReportTargetReq) (*video.RestoreReportTargetResp, error) {
+	l := logic.NewRestoreReportTargetLogic(ctx, s.svcCtx)
+	return l.RestoreReportTarget(in)
+}
+
+func (s *VideoRpcServer) GetVideoList(ctx context.Context, in *video.VideoListReq) (*video.VideoListResp, error) {
+	l := logic.NewGetVideoListLogic(ctx, s.svcCtx)
+	return l.GetVideoList(in)
+}
+
+func (s *VideoRpcServer) GetTopicVideoList(ctx context.Context, in *video.TopicVideoListReq) (*video.TopicVideoListResp, error) {
+	l := logic.NewGetTopicVideoListLogic(ctx, s.svcCtx)
+	return l.GetTopicVideoList(in)
+}
+
+func (s *VideoRpcServer) GetTrendingList(ctx context.Context, in *video.TrendingReq) (*video.TrendingResp, error) {
+	l := logic.NewGetTrendingListLogic(ctx, s.svcCtx)
+	return l.GetTrendingList(in)
+}
+
+func (s *VideoRpcServer) GetWatchHistory(ctx context.Context, in *video.WatchHistoryReq) (*video.WatchHistoryResp, error) {
+	l := logic.NewGetWatchHistoryLogic(ctx, s.svcCtx)
+	return l.GetWatchHistory(in)
+}
+
+func (s *VideoRpcServer) DeleteWatchHistory(ctx context.Context, in *video.DeleteWatchHistoryReq) (*video.WatchHistoryActionResp, error) {
+	l := logic.NewDeleteWatchHistoryLogic(ctx, s.svcCtx)
+	return l.DeleteWatchHistory(in)
+}
+
+func (s *VideoRpcServer) ClearWatchHistory(ctx context.Context, in *video.ClearWatchHistoryReq) (*video.WatchHistoryActionResp, error) {
+	l := logic.NewClearWatchHistoryLogic(ctx, s.svcCtx)
+	return l.ClearWatchHistory(in)
+}
+
+func (s *VideoRpcServer) SetWatchHistoryPaused(ctx context.Context, in *video.WatchHistoryPausedReq) (*video.WatchHistoryActionResp, error) {
+	l := logic.NewSetWatchHistoryPausedLogic(ctx, s.svcCtx)
+	return l.SetWatchHistoryPaused(in)
+}
+
+func (s *VideoRpcServer) GetCreatorStats(ctx context.Context, in *video.CreatorStatsReq) (*video.CreatorStatsResp, error) {
+	l := logic.NewGetCreatorStatsLogic(ctx, s.svcCtx)
+	return l.GetCreatorStats(in)
+}
+
+func (s *VideoRpcServer) CollectionAction(ctx context.Context, in *video.CollectionActionReq) (*video.CollectionActionResp, error) {
+	l := logic.NewCollectionActionLogic(ctx, s.svcCtx)
+	return l.CollectionAction(in)
+}
+
+func (s *VideoRpcServer) SortCollections(ctx context.Context, in *video.SortCollectionsReq) (*video.CollectionActionResp, error) {
+	l := logic.NewSortCollectionsLogic(ctx, s.svcCtx)
+	return l.SortCollections(in)
+}
+
+func (s *VideoRpcServer) CollectionVideoAction(ctx context.Context, in *video.CollectionVideoActionReq) (*video.CollectionActionResp, error) {
+	l := logic.NewCollectionVideoActionLogic(ctx, s.svcCtx)
+	return l.CollectionVideoAction(in)
+}
+
+func (s *VideoRpcServer) GetCollectionList(ctx context.Context, in *video.CollectionListReq) (*video.CollectionListResp, error) {
+	l := logic.NewGetCollectionListLogic(ctx, s.svcCtx)
+	return l.GetCollectionList(in)
+}
+
+func (s *VideoRpcServer) GetCollectionVideoList(ctx context.Context, in *video.CollectionVideoListReq) (*video.CollectionVideoListResp, error) {
+	l := logic.NewGetCollectionVideoListLogic(ctx, s.svcCtx)
+	return l.GetCollectionVideoList(in)
+}
+
+func (s *VideoRpcServer) ShareAction(ctx context.Context, in *video.ShareReq) (*video.ShareResp, error) {
+	l := logic.NewShareActionLogic(ctx, s.svcCtx)
+	return l.ShareAction(in)
+}
+
+func (s *VideoRpcServer) ResolveShare(ctx context.Context, in *video.ResolveShareReq) (*video.ResolveShareResp, error) {
+	l := logic.NewResolveShareLogic(ctx, s.svcCtx)
+	return l.ResolveShare(in)
+}
+
+func (s *VideoRpcServer) SetVideoVisibility(ctx context.Context, in *video.VideoVisibilityReq) (*video.VideoVisibilityResp, error) {
+	l := logic.NewSetVideoVisibilityLogic(ctx, s.svcCtx)
+	return l.SetVideoVisibility(in)
+}
+
+func (s *VideoRpcServer) GetDraftList(ctx context.Context, in *video.DraftListReq) (*video.DraftListResp, error) {
+	l := logic.NewGetDraftListLogic(ctx, s.svcCtx)
+	return l.GetDraftList(in)
+}
+
+func (s *VideoRpcServer) DraftAction(ctx context.Context, in *video.DraftActionReq) (*video.DraftActionResp, error) {
+	l := logic.NewDraftActionLogic(ctx, s.svcCtx)
+	return l.DraftAction(in)
+}
+
+func (s *VideoRpcServer) GetDuplicateOriginal(ctx context.Context, in *video.DuplicateOriginalReq) (*video.DuplicateOriginalResp, error) {
+	l := logic.NewGetDuplicateOriginalLogic(ctx, s.svcCtx)
+	return l.GetDuplicateOriginal(in)
+}
@@ -1,6 +1,7 @@
 package svc
 
 import (
+	"Mini-Tiktok/common/moderation"
 	"Mini-Tiktok/user/app/rpc/userrpc"
 	"Mini-Tiktok/video/app/rpc/internal/config"
 	"Mini-Tiktok/video/app/rpc/model"
@@ -13,14 +14,23 @@ import (
 )
 
 type ServiceContext struct {
-	Config      config.Config
-	UserRpc     userrpc.UserRpc
-	Redis       *redisCache.RedisPool
-	Db          *gorm.DB
-	KafkaWriter *kafka.Writer
+	Config       config.Config
+	UserRpc      userrpc.UserRpc
+	Redis        *redisCache.RedisPool
+	Db           *gorm.DB
+	KafkaWriter  *kafka.Writer
+	PushWriter   *kafka.Writer
+	SearchWriter *kafka.Writer
+	Moderation   *moderation.Filter
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	// 分享链接签名密钥单独配置，避免与 jwt 密钥共用时一方泄露影响另一方
+	if c.ShareConfig.Secret == "" || c.ShareConfig.Secret == c.ShareConfig.JwtSecret {
+		log.Fatalln("ShareConfig.Secret must be set and differ from the jwt AccessSecret")
+		return nil
+	}
+
 	db, err := model.InitGorm(c.DbConfig)
 	if err != nil {
 		log.Fatalln(err)
@@ -36,17 +46,39 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		return nil
 	}
 
+	filter, err := moderation.NewFilter(c.Moderation)
+	if err != nil {
+		log.Fatalln(err)
+		return nil
+	}
+
+	searchWriter := getKafkaWriter(c.SearchConfig.Host,
+		c.SearchConfig.Topic,
+		c.SearchConfig.BatchTimeout,
+		c.SearchConfig.BatchSize,
+		c.SearchConfig.BatchBytes,
+	)
+	searchWriter.Balancer = &kafka.Hash{} // 按文档 id 分区，保证同一文档的事件有序
+
 	return &ServiceContext{
-		Config:  c,
-		UserRpc: userrpc.NewUserRpc(zrpc.MustNewClient(c.UserRpc)),
-		Redis:   pool,
-		Db:      db,
+		Config:     c,
+		UserRpc:    userrpc.NewUserRpc(zrpc.MustNewClient(c.UserRpc)),
+		Redis:      pool,
+		Db:         db,
+		Moderation: filter,
 		KafkaWriter: getKafkaWriter(c.KafkaConfig.Host,
 			c.KafkaConfig.Topic,
 			c.KafkaConfig.BatchTimeout,
 			c.KafkaConfig.BatchSize,
 			c.KafkaConfig.BatchBytes,
 		),
+		PushWriter: getKafkaWriter(c.PushConfig.Host,
+			c.PushConfig.Topic,
+			c.PushConfig.BatchTimeout,
+			c.PushConfig.BatchSize,
+			c.PushConfig.BatchBytes,
+		),
+		SearchWriter: searchWriter,
 	}
 }
 
@@ -0,0 +1,12 @@
+package model
+
+// VideoCacheTask 表结构，发布草稿或定时视频后待完成的缓存更新
+// 与发布视频的 DB 更新在同一个事务中写入，缓存更新完成后删除；缓存更新失败时保留，由定时任务重试
+type VideoCacheTask struct {
+	VideoId    uint64 `gorm:"column:video_id"`
+	CreateTime int64  `gorm:"column:create_time"`
+}
+
+func (VideoCacheTask) TableName() string {
+	return "video_cache_task"
+}
@@ -0,0 +1,38 @@
+package model
+
+// Collection 表结构，用户创建的视频合集
+type Collection struct {
+	Id         uint64 `gorm:"column:id"`
+	UserId     uint64 `gorm:"column:user_id"`
+	Name       string `gorm:"column:name"`
+	Status     string `gorm:"column:status"` // 0-私密，1-公开
+	VideoCount int64  `gorm:"column:video_count"`
+	Sort       int64  `gorm:"column:sort"` // 合集在用户合集列表中的顺序，越小越靠前
+	CreateTime int64  `gorm:"column:create_time"`
+	UpdateTime int64  `gorm:"column:update_time"`
+}
+
+// CollectionVideo 表结构，合集中的视频
+type CollectionVideo struct {
+	CollectionId uint64 `gorm:"column:collection_id"`
+	VideoId      uint64 `gorm:"column:video_id"`
+	CreateTime   int64  `gorm:"column:create_time"` // 加入合集的时间
+}
+
+const (
+	CollectionPrivate = "0" // 私密合集，只有创建者可见
+	CollectionPublic  = "1" // 公开合集，所有用户可见
+)
+
+func (Collection) TableName() string {
+	return "collection"
+}
+
+func (CollectionVideo) TableName() string {
+	return "collection_video"
+}
+
+// IsPublic 判断合集是否对所有用户可见
+func (c Collection) IsPublic() bool {
+	return c.Status == CollectionPublic
+}
@@ -9,9 +9,13 @@ type Comment struct {
 	VideoId    uint64 `json:"video_id" gorm:"column:video_id"`
 	Content    string `json:"content" gorm:"column:content"`
 	CreateTime int64  `json:"createTime" gorm:"column:create_time"`
+	Status     string `json:"status" gorm:"column:status"` // 1-正常，0-被举报隐藏待审核
 }
 
 const (
+	CommentStatusHidden  = "0"
+	CommentStatusVisible = "1"
+
 	ComCacheKeyPrefix      = "Com:CommentId:CommentJson:"
 	ComIdCacheKeyPrefix    = "Com:VideoId:CommentId:ZSET:"
 	ComCountCacheKeyPrefix = "Com:VideoId:CommentCount:"
@@ -0,0 +1,41 @@
+package model
+
+import "strconv"
+
+// WatchHistory 表结构，用户观看历史的归档，Redis 中只保留每个用户最近的观看记录，超出的部分由播放事件消费者写入该表
+type WatchHistory struct {
+	UserId    uint64 `gorm:"column:user_id"`
+	VideoId   uint64 `gorm:"column:video_id"`
+	WatchTime int64  `gorm:"column:watch_time"`
+}
+
+// WatchHistorySetting 表结构，用户的观看历史设置
+type WatchHistorySetting struct {
+	UserId     uint64 `gorm:"column:user_id"`
+	Paused     string `gorm:"column:paused"` // 0-记录观看历史，1-暂停记录
+	UpdateTime int64  `gorm:"column:update_time"`
+}
+
+const (
+	HistoryRecording = "0"
+	HistoryPaused    = "1"
+
+	HistoryCacheKeyPrefix = "History:UserId:VideoId:ZSET:"
+	HistoryPausedCacheKey = "History:Paused:UserId:SET"
+)
+
+func (WatchHistory) TableName() string {
+	return "watch_history"
+}
+
+func (WatchHistorySetting) TableName() string {
+	return "watch_history_setting"
+}
+
+// CacheKey 返回 用户最近观看历史 对应的缓存 key 名称，
+// 缓存类型为 zset 类型，key: History:UserId:VideoId:ZSET:{用户id}, member: {视频id}, score: 观看时间
+// 缓存不设置过期时间，超过 HistoryMaxSize 的最早记录归档到 DB
+// 暂停记录观看历史的用户 id 保存在 History:Paused:UserId:SET 集合中，服务启动时从 DB 加载
+func (WatchHistory) CacheKey(userid uint64) string {
+	return HistoryCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
@@ -0,0 +1,42 @@
+package model
+
+import "strconv"
+
+// VideoPlayStat 表结构，视频播放统计，由播放事件消费者定时从 Redis 累加写入
+type VideoPlayStat struct {
+	VideoId       uint64 `gorm:"column:video_id"`
+	ViewCount     int64  `gorm:"column:view_count"`     // 播放次数
+	CompleteCount int64  `gorm:"column:complete_count"` // 完播次数
+	WatchDuration int64  `gorm:"column:watch_duration"` // 完播上报的观看时长总和，单位 ms
+	UniqueViewers int64  `gorm:"column:unique_viewers"` // 独立观众数（HyperLogLog 估计值）
+	UpdateTime    int64  `gorm:"column:update_time"`
+}
+
+const (
+	PlayViewCountCacheKeyPrefix = "Play:VideoId:ViewCount:"
+	PlayDeltaCacheKeyPrefix     = "Play:VideoId:Delta:HASH:"
+)
+
+func (VideoPlayStat) TableName() string {
+	return "video_play_stat"
+}
+
+// CompletionRate 返回视频的完播率
+func (s VideoPlayStat) CompletionRate() float64 {
+	if s.ViewCount == 0 {
+		return 0
+	}
+	return float64(s.CompleteCount) / float64(s.ViewCount)
+}
+
+// ViewCountCacheKey 返回 视频播放次数 对应的缓存 key 名称，
+// 缓存类型为 string 类型，key: Play:VideoId:ViewCount:{视频id} value: 播放次数（包括还未写入 DB 的部分）
+func (VideoPlayStat) ViewCountCacheKey(videoId uint64) string {
+	return PlayViewCountCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
+
+// DeltaCacheKey 返回 视频未写入 DB 的播放统计 对应的缓存 key 名称，
+// 缓存类型为 hash 类型，key: Play:VideoId:Delta:HASH:{视频id} field: view_count, complete_count, watch_duration
+func (VideoPlayStat) DeltaCacheKey(videoId uint64) string {
+	return PlayDeltaCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
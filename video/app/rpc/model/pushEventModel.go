@@ -0,0 +1,20 @@
+package model
+
+const (
+	PushEventComment  = "comment"  // 自己的视频收到新评论
+	PushEventFavorite = "favorite" // 自己的视频被点赞
+)
+
+// PushEvent 写入 Kafka 推送主题的事件，由 api 网关通过 WebSocket 推送给 UserId 对应的用户
+type PushEvent struct {
+	Type       string      `json:"type"`        // 事件类型
+	UserId     uint64      `json:"user_id"`     // 接收事件的用户 id
+	Data       interface{} `json:"data"`        // 事件内容
+	CreateTime int64       `json:"create_time"` // 事件产生时间（毫秒时间戳）
+}
+
+// FavoriteEventData 视频被点赞事件内容
+type FavoriteEventData struct {
+	UserId  uint64 `json:"user_id"`  // 点赞用户 id
+	VideoId uint64 `json:"video_id"` // 被点赞的视频 id
+}
@@ -1,6 +1,7 @@
 package redisCache
 
 import (
+	"Mini-Tiktok/common/trending"
 	"Mini-Tiktok/video/app/rpc/internal/config"
 	"Mini-Tiktok/video/app/rpc/model"
 	"encoding/json"
@@ -8,6 +9,8 @@ import (
 	"fmt"
 	"github.com/gomodule/redigo/redis"
 	"gorm.io/gorm"
+	"math"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -304,7 +307,17 @@ func (p *RedisPool) DelComment(conn redis.Conn, videoId, commentId uint64, ttl i
 	return nil
 }
 
-// AddVideoInfoAndFeed 将视频信息加入 Redis 中视频信息缓存， Feed 流缓存以及用户最近发布视频列表缓存
+// DelCommentList 删除视频的评论 id 列表与评论数缓存，由下次查询时从 DB 加载，用于恢复被隐藏的评论
+func (p *RedisPool) DelCommentList(conn redis.Conn, videoId uint64) error {
+	_, err := conn.Do("DEL", model.Comment{}.IdCacheKey(videoId), model.Comment{}.CountCacheKey(videoId))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddVideoInfoAndFeed 将视频信息加入 Redis 中视频信息缓存， Feed 流缓存以及用户最近发布视频列表缓存，
+// 用户最近发布视频列表缓存只在存在时加入，不存在时由下次查询从 DB 加载，避免缓存中只有部分发布列表
 // 使用 lua 脚本将多次操作整合为一次 RTT
 func (p *RedisPool) AddVideoInfoAndFeed(conn redis.Conn, userid, videoId uint64, videoJson []byte, createTime int64, ttl int) error {
 	FeedCacheKey := model.Video{}.FeedCacheKey()
@@ -316,8 +329,9 @@ func (p *RedisPool) AddVideoInfoAndFeed(conn redis.Conn, userid, videoId uint64,
 		"redis.call('SET', KEYS[2], ARGV[2],'EXAT', ARGV[5]); "+
 			"redis.call('ZADD', KEYS[1], ARGV[3], ARGV[2]); "+
 			"redis.call('EXPIREAT', KEYS[1], ARGV[5]); "+
+			"if (redis.call('EXISTS', KEYS[3]) == 1) then "+
 			"redis.call('ZADD', KEYS[3], ARGV[3], ARGV[1]); "+
-			"redis.call('EXPIREAT', KEYS[3], ARGV[5]); "+
+			"redis.call('EXPIREAT', KEYS[3], ARGV[5]); end; "+
 			"if (redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[4])) then "+
 			"redis.call('ZPOPMIN', KEYS[1], ARGV[4]/10); end; "+
 			"return nil; ", 3, FeedCacheKey, VideoCacheKey, PubListCacheKey, videoId, videoJson, createTime, cacheConfig.FEED_MAX_CACHE_SIZE, Exat)
@@ -327,6 +341,72 @@ func (p *RedisPool) AddVideoInfoAndFeed(conn redis.Conn, userid, videoId uint64,
 	return nil
 }
 
+// DelVideoInfoAndFeed 将视频从视频信息缓存，Feed 流缓存以及用户最近发布视频列表缓存中删除（视频未通过审核或被下架）
+// Feed 缓存的 member 为视频信息 json，先按发布时间取出同一时间戳的视频，再按视频 id 匹配删除
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) DelVideoInfoAndFeed(conn redis.Conn, userid, videoId uint64, createTime int64) error {
+	FeedCacheKey := model.Video{}.FeedCacheKey()
+	VideoCacheKey := model.Video{}.CacheKey(videoId)
+	PubListCacheKey := model.Video{}.PublishListCacheKey(userid)
+	_, err := conn.Do("EVAL",
+		"local idField = '\"id\":'..ARGV[1]..','; "+
+			"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[2], ARGV[2], 'BYSCORE'); "+
+			"for i, m in pairs(zlist) do "+
+			"if (string.find(m, idField, 1, true) ~= nil) then "+
+			"redis.call('ZREM', KEYS[1], m); end; end; "+
+			"redis.call('DEL', KEYS[2]); "+
+			"redis.call('ZREM', KEYS[3], ARGV[1]); "+
+			"return nil; ", 3, FeedCacheKey, VideoCacheKey, PubListCacheKey, videoId, createTime)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateVideoInfoAndFeed 更新视频信息缓存与 Feed 流缓存中的视频信息（如修改可见范围），缓存中不存在该视频时不写入
+// Feed 缓存的 member 为视频信息 json，与 DelVideoInfoAndFeed 相同按发布时间与视频 id 找到旧的 member 后替换
+// 使用 lua 脚本将多次操作整合为一次 RTT，读取缓存的请求不会看到新旧信息同时存在或都不存在
+func (p *RedisPool) UpdateVideoInfoAndFeed(conn redis.Conn, videoId uint64, videoJson []byte, createTime int64) error {
+	FeedCacheKey := model.Video{}.FeedCacheKey()
+	VideoCacheKey := model.Video{}.CacheKey(videoId)
+	_, err := conn.Do("EVAL",
+		"local idField = '\"id\":'..ARGV[1]..','; "+
+			"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[2], ARGV[2], 'BYSCORE'); "+
+			"for i, m in pairs(zlist) do "+
+			"if (string.find(m, idField, 1, true) ~= nil) then "+
+			"redis.call('ZREM', KEYS[1], m); "+
+			"redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3]); end; end; "+
+			"if (redis.call('EXISTS', KEYS[2]) == 1) then "+
+			"redis.call('SET', KEYS[2], ARGV[3], 'KEEPTTL'); end; "+
+			"return nil; ", 2, FeedCacheKey, VideoCacheKey, videoId, createTime, videoJson)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IncrWorkCount 用户信息缓存中存在作品数时将其加上 delta，不存在时由用户服务下次查询时从 DB 加载
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrWorkCount(conn redis.Conn, userid uint64, delta int64) error {
+	_, err := conn.Do("EVAL", "if (redis.call('HEXISTS', KEYS[1], ARGV[1]) == 1) then "+
+		"redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[2]); end; "+
+		"return nil; ", 1, model.User{}.CacheKey(userid), model.WorkCountField, delta)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelWorkCount 删除用户信息缓存中的作品数，由用户服务下次查询时从 DB 重新统计
+// 与 IncrWorkCount 不同，重复执行的结果相同，用于可能重试的缓存更新
+func (p *RedisPool) DelWorkCount(conn redis.Conn, userid uint64) error {
+	_, err := conn.Do("HDEL", model.User{}.CacheKey(userid), model.WorkCountField)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // SendAddVideoInfo 将视频信息加入 Redis 中视频信息缓存与用户最近发布视频列表缓存
 // 注意该函数只是将命令写到缓冲区上，并未发送，需要调用 Redis 连接使用 Flush() 发送
 func (p *RedisPool) SendAddVideoInfo(conn redis.Conn, userid, videoId uint64, videoJson []byte, createTime int64, ttl int) error {
@@ -595,6 +675,490 @@ func (p *RedisPool) GetFeed(conn redis.Conn, latestTime, count int64) ([][]byte,
 	return FeedJsonList, nil
 }
 
+// GetHiddenUsers 获取对用户隐藏视频与评论的用户 id 集合（拉黑关系与用户屏蔽的用户的并集），
+// 拉黑或屏蔽缓存不存在时 exists 返回 false
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) GetHiddenUsers(conn redis.Conn, userid uint64) ([]uint64, bool, error) {
+	raw, err := conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) == 1 and redis.call('EXISTS', KEYS[2]) == 1) then "+
+		"return redis.call('SUNION', KEYS[1], KEYS[2]); end; "+
+		"return nil; ", 2, model.Block{}.BlockCacheKey(userid), model.Block{}.MuteCacheKey(userid))
+	if err != nil {
+		return nil, false, err
+	}
+	if raw == nil {
+		return nil, false, nil
+	}
+	ids, err := redis.Uint64s(raw, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return ids, true, nil
+}
+
+// SetBlockCache 设置用户的拉黑与屏蔽用户 id 集合缓存，两个集合都会加入占位成员 0 以区分空集合与缓存不存在
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetBlockCache(conn redis.Conn, userid uint64, blockIds, muteIds []uint64, ttl int) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1], KEYS[2]); " +
+		"redis.call('SADD', KEYS[1], ARGV[3]); " +
+		"redis.call('SADD', KEYS[2], ARGV[3]); " +
+		"local n = tonumber(ARGV[2]); " +
+		"for i = 4, #ARGV do " +
+		"if (i - 3 <= n) then redis.call('SADD', KEYS[1], ARGV[i]); " +
+		"else redis.call('SADD', KEYS[2], ARGV[i]); end; end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"redis.call('EXPIRE', KEYS[2], ARGV[1]); " +
+		"return nil; ", 2, model.Block{}.BlockCacheKey(userid), model.Block{}.MuteCacheKey(userid),
+		ttl, len(blockIds), model.BlockCacheEmptyMember}
+	for _, id := range blockIds {
+		args = append(args, id)
+	}
+	for _, id := range muteIds {
+		args = append(args, id)
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetReportHiddenUsers 获取因被举报而自动隐藏的账号 id 集合，缓存不存在时 exists 返回 false
+func (p *RedisPool) GetReportHiddenUsers(conn redis.Conn) ([]uint64, bool, error) {
+	raw, err := conn.Do("SMEMBERS", model.ReportTarget{}.HiddenUserCacheKey())
+	if err != nil {
+		return nil, false, err
+	}
+	ids, err := redis.Uint64s(raw, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(ids) == 0 {
+		return nil, false, nil
+	}
+	return ids, true, nil
+}
+
+// SetReportHiddenUsers 设置因被举报而自动隐藏的账号 id 集合缓存，集合会加入占位成员 0 以区分空集合与缓存不存在
+// 使用 lua 脚本将多次操作整合成一次 RTT
+func (p *RedisPool) SetReportHiddenUsers(conn redis.Conn, ids []uint64, ttl int) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1]); " +
+		"for i = 2, #ARGV do redis.call('SADD', KEYS[1], ARGV[i]); end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"return nil; ", 1, model.ReportTarget{}.HiddenUserCacheKey(), ttl, model.BlockCacheEmptyMember}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelReportHiddenUsers 删除因被举报而自动隐藏的账号 id 集合缓存
+func (p *RedisPool) DelReportHiddenUsers(conn redis.Conn) error {
+	_, err := conn.Do("DEL", model.ReportTarget{}.HiddenUserCacheKey())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetExTopicVideoList 获取话题最新视频列表缓存中排在游标 (maxTime, maxId) 之后的 limit 个视频 id 与投稿时间，并刷新过期时间，
+// 视频按投稿时间从新到旧、投稿时间相同时按 id 从大到小排序，maxTime 为 0 时从最新的视频开始获取，
+// cached 返回缓存中的视频数量，缓存不存在时 exists 返回 false
+// ZSET 中分数相同的成员按字典序排序，与 id 的数值顺序不一定相同，所以同时取出与游标、本页最后一个视频投稿时间相同的全部成员，排序后再截取
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetExTopicVideoList(conn redis.Conn, topicId uint64, maxTime int64, maxId uint64, limit int64, ttl int) (ids []uint64, times []int64, cached int64, exists bool, err error) {
+	max := "+inf"
+	if maxTime > 0 {
+		max = strconv.FormatInt(maxTime, 10)
+	}
+	raw, err := conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) ~= 1) then return nil; end; "+
+		"redis.call('EXPIRE', KEYS[1], ARGV[3]); "+
+		"local res = {redis.call('ZCARD', KEYS[1]) - 1}; "+
+		"local n = tonumber(ARGV[2]); "+
+		"if (ARGV[1] ~= '+inf') then n = n + redis.call('ZCOUNT', KEYS[1], ARGV[1], ARGV[1]); end; "+
+		"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[1], '-inf', 'BYSCORE', 'REV', 'LIMIT', 0, n, 'WITHSCORES'); "+
+		"for i, v in ipairs(zlist) do table.insert(res, v); end; "+
+		"if (#zlist == 2 * n and n > 0) then "+
+		"local last = zlist[#zlist]; "+
+		"local tail = redis.call('ZRANGE', KEYS[1], last, last, 'BYSCORE', 'WITHSCORES'); "+
+		"for i, v in ipairs(tail) do table.insert(res, v); end; end; "+
+		"return res; ", 1, model.Topic{}.VideoCacheKey(topicId), max, limit, ttl)
+	if err != nil {
+		return nil, nil, 0, false, err
+	}
+	if raw == nil {
+		return nil, nil, 0, false, nil
+	}
+	vals, err := redis.Values(raw, nil)
+	if err != nil {
+		return nil, nil, 0, false, err
+	}
+	cached, err = redis.Int64(vals[0], nil)
+	if err != nil {
+		return nil, nil, 0, false, err
+	}
+	seen := make(map[uint64]int64, len(vals)/2)
+	for i := 1; i+1 < len(vals); i += 2 {
+		id, err := redis.Uint64(vals[i], nil)
+		if err != nil {
+			return nil, nil, 0, false, err
+		}
+		if id == model.TopicCacheEmptyMember {
+			continue
+		}
+		t, err := redis.Int64(vals[i+1], nil)
+		if err != nil {
+			return nil, nil, 0, false, err
+		}
+		if maxTime > 0 && (t > maxTime || (t == maxTime && id >= maxId)) {
+			continue
+		}
+		seen[id] = t
+	}
+	ids = make([]uint64, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if seen[ids[i]] != seen[ids[j]] {
+			return seen[ids[i]] > seen[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	if int64(len(ids)) > limit {
+		ids = ids[:limit]
+	}
+	times = make([]int64, len(ids))
+	for i, id := range ids {
+		times[i] = seen[id]
+	}
+	return ids, times, cached, true, nil
+}
+
+// SetTopicVideoList 设置话题最新视频列表缓存，集合会加入分数为 -1 的占位成员 0 以区分空列表与缓存不存在
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) SetTopicVideoList(conn redis.Conn, topicId uint64, ids []uint64, times []int64, ttl int) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1]); " +
+		"redis.call('ZADD', KEYS[1], -1, ARGV[2]); " +
+		"for i = 3, #ARGV, 2 do redis.call('ZADD', KEYS[1], ARGV[i+1], ARGV[i]); end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"return nil; ", 1, model.Topic{}.VideoCacheKey(topicId), ttl, model.TopicCacheEmptyMember}
+	for i, id := range ids {
+		args = append(args, id, times[i])
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTopicHotVideoList 获取话题热门视频列表缓存中排名 [offset, offset+limit) 的视频 id（按点赞数从多到少），
+// cached 返回缓存中的视频数量，缓存不存在时 exists 返回 false。热门列表不刷新过期时间，过期后从 DB 重新计算
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetTopicHotVideoList(conn redis.Conn, topicId uint64, offset, limit int64) (ids []uint64, cached int64, exists bool, err error) {
+	raw, err := conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) ~= 1) then return nil; end; "+
+		"local res = {redis.call('ZCARD', KEYS[1]) - 1}; "+
+		"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[1], ARGV[1] + ARGV[2] - 1, 'REV'); "+
+		"for i, v in ipairs(zlist) do table.insert(res, v); end; "+
+		"return res; ", 1, model.Topic{}.HotVideoCacheKey(topicId), offset, limit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if raw == nil {
+		return nil, 0, false, nil
+	}
+	vals, err := redis.Uint64s(raw, nil)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	for _, id := range vals[1:] {
+		if id != model.TopicCacheEmptyMember {
+			ids = append(ids, id)
+		}
+	}
+	return ids, int64(vals[0]), true, nil
+}
+
+// SetTopicHotVideoList 设置话题热门视频列表缓存，集合会加入分数为 -1 的占位成员 0 以区分空列表与缓存不存在
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) SetTopicHotVideoList(conn redis.Conn, topicId uint64, ids []uint64, favCounts []int64, ttl int) error {
+	args := []interface{}{"redis.call('DEL', KEYS[1]); " +
+		"redis.call('ZADD', KEYS[1], -1, ARGV[2]); " +
+		"for i = 3, #ARGV, 2 do redis.call('ZADD', KEYS[1], ARGV[i+1], ARGV[i]); end; " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); " +
+		"return nil; ", 1, model.Topic{}.HotVideoCacheKey(topicId), ttl, model.TopicCacheEmptyMember}
+	for i, id := range ids {
+		args = append(args, id, favCounts[i])
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddTopicVideo 视频公开后加入其所属话题的最新视频列表缓存（缓存存在时才加入），
+// 缓存超过 maxSize 时删除最早的视频（占位成员的分数为 -1，排名总是 0）
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) AddTopicVideo(conn redis.Conn, topicIds []uint64, videoId uint64, createTime int64, maxSize int) error {
+	args := []interface{}{"for i, k in ipairs(KEYS) do " +
+		"if (redis.call('EXISTS', k) == 1) then " +
+		"redis.call('ZADD', k, ARGV[2], ARGV[1]); " +
+		"if (redis.call('ZCARD', k) > tonumber(ARGV[3]) + 1) then " +
+		"redis.call('ZREMRANGEBYRANK', k, 1, 1); end; end; end; " +
+		"return nil; ", len(topicIds)}
+	for _, id := range topicIds {
+		args = append(args, model.Topic{}.VideoCacheKey(id))
+	}
+	args = append(args, videoId, createTime, maxSize)
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelTopicVideo 视频不再公开后从其所属话题的最新视频与热门视频列表缓存中删除
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) DelTopicVideo(conn redis.Conn, topicIds []uint64, videoId uint64) error {
+	args := []interface{}{"for i, k in ipairs(KEYS) do redis.call('ZREM', k, ARGV[1]); end; " +
+		"return nil; ", len(topicIds) * 2}
+	for _, id := range topicIds {
+		args = append(args, model.Topic{}.VideoCacheKey(id), model.Topic{}.HotVideoCacheKey(id))
+	}
+	args = append(args, videoId)
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IncrTrending 将视频的互动分数累加到每个时间窗口的当前时间桶，并设置时间桶的过期时间
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrTrending(conn redis.Conn, videoId uint64, score float64, now int64) error {
+	args := []interface{}{"for i, k in ipairs(KEYS) do " +
+		"redis.call('ZINCRBY', k, ARGV[1], ARGV[2]); " +
+		"redis.call('EXPIRE', k, ARGV[i+2]); end; " +
+		"return nil; ", len(trending.Windows)}
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketCacheKey(w.Bucket(now)))
+	}
+	args = append(args, score, videoId)
+	for _, w := range trending.Windows {
+		args = append(args, w.BucketTTL())
+	}
+	_, err := conn.Do("EVAL", args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTrendingList 获取时间窗口排行榜中排名 [offset, offset+limit) 的视频 id（按热度分数从高到低），total 返回排行榜中的视频数量
+// 排行榜缓存不存在时合并窗口内的时间桶：越早的时间桶权重越低，最早的时间桶权重为 decay，
+// 只保留分数为正的前 maxSize 个视频，并设置 ttl 秒的过期时间
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetTrendingList(conn redis.Conn, w trending.Window, now, offset, limit int64, decay float64, maxSize, ttl int) (ids []uint64, total int64, err error) {
+	keys := []interface{}{w.CacheKey()}
+	weights := make([]interface{}, 0, w.Buckets)
+	current := w.Bucket(now)
+	for i := int64(0); i < w.Buckets; i++ {
+		keys = append(keys, w.BucketCacheKey(current-i))
+		weights = append(weights, strconv.FormatFloat(math.Pow(decay, float64(i)/float64(w.Buckets-1)), 'f', -1, 64))
+	}
+	args := []interface{}{"if (redis.call('EXISTS', KEYS[1]) ~= 1) then " +
+		"local n = #KEYS - 1; " +
+		"local cmd = {'ZUNIONSTORE', KEYS[1], n}; " +
+		"for i = 2, #KEYS do table.insert(cmd, KEYS[i]); end; " +
+		"table.insert(cmd, 'WEIGHTS'); " +
+		"for i = 1, n do table.insert(cmd, ARGV[i + 4]); end; " +
+		"redis.call(unpack(cmd)); " +
+		"redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', 0); " +
+		"redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[3]) - 1); " +
+		"redis.call('EXPIRE', KEYS[1], ARGV[4]); end; " +
+		"local res = {redis.call('ZCARD', KEYS[1])}; " +
+		"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[1], ARGV[1] + ARGV[2] - 1, 'REV'); " +
+		"for i, v in ipairs(zlist) do table.insert(res, v); end; " +
+		"return res; ", len(keys)}
+	args = append(args, keys...)
+	args = append(args, offset, limit, maxSize, ttl)
+	args = append(args, weights...)
+	vals, err := redis.Uint64s(conn.Do("EVAL", args...))
+	if err != nil {
+		return nil, 0, err
+	}
+	return vals[1:], int64(vals[0]), nil
+}
+
+// GetExViewCounts 批量获取缓存视频播放次数并刷新过期时间，缓存不存在的视频返回 COUNT_NOT_FOUND
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetExViewCounts(conn redis.Conn, videoIds []uint64, ttl int) ([]int64, error) {
+	args := []interface{}{"local res = {}; " +
+		"for i, k in ipairs(KEYS) do " +
+		"local val = redis.call('GETEX', k, 'EX', ARGV[1]); " +
+		"if (val) then table.insert(res, tonumber(val)); else table.insert(res, -1); end; end; " +
+		"return res; ", len(videoIds)}
+	for _, id := range videoIds {
+		args = append(args, model.VideoPlayStat{}.ViewCountCacheKey(id))
+	}
+	args = append(args, ttl)
+	counts, err := redis.Int64s(conn.Do("EVAL", args...))
+	if err != nil {
+		return nil, err
+	}
+	for i := range counts {
+		if counts[i] < 0 {
+			counts[i] = COUNT_NOT_FOUND
+		}
+	}
+	return counts, nil
+}
+
+// SetViewCounts 批量设置缓存视频播放次数：DB 中的播放次数加上还未写入 DB 的播放次数，返回设置后的播放次数
+// 缓存已存在时不覆盖（播放事件消费者只在缓存存在时累加，避免覆盖掉期间累加的次数）
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) SetViewCounts(conn redis.Conn, videoIds []uint64, counts []int64, ttl int) ([]int64, error) {
+	args := []interface{}{"local res = {}; " +
+		"for i = 1, #KEYS, 2 do " +
+		"local delta = tonumber(redis.call('HGET', KEYS[i+1], 'view_count')) or 0; " +
+		"redis.call('SET', KEYS[i], ARGV[(i+1)/2 + 1] + delta, 'EX', ARGV[1], 'NX'); " +
+		"table.insert(res, tonumber(redis.call('GET', KEYS[i]))); end; " +
+		"return res; ", len(videoIds) * 2}
+	for _, id := range videoIds {
+		args = append(args, model.VideoPlayStat{}.ViewCountCacheKey(id), model.VideoPlayStat{}.DeltaCacheKey(id))
+	}
+	args = append(args, ttl)
+	for _, c := range counts {
+		args = append(args, c)
+	}
+	return redis.Int64s(conn.Do("EVAL", args...))
+}
+
+// GetExShareCounts 批量获取缓存视频分享数并刷新过期时间，缓存不存在的视频返回 COUNT_NOT_FOUND
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetExShareCounts(conn redis.Conn, videoIds []uint64, ttl int) ([]int64, error) {
+	args := []interface{}{"local res = {}; " +
+		"for i, k in ipairs(KEYS) do " +
+		"local val = redis.call('GETEX', k, 'EX', ARGV[1]); " +
+		"if (val) then table.insert(res, tonumber(val)); else table.insert(res, -1); end; end; " +
+		"return res; ", len(videoIds)}
+	for _, id := range videoIds {
+		args = append(args, model.Share{}.CountCacheKey(id))
+	}
+	args = append(args, ttl)
+	counts, err := redis.Int64s(conn.Do("EVAL", args...))
+	if err != nil {
+		return nil, err
+	}
+	for i := range counts {
+		if counts[i] < 0 {
+			counts[i] = COUNT_NOT_FOUND
+		}
+	}
+	return counts, nil
+}
+
+// SetShareCounts 批量设置缓存视频分享数，缓存已存在时不覆盖（分享时只在缓存存在时累加）
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) SetShareCounts(conn redis.Conn, videoIds []uint64, counts []int64, ttl int) error {
+	args := []interface{}{"for i, k in ipairs(KEYS) do " +
+		"redis.call('SET', k, ARGV[i+1], 'EX', ARGV[1], 'NX'); end; " +
+		"return nil; ", len(videoIds)}
+	for _, id := range videoIds {
+		args = append(args, model.Share{}.CountCacheKey(id))
+	}
+	args = append(args, ttl)
+	for _, c := range counts {
+		args = append(args, c)
+	}
+	_, err := conn.Do("EVAL", args...)
+	return err
+}
+
+// IncrShareCount 缓存存在时将视频分享数 + 1 并刷新过期时间，返回分享数，缓存不存在时返回 COUNT_NOT_FOUND（由下次查询从 DB 计数）
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) IncrShareCount(conn redis.Conn, videoId uint64, ttl int) (int64, error) {
+	return redis.Int64(conn.Do("EVAL", "if (redis.call('EXISTS', KEYS[1]) == 0) then return -1; end; "+
+		"local val = redis.call('INCR', KEYS[1]); "+
+		"redis.call('EXPIRE', KEYS[1], ARGV[1]); "+
+		"return val; ", 1, model.Share{}.CountCacheKey(videoId), ttl))
+}
+
+// GetHistory 获取用户观看历史中观看时间早于 maxTime 的 limit 条记录（从新到旧），maxTime 为 0 时从最新的记录开始获取，
+// 同时返回用户是否暂停记录观看历史
+// 使用 lua 脚本将多次操作整合为一次 RTT
+func (p *RedisPool) GetHistory(conn redis.Conn, userid uint64, maxTime, limit int64) (ids []uint64, times []int64, paused bool, err error) {
+	max := "+inf"
+	if maxTime > 0 {
+		max = "(" + strconv.FormatInt(maxTime, 10)
+	}
+	vals, err := redis.Values(conn.Do("EVAL", "local res = {redis.call('SISMEMBER', KEYS[2], ARGV[3])}; "+
+		"local zlist = redis.call('ZRANGE', KEYS[1], ARGV[1], '-inf', 'BYSCORE', 'REV', 'LIMIT', 0, ARGV[2], 'WITHSCORES'); "+
+		"for i, v in ipairs(zlist) do table.insert(res, v); end; "+
+		"return res; ", 2, model.WatchHistory{}.CacheKey(userid), model.HistoryPausedCacheKey, max, limit, userid))
+	if err != nil {
+		return nil, nil, false, err
+	}
+	p1, err := redis.Int64(vals[0], nil)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	for i := 1; i+1 < len(vals); i += 2 {
+		id, err := redis.Uint64(vals[i], nil)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		t, err := redis.Int64(vals[i+1], nil)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		ids = append(ids, id)
+		times = append(times, t)
+	}
+	return ids, times, p1 == 1, nil
+}
+
+// GetHistoryIds 获取用户观看历史缓存中的所有视频 id
+func (p *RedisPool) GetHistoryIds(conn redis.Conn, userid uint64) ([]uint64, error) {
+	return redis.Uint64s(conn.Do("ZRANGE", model.WatchHistory{}.CacheKey(userid), 0, -1))
+}
+
+// DelHistory 从用户观看历史缓存中删除视频
+func (p *RedisPool) DelHistory(conn redis.Conn, userid, videoId uint64) error {
+	_, err := conn.Do("ZREM", model.WatchHistory{}.CacheKey(userid), videoId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClearHistory 清空用户观看历史缓存
+func (p *RedisPool) ClearHistory(conn redis.Conn, userid uint64) error {
+	_, err := conn.Do("DEL", model.WatchHistory{}.CacheKey(userid))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetHistoryPaused 设置用户是否暂停记录观看历史，播放事件消费者不会为暂停记录的用户写入观看历史
+func (p *RedisPool) SetHistoryPaused(conn redis.Conn, userid uint64, paused bool) error {
+	cmd := "SREM"
+	if paused {
+		cmd = "SADD"
+	}
+	_, err := conn.Do(cmd, model.HistoryPausedCacheKey, userid)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // InitRedis 通过从 DB 获取数据初始化 redis（缓存预热）
 func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 	// 从 DB 中获取数据
@@ -602,7 +1166,7 @@ func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 	var VideoList []*model.Video
 	var favoriteCount int64
 	var commentCount int64
-	err := db.Find(&VideoList).Order("create_time DESC").Limit(cacheConfig.VIDEO_MAX_CACHE_SIZE).Error
+	err := db.Where("status = ? and publish_state = ?", model.VideoStatusApproved, model.VideoPublishStatePublished).Find(&VideoList).Order("create_time DESC").Limit(cacheConfig.VIDEO_MAX_CACHE_SIZE).Error
 	if err != nil {
 		return err
 	}
@@ -628,7 +1192,7 @@ func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 			return err
 		}
 
-		err = db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id}).Count(&commentCount).Error
+		err = db.Model(&model.Comment{}).Where(&model.Comment{VideoId: v.Id, Status: model.CommentStatusVisible}).Count(&commentCount).Error
 		if err != nil {
 			return err
 		}
@@ -638,5 +1202,18 @@ func (p *RedisPool) InitRedis(conf *config.CacheConfig, db *gorm.DB) error {
 		}
 	}
 
+	// 加载暂停记录观看历史的用户，该集合没有过期时间，重新加载保证 Redis 数据丢失后仍然生效
+	var pausedIds []uint64
+	err = db.Model(&model.WatchHistorySetting{}).Where("paused = ?", model.HistoryPaused).Pluck("user_id", &pausedIds).Error
+	if err != nil {
+		return err
+	}
+	for _, id := range pausedIds {
+		err = p.SetHistoryPaused(conn, id, true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,71 @@
+package model
+
+import "gorm.io/gorm"
+
+// Report 表结构，用户对视频、评论或账号的举报记录，同一用户对同一目标只能举报一次
+type Report struct {
+	Id         uint64 `json:"id" gorm:"column:id"`
+	ReporterId uint64 `json:"reporter_id" gorm:"column:reporter_id"`
+	TargetType string `json:"target_type" gorm:"column:target_type"` // 1-视频，2-评论，3-账号
+	TargetId   uint64 `json:"target_id" gorm:"column:target_id"`
+	Reason     string `json:"reason" gorm:"column:reason"` // 举报原因分类
+	Content    string `json:"content" gorm:"column:content"`
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"`
+}
+
+// ReportTarget 表结构，按举报目标汇总的举报记录，
+// 举报人数达到阈值时目标被自动隐藏，等待管理员审核
+type ReportTarget struct {
+	TargetType  string `json:"target_type" gorm:"column:target_type"`
+	TargetId    uint64 `json:"target_id" gorm:"column:target_id"`
+	OwnerId     uint64 `json:"owner_id" gorm:"column:owner_id"` // 被举报内容的作者（账号举报时为账号本身）
+	ReportCount int64  `json:"report_count" gorm:"column:report_count"`
+	Status      string `json:"status" gorm:"column:status"` // 0-正常，1-已自动隐藏待审核，2-审核后恢复
+	CreateTime  int64  `json:"create_time" gorm:"column:create_time"`
+	UpdateTime  int64  `json:"update_time" gorm:"column:update_time"`
+}
+
+const (
+	ReportTargetVideo   = "1"
+	ReportTargetComment = "2"
+	ReportTargetUser    = "3"
+
+	ReportReasonSpam       = "1" // 垃圾广告
+	ReportReasonPorn       = "2" // 色情低俗
+	ReportReasonViolence   = "3" // 暴力血腥
+	ReportReasonHarassment = "4" // 骚扰谩骂
+	ReportReasonIllegal    = "5" // 违法犯罪
+	ReportReasonOther      = "6" // 其他
+
+	ReportStatusOpen     = "0"
+	ReportStatusHidden   = "1"
+	ReportStatusRestored = "2" // 审核后恢复，之后的举报不再自动隐藏
+
+	ReportHiddenUserCacheKey = "Report:UserId:Hidden:SET"
+)
+
+func (Report) TableName() string {
+	return "report"
+}
+
+func (ReportTarget) TableName() string {
+	return "report_target"
+}
+
+// HiddenUserCacheKey 返回因被举报而自动隐藏的账号 id 集合的缓存 key 名称，
+// 缓存类型为 set 类型，key: Report:UserId:Hidden:SET, member: 被隐藏的用户id
+// 集合中总是包含占位成员 0，有账号被隐藏时删除缓存，由下次查询时从 DB 加载
+func (ReportTarget) HiddenUserCacheKey() string {
+	return ReportHiddenUserCacheKey
+}
+
+// HiddenUserIdList 从 DB 查询因被举报而自动隐藏的账号 id 列表
+func HiddenUserIdList(db *gorm.DB) ([]uint64, error) {
+	var ids []uint64
+	err := db.Model(&ReportTarget{}).Select("target_id").
+		Where(&ReportTarget{TargetType: ReportTargetUser, Status: ReportStatusHidden}).Find(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,32 @@
+package model
+
+import "strconv"
+
+// Share 表结构，用户的分享记录
+type Share struct {
+	Id         uint64 `gorm:"column:id"`
+	UserId     uint64 `gorm:"column:user_id"`
+	VideoId    uint64 `gorm:"column:video_id"`
+	Channel    string `gorm:"column:channel"`    // 1-复制链接，2-私信好友，3-分享到站外
+	ToUserId   uint64 `gorm:"column:to_user_id"` // 私信好友时的接收者 id，其他渠道为 0
+	CreateTime int64  `gorm:"column:create_time"`
+}
+
+const (
+	ShareChannelLink     = "1" // 复制链接
+	ShareChannelMessage  = "2" // 私信好友
+	ShareChannelExternal = "3" // 分享到站外
+
+	ShareCountCacheKeyPrefix = "Share:VideoId:ShareCount:"
+)
+
+func (Share) TableName() string {
+	return "share"
+}
+
+// CountCacheKey 返回 视频分享数 对应的缓存 key 名称，
+// Share Count 缓存类型为 string 类型，key: Share:VideoId:ShareCount:{视频id} value: 分享数
+// 与点赞数、评论数相同，默认 12 小时过期，过期后从 DB 重新计数
+func (Share) CountCacheKey(videoId uint64) string {
+	return ShareCountCacheKeyPrefix + strconv.FormatUint(videoId, 10)
+}
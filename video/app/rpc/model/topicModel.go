@@ -0,0 +1,49 @@
+package model
+
+import "strconv"
+
+// Topic 表结构，投稿时从视频标题中提取的话题（#话题名），VideoCount 为话题下公开视频的数量
+type Topic struct {
+	Id         uint64 `json:"id" gorm:"column:id"`
+	Name       string `json:"name" gorm:"column:name"` // 归一化后的话题名（小写，不含 #）
+	VideoCount int64  `json:"video_count" gorm:"column:video_count"`
+	CreateTime int64  `json:"create_time" gorm:"column:create_time"`
+}
+
+// VideoTopic 表结构，视频与话题的对应关系
+type VideoTopic struct {
+	TopicId    uint64 `gorm:"column:topic_id"`
+	VideoId    uint64 `gorm:"column:video_id"`
+	CreateTime int64  `gorm:"column:create_time"` // 视频的投稿时间，用于按时间排序话题下的视频
+}
+
+const (
+	TopicSortRecent = "1" // 按投稿时间排序
+	TopicSortHot    = "2" // 按点赞数排序
+
+	TopicVideoCacheKeyPrefix    = "Topic:TopicId:VideoId:ZSET:"
+	TopicHotVideoCacheKeyPrefix = "Topic:TopicId:HotVideoId:ZSET:"
+	TopicCacheEmptyMember       = 0 // 占位成员，区分没有公开视频的话题与缓存不存在
+)
+
+func (Topic) TableName() string {
+	return "topic"
+}
+
+func (VideoTopic) TableName() string {
+	return "video_topic"
+}
+
+// VideoCacheKey 返回话题最新视频列表对应的缓存 key 名称，
+// 缓存类型为 ZSET 类型，key: Topic:TopicId:VideoId:ZSET:{话题id}, member: {视频id}, score: 视频时间戳
+// 只缓存最新的 TOPIC_VIDEO_MAX_CACHE_SIZE 个公开视频，视频公开状态变化时更新
+func (Topic) VideoCacheKey(topicId uint64) string {
+	return TopicVideoCacheKeyPrefix + strconv.FormatUint(topicId, 10)
+}
+
+// HotVideoCacheKey 返回话题热门视频列表对应的缓存 key 名称，
+// 缓存类型为 ZSET 类型，key: Topic:TopicId:HotVideoId:ZSET:{话题id}, member: {视频id}, score: 视频点赞数
+// 只缓存点赞数最多的 TOPIC_HOT_MAX_CACHE_SIZE 个公开视频，过期后从 DB 重新计算
+func (Topic) HotVideoCacheKey(topicId uint64) string {
+	return TopicHotVideoCacheKeyPrefix + strconv.FormatUint(topicId, 10)
+}
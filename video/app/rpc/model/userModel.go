@@ -0,0 +1,29 @@
+package model
+
+import (
+	"gorm.io/gorm"
+	"strconv"
+)
+
+const (
+	UserCacheKeyPrefix = "User:Userid:UserInfo:Hash"
+	WorkCountField     = "workCount"
+)
+
+// User 用户服务的用户信息缓存，视频审核通过或下架后需要更新其中作者的作品数
+type User struct{}
+
+// CacheKey 返回 user 对应的缓存 key 名称（与用户服务保持一致）
+func (User) CacheKey(userid uint64) string {
+	return UserCacheKeyPrefix + strconv.FormatUint(userid, 10)
+}
+
+// UserRole 从 DB 查询用户的角色（0-普通用户，1-审核员，2-管理员），用户不存在时返回空字符串
+func UserRole(db *gorm.DB, userid uint64) (string, error) {
+	var roles []string
+	err := db.Table("user").Where("id = ?", userid).Limit(1).Pluck("role", &roles).Error
+	if err != nil || len(roles) == 0 {
+		return "", err
+	}
+	return roles[0], nil
+}
@@ -4,15 +4,39 @@ import "strconv"
 
 // Video 表结构
 type Video struct {
-	Id         uint64 `json:"id" gorm:"column:id"`
-	UserId     uint64 `json:"user_id" gorm:"column:user_id"`
-	Title      string `json:"title" gorm:"column:title"`
-	PlayUrl    string `json:"play_url" gorm:"column:play_url"`
-	CoverUrl   string `json:"cover_url" gorm:"column:cover_url"`
-	CreateTime int64  `json:"create_time" gorm:"column:create_time"`
+	Id           uint64 `json:"id" gorm:"column:id"`
+	UserId       uint64 `json:"user_id" gorm:"column:user_id"`
+	Title        string `json:"title" gorm:"column:title"`
+	PlayUrl      string `json:"play_url" gorm:"column:play_url"`
+	DownloadUrl  string `json:"download_url" gorm:"column:download_url"`     // 下载版本的地址（带水印），没有下载版本时为空
+	PreviewUrl   string `json:"preview_url" gorm:"column:preview_url"`       // 动态预览的地址
+	SpriteUrl    string `json:"sprite_url" gorm:"column:sprite_url"`         // 进度条缩略图雪碧图的地址
+	SpriteVttUrl string `json:"sprite_vtt_url" gorm:"column:sprite_vtt_url"` // 缩略图 WebVTT 索引的地址
+	CoverUrl     string `json:"cover_url" gorm:"column:cover_url"`
+	CreateTime   int64  `json:"create_time" gorm:"column:create_time"`
+	Status       string `json:"status" gorm:"column:status"`               // 审核状态：0-待审核，1-已通过，2-未通过，3-已下架
+	ReviewReason string `json:"review_reason" gorm:"column:review_reason"` // 未通过或下架的原因
+	ReviewTime   int64  `json:"review_time" gorm:"column:review_time"`
+	Visibility   string `json:"visibility" gorm:"column:visibility"`       // 可见范围：0-公开，1-仅粉丝，2-仅好友，3-仅自己
+	PublishState string `json:"publish_state" gorm:"column:publish_state"` // 发布状态：0-已发布，1-草稿，2-定时发布
+	PublishTime  int64  `json:"publish_time" gorm:"column:publish_time"`   // 定时发布的时间
 }
 
 const (
+	VideoStatusPending   = "0" // 待审核，只有作者自己可见
+	VideoStatusApproved  = "1" // 已通过，进入 Feed 流与公开的发布列表
+	VideoStatusRejected  = "2" // 未通过
+	VideoStatusTakenDown = "3" // 已下架
+
+	VideoVisibilityPublic    = "0" // 所有用户可见
+	VideoVisibilityFollowers = "1" // 仅关注了作者的用户可见
+	VideoVisibilityFriends   = "2" // 仅与作者互相关注的好友可见
+	VideoVisibilityPrivate   = "3" // 仅作者自己可见
+
+	VideoPublishStatePublished = "0" // 已发布，审核通过后进入 Feed 流与公开的发布列表
+	VideoPublishStateDraft     = "1" // 草稿，由作者手动发布
+	VideoPublishStateScheduled = "2" // 定时发布，到达发布时间后由定时任务发布
+
 	VideoCacheKeyPrefix       = "Vid:VideoId:VideoInfo:"
 	PublishListCacheKeyPrefix = "Vid:UserId:VideoId:ZSET:"
 	FeedCacheKey              = "Feed"
@@ -22,6 +46,32 @@ func (Video) TableName() string {
 	return "video"
 }
 
+// IsPublic 判断视频是否对所有用户可见，视频需要审核通过并且已发布
+// （升级前写入缓存的视频信息没有 status 与 publish_state 字段，视为已通过并已发布）
+func (v Video) IsPublic() bool {
+	return (v.Status == VideoStatusApproved || v.Status == "") && v.IsPublished()
+}
+
+// IsPublished 判断视频是否已发布，草稿与未到发布时间的视频只在作者的草稿列表中可见
+func (v Video) IsPublished() bool {
+	return v.PublishState == VideoPublishStatePublished || v.PublishState == ""
+}
+
+// IsRestricted 判断视频是否设置了可见范围，需要根据当前用户与作者的关注关系判断是否可见
+// （升级前写入缓存的视频信息没有 visibility 字段，视为公开）
+func (v Video) IsRestricted() bool {
+	return v.Visibility != VideoVisibilityPublic && v.Visibility != ""
+}
+
+// IsValidVisibility 判断可见范围的取值是否合法
+func IsValidVisibility(visibility string) bool {
+	switch visibility {
+	case VideoVisibilityPublic, VideoVisibilityFollowers, VideoVisibilityFriends, VideoVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
 // CacheKey 返回 Video 对应的缓存 key 名称，
 // Video 缓存类型为 string 类型，key: VideoId:VideoInfo:{视频id} value: 视频信息 json
 // 默认过期时间：12h
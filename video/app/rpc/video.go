@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"Mini-Tiktok/video/app/rpc/internal/config"
+	"Mini-Tiktok/video/app/rpc/internal/logic"
 	"Mini-Tiktok/video/app/rpc/internal/server"
 	"Mini-Tiktok/video/app/rpc/internal/svc"
 	"Mini-Tiktok/video/app/rpc/video"
@@ -28,6 +30,8 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	// 定时发布任务，将到达发布时间的视频发布到 Feed 流与发布列表
+	go logic.NewPublishSchedulerLogic(context.Background(), ctx).ScheduleLoop()
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		video.RegisterVideoRpcServer(grpcServer, server.NewVideoRpcServer(ctx))
 
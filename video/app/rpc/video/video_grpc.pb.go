@@ -19,12 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	VideoRpc_GetPublishList_FullMethodName  = "/video.VideoRpc/GetPublishList"
-	VideoRpc_GetFeed_FullMethodName         = "/video.VideoRpc/GetFeed"
-	VideoRpc_CommentAction_FullMethodName   = "/video.VideoRpc/CommentAction"
-	VideoRpc_GetCommentList_FullMethodName  = "/video.VideoRpc/GetCommentList"
-	VideoRpc_FavoriteAction_FullMethodName  = "/video.VideoRpc/FavoriteAction"
-	VideoRpc_GetFavoriteList_FullMethodName = "/video.VideoRpc/GetFavoriteList"
+	VideoRpc_GetPublishList_FullMethodName         = "/video.VideoRpc/GetPublishList"
+	VideoRpc_GetFeed_FullMethodName                = "/video.VideoRpc/GetFeed"
+	VideoRpc_CommentAction_FullMethodName          = "/video.VideoRpc/CommentAction"
+	VideoRpc_GetCommentList_FullMethodName         = "/video.VideoRpc/GetCommentList"
+	VideoRpc_FavoriteAction_FullMethodName         = "/video.VideoRpc/FavoriteAction"
+	VideoRpc_GetFavoriteList_FullMethodName        = "/video.VideoRpc/GetFavoriteList"
+	VideoRpc_ListReviewQueue_FullMethodName        = "/video.VideoRpc/ListReviewQueue"
+	VideoRpc_ReviewVideo_FullMethodName            = "/video.VideoRpc/ReviewVideo"
+	VideoRpc_ReportAction_FullMethodName           = "/video.VideoRpc/ReportAction"
+	VideoRpc_ListReportTargets_FullMethodName      = "/video.VideoRpc/ListReportTargets"
+	VideoRpc_RestoreReportTarget_FullMethodName    = "/video.VideoRpc/RestoreReportTarget"
+	VideoRpc_GetVideoList_FullMethodName           = "/video.VideoRpc/GetVideoList"
+	VideoRpc_GetTopicVideoList_FullMethodName      = "/video.VideoRpc/GetTopicVideoList"
+	VideoRpc_GetTrendingList_FullMethodName        = "/video.VideoRpc/GetTrendingList"
+	VideoRpc_GetWatchHistory_FullMethodName        = "/video.VideoRpc/GetWatchHistory"
+	VideoRpc_DeleteWatchHistory_FullMethodName     = "/video.VideoRpc/DeleteWatchHistory"
+	VideoRpc_ClearWatchHistory_FullMethodName      = "/video.VideoRpc/ClearWatchHistory"
+	VideoRpc_SetWatchHistoryPaused_FullMethodName  = "/video.VideoRpc/SetWatchHistoryPaused"
+	VideoRpc_GetCreatorStats_FullMethodName        = "/video.VideoRpc/GetCreatorStats"
+	VideoRpc_CollectionAction_FullMethodName       = "/video.VideoRpc/CollectionAction"
+	VideoRpc_SortCollections_FullMethodName        = "/video.VideoRpc/SortCollections"
+	VideoRpc_CollectionVideoAction_FullMethodName  = "/video.VideoRpc/CollectionVideoAction"
+	VideoRpc_GetCollectionList_FullMethodName      = "/video.VideoRpc/GetCollectionList"
+	VideoRpc_GetCollectionVideoList_FullMethodName = "/video.VideoRpc/GetCollectionVideoList"
+	VideoRpc_ShareAction_FullMethodName            = "/video.VideoRpc/ShareAction"
+	VideoRpc_ResolveShare_FullMethodName           = "/video.VideoRpc/ResolveShare"
+	VideoRpc_SetVideoVisibility_FullMethodName     = "/video.VideoRpc/SetVideoVisibility"
+	VideoRpc_GetDraftList_FullMethodName           = "/video.VideoRpc/GetDraftList"
+	VideoRpc_DraftAction_FullMethodName            = "/video.VideoRpc/DraftAction"
+	VideoRpc_GetDuplicateOriginal_FullMethodName   = "/video.VideoRpc/GetDuplicateOriginal"
 )
 
 // VideoRpcClient is the client API for VideoRpc service.
@@ -37,6 +61,30 @@ type VideoRpcClient interface {
 	GetCommentList(ctx context.Context, in *CommentListReq, opts ...grpc.CallOption) (*CommentListResp, error)
 	FavoriteAction(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteResp, error)
 	GetFavoriteList(ctx context.Context, in *FavoriteListReq, opts ...grpc.CallOption) (*FavoriteListResp, error)
+	ListReviewQueue(ctx context.Context, in *ReviewQueueReq, opts ...grpc.CallOption) (*ReviewQueueResp, error)
+	ReviewVideo(ctx context.Context, in *ReviewVideoReq, opts ...grpc.CallOption) (*ReviewVideoResp, error)
+	ReportAction(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*ReportResp, error)
+	ListReportTargets(ctx context.Context, in *ReportTargetListReq, opts ...grpc.CallOption) (*ReportTargetListResp, error)
+	RestoreReportTarget(ctx context.Context, in *RestoreReportTargetReq, opts ...grpc.CallOption) (*RestoreReportTargetResp, error)
+	GetVideoList(ctx context.Context, in *VideoListReq, opts ...grpc.CallOption) (*VideoListResp, error)
+	GetTopicVideoList(ctx context.Context, in *TopicVideoListReq, opts ...grpc.CallOption) (*TopicVideoListResp, error)
+	GetTrendingList(ctx context.Context, in *TrendingReq, opts ...grpc.CallOption) (*TrendingResp, error)
+	GetWatchHistory(ctx context.Context, in *WatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryResp, error)
+	DeleteWatchHistory(ctx context.Context, in *DeleteWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+	ClearWatchHistory(ctx context.Context, in *ClearWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+	SetWatchHistoryPaused(ctx context.Context, in *WatchHistoryPausedReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+	GetCreatorStats(ctx context.Context, in *CreatorStatsReq, opts ...grpc.CallOption) (*CreatorStatsResp, error)
+	CollectionAction(ctx context.Context, in *CollectionActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+	SortCollections(ctx context.Context, in *SortCollectionsReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+	CollectionVideoAction(ctx context.Context, in *CollectionVideoActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+	GetCollectionList(ctx context.Context, in *CollectionListReq, opts ...grpc.CallOption) (*CollectionListResp, error)
+	GetCollectionVideoList(ctx context.Context, in *CollectionVideoListReq, opts ...grpc.CallOption) (*CollectionVideoListResp, error)
+	ShareAction(ctx context.Context, in *ShareReq, opts ...grpc.CallOption) (*ShareResp, error)
+	ResolveShare(ctx context.Context, in *ResolveShareReq, opts ...grpc.CallOption) (*ResolveShareResp, error)
+	SetVideoVisibility(ctx context.Context, in *VideoVisibilityReq, opts ...grpc.CallOption) (*VideoVisibilityResp, error)
+	GetDraftList(ctx context.Context, in *DraftListReq, opts ...grpc.CallOption) (*DraftListResp, error)
+	DraftAction(ctx context.Context, in *DraftActionReq, opts ...grpc.CallOption) (*DraftActionResp, error)
+	GetDuplicateOriginal(ctx context.Context, in *DuplicateOriginalReq, opts ...grpc.CallOption) (*DuplicateOriginalResp, error)
 }
 
 type videoRpcClient struct {
@@ -101,6 +149,222 @@ func (c *videoRpcClient) GetFavoriteList(ctx context.Context, in *FavoriteListRe
 	return out, nil
 }
 
+func (c *videoRpcClient) ListReviewQueue(ctx context.Context, in *ReviewQueueReq, opts ...grpc.CallOption) (*ReviewQueueResp, error) {
+	out := new(ReviewQueueResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ListReviewQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ReviewVideo(ctx context.Context, in *ReviewVideoReq, opts ...grpc.CallOption) (*ReviewVideoResp, error) {
+	out := new(ReviewVideoResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ReviewVideo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ReportAction(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*ReportResp, error) {
+	out := new(ReportResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ReportAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ListReportTargets(ctx context.Context, in *ReportTargetListReq, opts ...grpc.CallOption) (*ReportTargetListResp, error) {
+	out := new(ReportTargetListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ListReportTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) RestoreReportTarget(ctx context.Context, in *RestoreReportTargetReq, opts ...grpc.CallOption) (*RestoreReportTargetResp, error) {
+	out := new(RestoreReportTargetResp)
+	err := c.cc.Invoke(ctx, VideoRpc_RestoreReportTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetVideoList(ctx context.Context, in *VideoListReq, opts ...grpc.CallOption) (*VideoListResp, error) {
+	out := new(VideoListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetVideoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetTopicVideoList(ctx context.Context, in *TopicVideoListReq, opts ...grpc.CallOption) (*TopicVideoListResp, error) {
+	out := new(TopicVideoListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetTopicVideoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetTrendingList(ctx context.Context, in *TrendingReq, opts ...grpc.CallOption) (*TrendingResp, error) {
+	out := new(TrendingResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetTrendingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetWatchHistory(ctx context.Context, in *WatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryResp, error) {
+	out := new(WatchHistoryResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetWatchHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) DeleteWatchHistory(ctx context.Context, in *DeleteWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	out := new(WatchHistoryActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_DeleteWatchHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ClearWatchHistory(ctx context.Context, in *ClearWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	out := new(WatchHistoryActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ClearWatchHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) SetWatchHistoryPaused(ctx context.Context, in *WatchHistoryPausedReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	out := new(WatchHistoryActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_SetWatchHistoryPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetCreatorStats(ctx context.Context, in *CreatorStatsReq, opts ...grpc.CallOption) (*CreatorStatsResp, error) {
+	out := new(CreatorStatsResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetCreatorStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) CollectionAction(ctx context.Context, in *CollectionActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	out := new(CollectionActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_CollectionAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) SortCollections(ctx context.Context, in *SortCollectionsReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	out := new(CollectionActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_SortCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) CollectionVideoAction(ctx context.Context, in *CollectionVideoActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	out := new(CollectionActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_CollectionVideoAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetCollectionList(ctx context.Context, in *CollectionListReq, opts ...grpc.CallOption) (*CollectionListResp, error) {
+	out := new(CollectionListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetCollectionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetCollectionVideoList(ctx context.Context, in *CollectionVideoListReq, opts ...grpc.CallOption) (*CollectionVideoListResp, error) {
+	out := new(CollectionVideoListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetCollectionVideoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ShareAction(ctx context.Context, in *ShareReq, opts ...grpc.CallOption) (*ShareResp, error) {
+	out := new(ShareResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ShareAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) ResolveShare(ctx context.Context, in *ResolveShareReq, opts ...grpc.CallOption) (*ResolveShareResp, error) {
+	out := new(ResolveShareResp)
+	err := c.cc.Invoke(ctx, VideoRpc_ResolveShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) SetVideoVisibility(ctx context.Context, in *VideoVisibilityReq, opts ...grpc.CallOption) (*VideoVisibilityResp, error) {
+	out := new(VideoVisibilityResp)
+	err := c.cc.Invoke(ctx, VideoRpc_SetVideoVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetDraftList(ctx context.Context, in *DraftListReq, opts ...grpc.CallOption) (*DraftListResp, error) {
+	out := new(DraftListResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetDraftList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) DraftAction(ctx context.Context, in *DraftActionReq, opts ...grpc.CallOption) (*DraftActionResp, error) {
+	out := new(DraftActionResp)
+	err := c.cc.Invoke(ctx, VideoRpc_DraftAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRpcClient) GetDuplicateOriginal(ctx context.Context, in *DuplicateOriginalReq, opts ...grpc.CallOption) (*DuplicateOriginalResp, error) {
+	out := new(DuplicateOriginalResp)
+	err := c.cc.Invoke(ctx, VideoRpc_GetDuplicateOriginal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoRpcServer is the server API for VideoRpc service.
 // All implementations must embed UnimplementedVideoRpcServer
 // for forward compatibility
@@ -111,6 +375,30 @@ type VideoRpcServer interface {
 	GetCommentList(context.Context, *CommentListReq) (*CommentListResp, error)
 	FavoriteAction(context.Context, *FavoriteReq) (*FavoriteResp, error)
 	GetFavoriteList(context.Context, *FavoriteListReq) (*FavoriteListResp, error)
+	ListReviewQueue(context.Context, *ReviewQueueReq) (*ReviewQueueResp, error)
+	ReviewVideo(context.Context, *ReviewVideoReq) (*ReviewVideoResp, error)
+	ReportAction(context.Context, *ReportReq) (*ReportResp, error)
+	ListReportTargets(context.Context, *ReportTargetListReq) (*ReportTargetListResp, error)
+	RestoreReportTarget(context.Context, *RestoreReportTargetReq) (*RestoreReportTargetResp, error)
+	GetVideoList(context.Context, *VideoListReq) (*VideoListResp, error)
+	GetTopicVideoList(context.Context, *TopicVideoListReq) (*TopicVideoListResp, error)
+	GetTrendingList(context.Context, *TrendingReq) (*TrendingResp, error)
+	GetWatchHistory(context.Context, *WatchHistoryReq) (*WatchHistoryResp, error)
+	DeleteWatchHistory(context.Context, *DeleteWatchHistoryReq) (*WatchHistoryActionResp, error)
+	ClearWatchHistory(context.Context, *ClearWatchHistoryReq) (*WatchHistoryActionResp, error)
+	SetWatchHistoryPaused(context.Context, *WatchHistoryPausedReq) (*WatchHistoryActionResp, error)
+	GetCreatorStats(context.Context, *CreatorStatsReq) (*CreatorStatsResp, error)
+	CollectionAction(context.Context, *CollectionActionReq) (*CollectionActionResp, error)
+	SortCollections(context.Context, *SortCollectionsReq) (*CollectionActionResp, error)
+	CollectionVideoAction(context.Context, *CollectionVideoActionReq) (*CollectionActionResp, error)
+	GetCollectionList(context.Context, *CollectionListReq) (*CollectionListResp, error)
+	GetCollectionVideoList(context.Context, *CollectionVideoListReq) (*CollectionVideoListResp, error)
+	ShareAction(context.Context, *ShareReq) (*ShareResp, error)
+	ResolveShare(context.Context, *ResolveShareReq) (*ResolveShareResp, error)
+	SetVideoVisibility(context.Context, *VideoVisibilityReq) (*VideoVisibilityResp, error)
+	GetDraftList(context.Context, *DraftListReq) (*DraftListResp, error)
+	DraftAction(context.Context, *DraftActionReq) (*DraftActionResp, error)
+	GetDuplicateOriginal(context.Context, *DuplicateOriginalReq) (*DuplicateOriginalResp, error)
 	mustEmbedUnimplementedVideoRpcServer()
 }
 
@@ -136,6 +424,78 @@ func (UnimplementedVideoRpcServer) FavoriteAction(context.Context, *FavoriteReq)
 func (UnimplementedVideoRpcServer) GetFavoriteList(context.Context, *FavoriteListReq) (*FavoriteListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteList not implemented")
 }
+func (UnimplementedVideoRpcServer) ListReviewQueue(context.Context, *ReviewQueueReq) (*ReviewQueueResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewQueue not implemented")
+}
+func (UnimplementedVideoRpcServer) ReviewVideo(context.Context, *ReviewVideoReq) (*ReviewVideoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewVideo not implemented")
+}
+func (UnimplementedVideoRpcServer) ReportAction(context.Context, *ReportReq) (*ReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportAction not implemented")
+}
+func (UnimplementedVideoRpcServer) ListReportTargets(context.Context, *ReportTargetListReq) (*ReportTargetListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportTargets not implemented")
+}
+func (UnimplementedVideoRpcServer) RestoreReportTarget(context.Context, *RestoreReportTargetReq) (*RestoreReportTargetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReportTarget not implemented")
+}
+func (UnimplementedVideoRpcServer) GetVideoList(context.Context, *VideoListReq) (*VideoListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoList not implemented")
+}
+func (UnimplementedVideoRpcServer) GetTopicVideoList(context.Context, *TopicVideoListReq) (*TopicVideoListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicVideoList not implemented")
+}
+func (UnimplementedVideoRpcServer) GetTrendingList(context.Context, *TrendingReq) (*TrendingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingList not implemented")
+}
+func (UnimplementedVideoRpcServer) GetWatchHistory(context.Context, *WatchHistoryReq) (*WatchHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchHistory not implemented")
+}
+func (UnimplementedVideoRpcServer) DeleteWatchHistory(context.Context, *DeleteWatchHistoryReq) (*WatchHistoryActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatchHistory not implemented")
+}
+func (UnimplementedVideoRpcServer) ClearWatchHistory(context.Context, *ClearWatchHistoryReq) (*WatchHistoryActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearWatchHistory not implemented")
+}
+func (UnimplementedVideoRpcServer) SetWatchHistoryPaused(context.Context, *WatchHistoryPausedReq) (*WatchHistoryActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWatchHistoryPaused not implemented")
+}
+func (UnimplementedVideoRpcServer) GetCreatorStats(context.Context, *CreatorStatsReq) (*CreatorStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorStats not implemented")
+}
+func (UnimplementedVideoRpcServer) CollectionAction(context.Context, *CollectionActionReq) (*CollectionActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionAction not implemented")
+}
+func (UnimplementedVideoRpcServer) SortCollections(context.Context, *SortCollectionsReq) (*CollectionActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortCollections not implemented")
+}
+func (UnimplementedVideoRpcServer) CollectionVideoAction(context.Context, *CollectionVideoActionReq) (*CollectionActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionVideoAction not implemented")
+}
+func (UnimplementedVideoRpcServer) GetCollectionList(context.Context, *CollectionListReq) (*CollectionListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionList not implemented")
+}
+func (UnimplementedVideoRpcServer) GetCollectionVideoList(context.Context, *CollectionVideoListReq) (*CollectionVideoListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionVideoList not implemented")
+}
+func (UnimplementedVideoRpcServer) ShareAction(context.Context, *ShareReq) (*ShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareAction not implemented")
+}
+func (UnimplementedVideoRpcServer) ResolveShare(context.Context, *ResolveShareReq) (*ResolveShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShare not implemented")
+}
+func (UnimplementedVideoRpcServer) SetVideoVisibility(context.Context, *VideoVisibilityReq) (*VideoVisibilityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVideoVisibility not implemented")
+}
+func (UnimplementedVideoRpcServer) GetDraftList(context.Context, *DraftListReq) (*DraftListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraftList not implemented")
+}
+func (UnimplementedVideoRpcServer) DraftAction(context.Context, *DraftActionReq) (*DraftActionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DraftAction not implemented")
+}
+func (UnimplementedVideoRpcServer) GetDuplicateOriginal(context.Context, *DuplicateOriginalReq) (*DuplicateOriginalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicateOriginal not implemented")
+}
 func (UnimplementedVideoRpcServer) mustEmbedUnimplementedVideoRpcServer() {}
 
 // UnsafeVideoRpcServer may be embedded to opt out of forward compatibility for this service.
@@ -257,36 +617,564 @@ func _VideoRpc_GetFavoriteList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// VideoRpc_ServiceDesc is the grpc.ServiceDesc for VideoRpc service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VideoRpc_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "video.VideoRpc",
-	HandlerType: (*VideoRpcServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPublishList",
-			Handler:    _VideoRpc_GetPublishList_Handler,
-		},
-		{
-			MethodName: "GetFeed",
-			Handler:    _VideoRpc_GetFeed_Handler,
-		},
-		{
-			MethodName: "CommentAction",
-			Handler:    _VideoRpc_CommentAction_Handler,
-		},
-		{
-			MethodName: "GetCommentList",
-			Handler:    _VideoRpc_GetCommentList_Handler,
-		},
-		{
-			MethodName: "FavoriteAction",
-			Handler:    _VideoRpc_FavoriteAction_Handler,
-		},
-		{
-			MethodName: "GetFavoriteList",
-			Handler:    _VideoRpc_GetFavoriteList_Handler,
+func _VideoRpc_ListReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewQueueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ListReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ListReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ListReviewQueue(ctx, req.(*ReviewQueueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ReviewVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ReviewVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ReviewVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ReviewVideo(ctx, req.(*ReviewVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ReportAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ReportAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ReportAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ReportAction(ctx, req.(*ReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ListReportTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTargetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ListReportTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ListReportTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ListReportTargets(ctx, req.(*ReportTargetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_RestoreReportTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReportTargetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).RestoreReportTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_RestoreReportTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).RestoreReportTarget(ctx, req.(*RestoreReportTargetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetVideoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetVideoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetVideoList(ctx, req.(*VideoListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetTopicVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicVideoListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetTopicVideoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetTopicVideoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetTopicVideoList(ctx, req.(*TopicVideoListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetTrendingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetTrendingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetTrendingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetTrendingList(ctx, req.(*TrendingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetWatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetWatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetWatchHistory(ctx, req.(*WatchHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_DeleteWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWatchHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).DeleteWatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_DeleteWatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).DeleteWatchHistory(ctx, req.(*DeleteWatchHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ClearWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearWatchHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ClearWatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ClearWatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ClearWatchHistory(ctx, req.(*ClearWatchHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_SetWatchHistoryPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchHistoryPausedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).SetWatchHistoryPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_SetWatchHistoryPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).SetWatchHistoryPaused(ctx, req.(*WatchHistoryPausedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetCreatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatorStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetCreatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetCreatorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetCreatorStats(ctx, req.(*CreatorStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_CollectionAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).CollectionAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_CollectionAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).CollectionAction(ctx, req.(*CollectionActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_SortCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortCollectionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).SortCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_SortCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).SortCollections(ctx, req.(*SortCollectionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_CollectionVideoAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionVideoActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).CollectionVideoAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_CollectionVideoAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).CollectionVideoAction(ctx, req.(*CollectionVideoActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetCollectionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetCollectionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetCollectionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetCollectionList(ctx, req.(*CollectionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetCollectionVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionVideoListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetCollectionVideoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetCollectionVideoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetCollectionVideoList(ctx, req.(*CollectionVideoListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ShareAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ShareAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ShareAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ShareAction(ctx, req.(*ShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_ResolveShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).ResolveShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_ResolveShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).ResolveShare(ctx, req.(*ResolveShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_SetVideoVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoVisibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).SetVideoVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_SetVideoVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).SetVideoVisibility(ctx, req.(*VideoVisibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetDraftList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetDraftList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetDraftList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetDraftList(ctx, req.(*DraftListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_DraftAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).DraftAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_DraftAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).DraftAction(ctx, req.(*DraftActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRpc_GetDuplicateOriginal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateOriginalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRpcServer).GetDuplicateOriginal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRpc_GetDuplicateOriginal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRpcServer).GetDuplicateOriginal(ctx, req.(*DuplicateOriginalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VideoRpc_ServiceDesc is the grpc.ServiceDesc for VideoRpc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VideoRpc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "video.VideoRpc",
+	HandlerType: (*VideoRpcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPublishList",
+			Handler:    _VideoRpc_GetPublishList_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _VideoRpc_GetFeed_Handler,
+		},
+		{
+			MethodName: "CommentAction",
+			Handler:    _VideoRpc_CommentAction_Handler,
+		},
+		{
+			MethodName: "GetCommentList",
+			Handler:    _VideoRpc_GetCommentList_Handler,
+		},
+		{
+			MethodName: "FavoriteAction",
+			Handler:    _VideoRpc_FavoriteAction_Handler,
+		},
+		{
+			MethodName: "GetFavoriteList",
+			Handler:    _VideoRpc_GetFavoriteList_Handler,
+		},
+		{
+			MethodName: "ListReviewQueue",
+			Handler:    _VideoRpc_ListReviewQueue_Handler,
+		},
+		{
+			MethodName: "ReviewVideo",
+			Handler:    _VideoRpc_ReviewVideo_Handler,
+		},
+		{
+			MethodName: "ReportAction",
+			Handler:    _VideoRpc_ReportAction_Handler,
+		},
+		{
+			MethodName: "ListReportTargets",
+			Handler:    _VideoRpc_ListReportTargets_Handler,
+		},
+		{
+			MethodName: "RestoreReportTarget",
+			Handler:    _VideoRpc_RestoreReportTarget_Handler,
+		},
+		{
+			MethodName: "GetVideoList",
+			Handler:    _VideoRpc_GetVideoList_Handler,
+		},
+		{
+			MethodName: "GetTopicVideoList",
+			Handler:    _VideoRpc_GetTopicVideoList_Handler,
+		},
+		{
+			MethodName: "GetTrendingList",
+			Handler:    _VideoRpc_GetTrendingList_Handler,
+		},
+		{
+			MethodName: "GetWatchHistory",
+			Handler:    _VideoRpc_GetWatchHistory_Handler,
+		},
+		{
+			MethodName: "DeleteWatchHistory",
+			Handler:    _VideoRpc_DeleteWatchHistory_Handler,
+		},
+		{
+			MethodName: "ClearWatchHistory",
+			Handler:    _VideoRpc_ClearWatchHistory_Handler,
+		},
+		{
+			MethodName: "SetWatchHistoryPaused",
+			Handler:    _VideoRpc_SetWatchHistoryPaused_Handler,
+		},
+		{
+			MethodName: "GetCreatorStats",
+			Handler:    _VideoRpc_GetCreatorStats_Handler,
+		},
+		{
+			MethodName: "CollectionAction",
+			Handler:    _VideoRpc_CollectionAction_Handler,
+		},
+		{
+			MethodName: "SortCollections",
+			Handler:    _VideoRpc_SortCollections_Handler,
+		},
+		{
+			MethodName: "CollectionVideoAction",
+			Handler:    _VideoRpc_CollectionVideoAction_Handler,
+		},
+		{
+			MethodName: "GetCollectionList",
+			Handler:    _VideoRpc_GetCollectionList_Handler,
+		},
+		{
+			MethodName: "GetCollectionVideoList",
+			Handler:    _VideoRpc_GetCollectionVideoList_Handler,
+		},
+		{
+			MethodName: "ShareAction",
+			Handler:    _VideoRpc_ShareAction_Handler,
+		},
+		{
+			MethodName: "ResolveShare",
+			Handler:    _VideoRpc_ResolveShare_Handler,
+		},
+		{
+			MethodName: "SetVideoVisibility",
+			Handler:    _VideoRpc_SetVideoVisibility_Handler,
+		},
+		{
+			MethodName: "GetDraftList",
+			Handler:    _VideoRpc_GetDraftList_Handler,
+		},
+		{
+			MethodName: "DraftAction",
+			Handler:    _VideoRpc_DraftAction_Handler,
+		},
+		{
+			MethodName: "GetDuplicateOriginal",
+			Handler:    _VideoRpc_GetDuplicateOriginal_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
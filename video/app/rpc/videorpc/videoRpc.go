@@ -13,21 +13,71 @@ import (
 )
 
 type (
-	Comment          = video.Comment
-	CommentListReq   = video.CommentListReq
-	CommentListResp  = video.CommentListResp
-	CommentReq       = video.CommentReq
-	CommentResp      = video.CommentResp
-	FavoriteListReq  = video.FavoriteListReq
-	FavoriteListResp = video.FavoriteListResp
-	FavoriteReq      = video.FavoriteReq
-	FavoriteResp     = video.FavoriteResp
-	FeedReq          = video.FeedReq
-	FeedResp         = video.FeedResp
-	PublishListReq   = video.PublishListReq
-	PublishListResp  = video.PublishListResp
-	User             = video.User
-	Video            = video.Video
+	ClearWatchHistoryReq     = video.ClearWatchHistoryReq
+	Collection               = video.Collection
+	CollectionActionReq      = video.CollectionActionReq
+	CollectionActionResp     = video.CollectionActionResp
+	CollectionListReq        = video.CollectionListReq
+	CollectionListResp       = video.CollectionListResp
+	CollectionVideoActionReq = video.CollectionVideoActionReq
+	CollectionVideoListReq   = video.CollectionVideoListReq
+	CollectionVideoListResp  = video.CollectionVideoListResp
+	Comment                  = video.Comment
+	CommentListReq           = video.CommentListReq
+	CommentListResp          = video.CommentListResp
+	CommentReq               = video.CommentReq
+	CommentResp              = video.CommentResp
+	CreatorStatsReq          = video.CreatorStatsReq
+	CreatorStatsResp         = video.CreatorStatsResp
+	DailyStat                = video.DailyStat
+	DeleteWatchHistoryReq    = video.DeleteWatchHistoryReq
+	Draft                    = video.Draft
+	DraftActionReq           = video.DraftActionReq
+	DraftActionResp          = video.DraftActionResp
+	DraftListReq             = video.DraftListReq
+	DraftListResp            = video.DraftListResp
+	DuplicateOriginalReq     = video.DuplicateOriginalReq
+	DuplicateOriginalResp    = video.DuplicateOriginalResp
+	FavoriteListReq          = video.FavoriteListReq
+	FavoriteListResp         = video.FavoriteListResp
+	FavoriteReq              = video.FavoriteReq
+	FavoriteResp             = video.FavoriteResp
+	FeedReq                  = video.FeedReq
+	FeedResp                 = video.FeedResp
+	PublishListReq           = video.PublishListReq
+	PublishListResp          = video.PublishListResp
+	ReportReq                = video.ReportReq
+	ReportResp               = video.ReportResp
+	ReportTarget             = video.ReportTarget
+	ReportTargetListReq      = video.ReportTargetListReq
+	ReportTargetListResp     = video.ReportTargetListResp
+	ResolveShareReq          = video.ResolveShareReq
+	ResolveShareResp         = video.ResolveShareResp
+	RestoreReportTargetReq   = video.RestoreReportTargetReq
+	RestoreReportTargetResp  = video.RestoreReportTargetResp
+	ReviewQueueReq           = video.ReviewQueueReq
+	ReviewQueueResp          = video.ReviewQueueResp
+	ReviewVideoReq           = video.ReviewVideoReq
+	ReviewVideoResp          = video.ReviewVideoResp
+	ShareReq                 = video.ShareReq
+	ShareResp                = video.ShareResp
+	SortCollectionsReq       = video.SortCollectionsReq
+	Topic                    = video.Topic
+	TopicVideoListReq        = video.TopicVideoListReq
+	TopicVideoListResp       = video.TopicVideoListResp
+	TrendingReq              = video.TrendingReq
+	TrendingResp             = video.TrendingResp
+	User                     = video.User
+	Video                    = video.Video
+	VideoListReq             = video.VideoListReq
+	VideoListResp            = video.VideoListResp
+	VideoVisibilityReq       = video.VideoVisibilityReq
+	VideoVisibilityResp      = video.VideoVisibilityResp
+	WatchHistoryActionResp   = video.WatchHistoryActionResp
+	WatchHistoryItem         = video.WatchHistoryItem
+	WatchHistoryPausedReq    = video.WatchHistoryPausedReq
+	WatchHistoryReq          = video.WatchHistoryReq
+	WatchHistoryResp         = video.WatchHistoryResp
 
 	VideoRpc interface {
 		GetPublishList(ctx context.Context, in *PublishListReq, opts ...grpc.CallOption) (*PublishListResp, error)
@@ -36,6 +86,30 @@ type (
 		GetCommentList(ctx context.Context, in *CommentListReq, opts ...grpc.CallOption) (*CommentListResp, error)
 		FavoriteAction(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteResp, error)
 		GetFavoriteList(ctx context.Context, in *FavoriteListReq, opts ...grpc.CallOption) (*FavoriteListResp, error)
+		ListReviewQueue(ctx context.Context, in *ReviewQueueReq, opts ...grpc.CallOption) (*ReviewQueueResp, error)
+		ReviewVideo(ctx context.Context, in *ReviewVideoReq, opts ...grpc.CallOption) (*ReviewVideoResp, error)
+		ReportAction(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*ReportResp, error)
+		ListReportTargets(ctx context.Context, in *ReportTargetListReq, opts ...grpc.CallOption) (*ReportTargetListResp, error)
+		RestoreReportTarget(ctx context.Context, in *RestoreReportTargetReq, opts ...grpc.CallOption) (*RestoreReportTargetResp, error)
+		GetVideoList(ctx context.Context, in *VideoListReq, opts ...grpc.CallOption) (*VideoListResp, error)
+		GetTopicVideoList(ctx context.Context, in *TopicVideoListReq, opts ...grpc.CallOption) (*TopicVideoListResp, error)
+		GetTrendingList(ctx context.Context, in *TrendingReq, opts ...grpc.CallOption) (*TrendingResp, error)
+		GetWatchHistory(ctx context.Context, in *WatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryResp, error)
+		DeleteWatchHistory(ctx context.Context, in *DeleteWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+		ClearWatchHistory(ctx context.Context, in *ClearWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+		SetWatchHistoryPaused(ctx context.Context, in *WatchHistoryPausedReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error)
+		GetCreatorStats(ctx context.Context, in *CreatorStatsReq, opts ...grpc.CallOption) (*CreatorStatsResp, error)
+		CollectionAction(ctx context.Context, in *CollectionActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+		SortCollections(ctx context.Context, in *SortCollectionsReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+		CollectionVideoAction(ctx context.Context, in *CollectionVideoActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error)
+		GetCollectionList(ctx context.Context, in *CollectionListReq, opts ...grpc.CallOption) (*CollectionListResp, error)
+		GetCollectionVideoList(ctx context.Context, in *CollectionVideoListReq, opts ...grpc.CallOption) (*CollectionVideoListResp, error)
+		ShareAction(ctx context.Context, in *ShareReq, opts ...grpc.CallOption) (*ShareResp, error)
+		ResolveShare(ctx context.Context, in *ResolveShareReq, opts ...grpc.CallOption) (*ResolveShareResp, error)
+		SetVideoVisibility(ctx context.Context, in *VideoVisibilityReq, opts ...grpc.CallOption) (*VideoVisibilityResp, error)
+		GetDraftList(ctx context.Context, in *DraftListReq, opts ...grpc.CallOption) (*DraftListResp, error)
+		DraftAction(ctx context.Context, in *DraftActionReq, opts ...grpc.CallOption) (*DraftActionResp, error)
+		GetDuplicateOriginal(ctx context.Context, in *DuplicateOriginalReq, opts ...grpc.CallOption) (*DuplicateOriginalResp, error)
 	}
 
 	defaultVideoRpc struct {
@@ -78,3 +152,123 @@ func (m *defaultVideoRpc) GetFavoriteList(ctx context.Context, in *FavoriteListR
 	client := video.NewVideoRpcClient(m.cli.Conn())
 	return client.GetFavoriteList(ctx, in, opts...)
 }
+
+func (m *defaultVideoRpc) ListReviewQueue(ctx context.Context, in *ReviewQueueReq, opts ...grpc.CallOption) (*ReviewQueueResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ListReviewQueue(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ReviewVideo(ctx context.Context, in *ReviewVideoReq, opts ...grpc.CallOption) (*ReviewVideoResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ReviewVideo(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ReportAction(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*ReportResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ReportAction(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ListReportTargets(ctx context.Context, in *ReportTargetListReq, opts ...grpc.CallOption) (*ReportTargetListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ListReportTargets(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) RestoreReportTarget(ctx context.Context, in *RestoreReportTargetReq, opts ...grpc.CallOption) (*RestoreReportTargetResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.RestoreReportTarget(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetVideoList(ctx context.Context, in *VideoListReq, opts ...grpc.CallOption) (*VideoListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetVideoList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetTopicVideoList(ctx context.Context, in *TopicVideoListReq, opts ...grpc.CallOption) (*TopicVideoListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetTopicVideoList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetTrendingList(ctx context.Context, in *TrendingReq, opts ...grpc.CallOption) (*TrendingResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetTrendingList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetWatchHistory(ctx context.Context, in *WatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetWatchHistory(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) DeleteWatchHistory(ctx context.Context, in *DeleteWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.DeleteWatchHistory(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ClearWatchHistory(ctx context.Context, in *ClearWatchHistoryReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ClearWatchHistory(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) SetWatchHistoryPaused(ctx context.Context, in *WatchHistoryPausedReq, opts ...grpc.CallOption) (*WatchHistoryActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.SetWatchHistoryPaused(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetCreatorStats(ctx context.Context, in *CreatorStatsReq, opts ...grpc.CallOption) (*CreatorStatsResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetCreatorStats(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) CollectionAction(ctx context.Context, in *CollectionActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.CollectionAction(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) SortCollections(ctx context.Context, in *SortCollectionsReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.SortCollections(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) CollectionVideoAction(ctx context.Context, in *CollectionVideoActionReq, opts ...grpc.CallOption) (*CollectionActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.CollectionVideoAction(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetCollectionList(ctx context.Context, in *CollectionListReq, opts ...grpc.CallOption) (*CollectionListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetCollectionList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetCollectionVideoList(ctx context.Context, in *CollectionVideoListReq, opts ...grpc.CallOption) (*CollectionVideoListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetCollectionVideoList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ShareAction(ctx context.Context, in *ShareReq, opts ...grpc.CallOption) (*ShareResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ShareAction(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) ResolveShare(ctx context.Context, in *ResolveShareReq, opts ...grpc.CallOption) (*ResolveShareResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.ResolveShare(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) SetVideoVisibility(ctx context.Context, in *VideoVisibilityReq, opts ...grpc.CallOption) (*VideoVisibilityResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.SetVideoVisibility(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetDraftList(ctx context.Context, in *DraftListReq, opts ...grpc.CallOption) (*DraftListResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetDraftList(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) DraftAction(ctx context.Context, in *DraftActionReq, opts ...grpc.CallOption) (*DraftActionResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.DraftAction(ctx, in, opts...)
+}
+
+func (m *defaultVideoRpc) GetDuplicateOriginal(ctx context.Context, in *DuplicateOriginalReq, opts ...grpc.CallOption) (*DuplicateOriginalResp, error) {
+	client := video.NewVideoRpcClient(m.cli.Conn())
+	return client.GetDuplicateOriginal(ctx, in, opts...)
+}